@@ -83,6 +83,9 @@ function build_proto_inner() {
   GO_MAPPINGS+=",Mui/ui.proto=github.com/cilium/hubble-ui/backend/proto/ui"
   GO_MAPPINGS+=",Mui/notifications.proto=github.com/cilium/hubble-ui/backend/proto/ui"
   GO_MAPPINGS+=",Mui/status.proto=github.com/cilium/hubble-ui/backend/proto/ui"
+  GO_MAPPINGS+=",Mui/policies.proto=github.com/cilium/hubble-ui/backend/proto/ui"
+  GO_MAPPINGS+=",Mui/map_export.proto=github.com/cilium/hubble-ui/backend/proto/ui"
+  GO_MAPPINGS+=",Mui/views.proto=github.com/cilium/hubble-ui/backend/proto/ui"
   GO_MAPPINGS+=",Mgoogle/protobuf/timestamp.proto=google.golang.org/protobuf/types/known/timestamppb"
   GO_MAPPINGS+=",Mgoogle/protobuf/duration.proto=google.golang.org/protobuf/types/known/durationpb"
   GO_MAPPINGS+=",Mcustomprotocol/customprotocol.proto=github.com/cilium/hubble-ui/backend/proto/customprotocol"
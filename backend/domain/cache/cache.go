@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/cilium/hubble-ui/backend/domain/events"
@@ -9,10 +11,25 @@ import (
 	"github.com/cilium/hubble-ui/backend/domain/service"
 )
 
+// NOTE: Cilium identity of the workload changes together with its labels,
+// so the card of the workload is kept under the id it was created with and
+// the services and links with new ids are merged into it
 type DataCache struct {
 	mx       sync.Mutex
 	services map[string]*service.Service
 	links    map[string]*link.Link
+
+	// NOTE: Original service id -> id of the card it is shown on
+	cardIds map[string]string
+
+	// NOTE: Workload key -> id of the card created for the workload
+	workloadCards map[string]string
+
+	// NOTE: Card id -> other ids merged into the card
+	idAliases map[string][]string
+
+	// NOTE: Ids of links whose counters have grown since they were reported
+	staleLinks map[string]struct{}
 }
 
 type Result[T any] struct {
@@ -22,9 +39,13 @@ type Result[T any] struct {
 
 func New() *DataCache {
 	return &DataCache{
-		mx:       sync.Mutex{},
-		services: make(map[string]*service.Service),
-		links:    make(map[string]*link.Link),
+		mx:            sync.Mutex{},
+		services:      make(map[string]*service.Service),
+		links:         make(map[string]*link.Link),
+		cardIds:       make(map[string]string),
+		workloadCards: make(map[string]string),
+		idAliases:     make(map[string][]string),
+		staleLinks:    make(map[string]struct{}),
 	}
 }
 
@@ -34,6 +55,10 @@ func (c *DataCache) Drop() {
 
 	c.services = make(map[string]*service.Service)
 	c.links = make(map[string]*link.Link)
+	c.cardIds = make(map[string]string)
+	c.workloadCards = make(map[string]string)
+	c.idAliases = make(map[string][]string)
+	c.staleLinks = make(map[string]struct{})
 }
 
 func (c *DataCache) UpsertServicesFromFlows(
@@ -70,26 +95,42 @@ func (c *DataCache) UpsertLinksFromFlows(
 	flows []*flow.Flow,
 ) []Result[*link.Link] {
 	links := make([]Result[*link.Link], 0)
+	indices := make(map[string]int)
 
 	for _, f := range flows {
-		svcLink := link.FromFlowProto(f.Ref())
+		svcLink := link.FromFlowProto(f.Ref(), f.ReadyBackends())
 		if svcLink == nil {
 			continue
 		}
 
-		if flags := c.UpsertServiceLink(svcLink); flags.IsChanged() {
-			links = append(links, Result[*link.Link]{
-				Entry:     svcLink,
-				EventKind: flags,
-			})
+		flags := c.UpsertServiceLink(svcLink)
+		if !flags.IsChanged() {
+			continue
 		}
+
+		// NOTE: The same link can be changed several times within one batch,
+		// only one result is kept for it. Added is kept as is since client
+		// hasn't seen the link yet.
+		if idx, exists := indices[svcLink.Id]; exists {
+			if links[idx].EventKind != events.Added {
+				links[idx].EventKind = flags
+			}
+
+			continue
+		}
+
+		indices[svcLink.Id] = len(links)
+		links = append(links, Result[*link.Link]{
+			Entry:     c.getLink(svcLink.Id),
+			EventKind: flags,
+		})
 	}
 
 	return links
 }
 
 func (c *DataCache) UpsertService(newSvc *service.Service) events.EventKind {
-	svcId := newSvc.Id()
+	svcId := newSvc.OriginalId()
 
 	if svcId == "0" {
 		return events.Unknown
@@ -98,41 +139,154 @@ func (c *DataCache) UpsertService(newSvc *service.Service) events.EventKind {
 	c.mx.Lock()
 	defer c.mx.Unlock()
 
-	currentSvc, exists := c.services[svcId]
+	cardId, isKnown := c.cardIds[svcId]
+	if !isKnown {
+		cardId = c.workloadCardId(newSvc, svcId)
+	}
+
+	currentSvc, exists := c.services[cardId]
 
 	switch {
 	case !exists:
+		c.cardIds[svcId] = svcId
+		c.registerWorkload(newSvc, svcId)
 		c.services[svcId] = newSvc
 		return events.Added
+	case !isKnown:
+		// NOTE: Identity of the workload has changed, the card is kept and
+		// the service is reported as modified instead of added
+		c.cardIds[svcId] = cardId
+		c.idAliases[cardId] = append(c.idAliases[cardId], svcId)
+		fallthrough
 	case currentSvc.IsEnrichedWith(newSvc):
-		c.services[svcId] = newSvc
+		newSvc.SetCardId(cardId, c.idAliases[cardId])
+		c.registerWorkload(newSvc, cardId)
+		c.services[cardId] = newSvc
 		return events.Modified
 	default:
 		return events.Exists
 	}
 }
 
+func (c *DataCache) workloadCardId(svc *service.Service, svcId string) string {
+	key := svc.WorkloadKey()
+	if len(key) == 0 {
+		return svcId
+	}
+
+	if cardId, exists := c.workloadCards[key]; exists {
+		return cardId
+	}
+
+	return svcId
+}
+
+func (c *DataCache) registerWorkload(svc *service.Service, cardId string) {
+	key := svc.WorkloadKey()
+	if len(key) == 0 {
+		return
+	}
+
+	if _, exists := c.workloadCards[key]; !exists {
+		c.workloadCards[key] = cardId
+	}
+}
+
 func (c *DataCache) UpsertServiceLink(newLink *link.Link) events.EventKind {
 	c.mx.Lock()
 	defer c.mx.Unlock()
 
+	// NOTE: Links of the services merged into another card are moved to it
+	srcId, destId := c.cardId(newLink.SourceId), c.cardId(newLink.DestinationId)
+	if srcId != newLink.SourceId || destId != newLink.DestinationId {
+		newLink.SetServiceIds(srcId, destId)
+	}
+
 	currentLink, exists := c.links[newLink.Id]
 	if !exists {
 		c.links[newLink.Id] = newLink
 		return events.Added
 	}
 
-	// TODO: Need to find where this accumulation can happen
-	// newLink.AccumulateLink(currentLink)
-	if currentLink.Equals(newLink) {
+	isEqual := currentLink.Equals(newLink)
+	hasNewCounters := currentLink.HasNewCountersFrom(newLink)
+	hasNewService := currentLink.DestinationService == nil &&
+		newLink.DestinationService != nil
+
+	currentLink.AccumulateLink(newLink)
+
+	// NOTE: Counters are growing with every flow, but link is reported as
+	// modified only when new verdict, drop reason or k8s Service appears on it,
+	// the rest of changes are reported by FlushStaleLinks
+	if isEqual && !hasNewCounters && !hasNewService {
+		c.staleLinks[newLink.Id] = struct{}{}
 		return events.Exists
 	}
 
-	// NOTE: the only thing that can differ is Verdict
-	c.links[newLink.Id] = newLink
+	delete(c.staleLinks, newLink.Id)
+
+	// NOTE: the only thing that can differ is Verdict, AuthType and encryption
+	currentLink.Verdict = newLink.Verdict
+	currentLink.AuthType = newLink.AuthType
+	currentLink.IsEncrypted = newLink.IsEncrypted
+
 	return events.Modified
 }
 
+// NOTE: Returns links whose counters have changed since they were reported,
+// it's meant to be called periodically to keep counters on client up to date
+func (c *DataCache) FlushStaleLinks() []Result[*link.Link] {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	results := make([]Result[*link.Link], 0, len(c.staleLinks))
+	for id := range c.staleLinks {
+		results = append(results, Result[*link.Link]{
+			Entry:     c.links[id],
+			EventKind: events.Modified,
+		})
+	}
+
+	clear(c.staleLinks)
+
+	slices.SortFunc(results, func(lhs, rhs Result[*link.Link]) int {
+		return strings.Compare(lhs.Entry.Id, rhs.Entry.Id)
+	})
+
+	return results
+}
+
+// NOTE: Returns id of the card the service is shown on
+func (c *DataCache) CardId(svcId string) string {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.cardId(svcId)
+}
+
+func (c *DataCache) cardId(svcId string) string {
+	if cardId, exists := c.cardIds[svcId]; exists {
+		return cardId
+	}
+
+	return svcId
+}
+
+// NOTE: Returns the service shown on the card, nil if there is no such card
+func (c *DataCache) Service(cardId string) *service.Service {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.services[cardId]
+}
+
+func (c *DataCache) getLink(id string) *link.Link {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.links[id]
+}
+
 func (c *DataCache) ForEachService(cb func(key string, svc *service.Service)) {
 	c.mx.Lock()
 	defer c.mx.Unlock()
@@ -0,0 +1,154 @@
+package cache
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+)
+
+func tcpFlow(src, dst *pbFlow.Endpoint) *pbFlow.Flow {
+	return &pbFlow.Flow{
+		Source:      src,
+		Destination: dst,
+		Verdict:     pbFlow.Verdict_FORWARDED,
+		L4: &pbFlow.Layer4{
+			Protocol: &pbFlow.Layer4_TCP{
+				TCP: &pbFlow.TCP{DestinationPort: 8080},
+			},
+		},
+	}
+}
+
+func backendEndpoint(identity uint32, labels ...string) *pbFlow.Endpoint {
+	return &pbFlow.Endpoint{
+		Identity:  identity,
+		Namespace: "shop",
+		Labels:    append([]string{"k8s:app=backend"}, labels...),
+		Workloads: []*pbFlow.Workload{{Kind: "Deployment", Name: "backend"}},
+	}
+}
+
+func TestIdentityChangeKeepsCard(t *testing.T) {
+	frontend := &pbFlow.Endpoint{
+		Identity:  1001,
+		Namespace: "shop",
+		Labels:    []string{"k8s:app=frontend"},
+	}
+
+	dcache := New()
+
+	before := flow.Wrap([]*pbFlow.Flow{tcpFlow(frontend, backendEndpoint(1002))})
+	dcache.UpsertServicesFromFlows(before)
+	dcache.UpsertLinksFromFlows(before)
+
+	after := flow.Wrap([]*pbFlow.Flow{
+		tcpFlow(frontend, backendEndpoint(1003, "k8s:version=v2")),
+	})
+
+	svcs := dcache.UpsertServicesFromFlows(after)
+	if len(svcs) != 1 || svcs[0].EventKind != events.Modified {
+		t.Fatalf("expected relabeled service to be modified, got %v", svcs)
+	}
+
+	svc := svcs[0].Entry.ToProto()
+	if svc.GetId() != "1002" || svc.GetIdentity() != 1003 {
+		t.Fatalf("expected card 1002 to show identity 1003, got %v", svc)
+	}
+
+	if len(svc.GetIdAliases()) != 1 || svc.GetIdAliases()[0] != "1003" {
+		t.Fatalf("unexpected id aliases: %v", svc.GetIdAliases())
+	}
+
+	links := dcache.UpsertLinksFromFlows(after)
+	if len(links) != 0 {
+		t.Fatalf("expected link to be merged into existing one, got %v", links)
+	}
+
+	dcache.ForEachLink(func(_ string, l *link.Link) {
+		if l.DestinationId != "1002" || l.FlowAmount != 2 {
+			t.Fatalf("unexpected link: %v", l)
+		}
+	})
+
+	// NOTE: Old pods are still running during rollout, they don't produce
+	// new events
+	if svcs := dcache.UpsertServicesFromFlows(before); len(svcs) != 0 {
+		t.Fatalf("expected no changes for old identity, got %v", svcs)
+	}
+}
+
+func TestWorkloadsSharingAppLabelAreNotMerged(t *testing.T) {
+	frontend := &pbFlow.Endpoint{
+		Identity:  1001,
+		Namespace: "shop",
+		Labels:    []string{"k8s:app=frontend"},
+	}
+
+	canary := backendEndpoint(1003, "k8s:track=canary")
+	canary.Workloads = []*pbFlow.Workload{{Kind: "Deployment", Name: "backend-canary"}}
+
+	// NOTE: Endpoint without workloads can't be matched with any of them
+	bare := &pbFlow.Endpoint{
+		Identity:  1004,
+		Namespace: "shop",
+		Labels:    []string{"k8s:app=backend", "k8s:track=bare"},
+	}
+
+	dcache := New()
+	svcs := dcache.UpsertServicesFromFlows(flow.Wrap([]*pbFlow.Flow{
+		tcpFlow(frontend, backendEndpoint(1002)),
+		tcpFlow(frontend, canary),
+		tcpFlow(frontend, bare),
+	}))
+
+	if len(svcs) != 4 {
+		t.Fatalf("expected every workload to get its own card, got %v", svcs)
+	}
+
+	for _, svc := range svcs {
+		if svc.EventKind != events.Added || svc.Entry.Id() != svc.Entry.OriginalId() {
+			t.Fatalf("unexpected merged service: %v", svc.Entry.ToProto())
+		}
+	}
+}
+
+func TestStaleLinksAreFlushed(t *testing.T) {
+	frontend := &pbFlow.Endpoint{
+		Identity:  1001,
+		Namespace: "shop",
+		Labels:    []string{"k8s:app=frontend"},
+	}
+
+	dcache := New()
+
+	flows := flow.Wrap([]*pbFlow.Flow{tcpFlow(frontend, backendEndpoint(1002))})
+	if links := dcache.UpsertLinksFromFlows(flows); len(links) != 1 {
+		t.Fatalf("expected one added link, got %v", links)
+	}
+
+	if links := dcache.FlushStaleLinks(); len(links) != 0 {
+		t.Fatalf("expected just reported link to not be stale, got %v", links)
+	}
+
+	if links := dcache.UpsertLinksFromFlows(flows); len(links) != 0 {
+		t.Fatalf("expected counters to not modify the link, got %v", links)
+	}
+
+	links := dcache.FlushStaleLinks()
+	if len(links) != 1 || links[0].EventKind != events.Modified {
+		t.Fatalf("expected stale link to be modified, got %v", links)
+	}
+
+	counts := links[0].Entry.ToProto().GetVerdictCounts()
+	if len(counts) != 1 || counts[0].GetCount() != 2 {
+		t.Fatalf("expected link to have both flows counted, got %v", counts)
+	}
+
+	if links := dcache.FlushStaleLinks(); len(links) != 0 {
+		t.Fatalf("expected stale links to be flushed once, got %v", links)
+	}
+}
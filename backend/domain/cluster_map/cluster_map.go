@@ -0,0 +1,254 @@
+package cluster_map
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+type node struct {
+	id         string
+	name       string
+	isReserved bool
+
+	flowAmount uint64
+	serviceIds map[string]struct{}
+
+	isReported bool
+}
+
+type nsLink struct {
+	id     string
+	srcId  string
+	destId string
+
+	flowAmount      uint64
+	verdictCounts   map[pbFlow.Verdict]uint64
+	dropReasons     map[pbFlow.DropReason]uint64
+	bytesTransfered uint64
+	encryptedAmount uint64
+	serviceLinkIds  map[string]struct{}
+
+	isReported bool
+}
+
+// NOTE: Aggregator folds flows into namespace nodes and namespace-to-namespace
+// links, so that the whole cluster can be shown without sending every
+// service. Counters grow with every flow, so the changes are collected and
+// reported by Flush instead of being sent right away.
+type Aggregator struct {
+	nodes map[string]*node
+	links map[string]*nsLink
+
+	dirtyNodes map[string]struct{}
+	dirtyLinks map[string]struct{}
+}
+
+func NewAggregator() *Aggregator {
+	return &Aggregator{
+		nodes:      make(map[string]*node),
+		links:      make(map[string]*nsLink),
+		dirtyNodes: make(map[string]struct{}),
+		dirtyLinks: make(map[string]struct{}),
+	}
+}
+
+// NOTE: Services and service links are counted by the cards they are shown
+// on, cardId resolves service id into id of the card
+func (a *Aggregator) ObserveFlows(flows []*flow.Flow, cardId func(string) string) {
+	for _, f := range flows {
+		ref := f.Ref()
+		if ref.GetSource() == nil || ref.GetDestination() == nil {
+			continue
+		}
+
+		srcSvcId, destSvcId := f.ServiceIds()
+		src := a.observeEndpoint(ref.GetSource(), cardId(srcSvcId), nil)
+		dest := a.observeEndpoint(ref.GetDestination(), cardId(destSvcId), src)
+
+		l := a.ensureLink(src, dest)
+		l.flowAmount += 1
+		l.verdictCounts[ref.GetVerdict()] += 1
+		l.bytesTransfered += link.GetFlowBytesTransfered(ref)
+
+		if reason := ref.GetDropReasonDesc(); reason != pbFlow.DropReason_DROP_REASON_UNKNOWN {
+			l.dropReasons[reason] += 1
+		}
+
+		if ref.GetIP().GetEncrypted() {
+			l.encryptedAmount += 1
+		}
+
+		if svcLink := link.FromFlowProto(ref, f.ReadyBackends()); svcLink != nil {
+			svcLink.SetServiceIds(cardId(svcLink.SourceId), cardId(svcLink.DestinationId))
+			l.serviceLinkIds[svcLink.Id] = struct{}{}
+		}
+
+		a.dirtyLinks[l.id] = struct{}{}
+	}
+}
+
+// NOTE: Returns nodes and links that have changed since the last call, the
+// ones that were never reported before are reported as added
+func (a *Aggregator) Flush() (
+	[]cache.Result[*ui.NamespaceNode], []cache.Result[*ui.NamespaceLink],
+) {
+	nodes := make([]cache.Result[*ui.NamespaceNode], 0, len(a.dirtyNodes))
+	for id := range a.dirtyNodes {
+		n := a.nodes[id]
+
+		nodes = append(nodes, cache.Result[*ui.NamespaceNode]{
+			Entry:     n.toProto(),
+			EventKind: eventKind(n.isReported),
+		})
+
+		n.isReported = true
+	}
+
+	links := make([]cache.Result[*ui.NamespaceLink], 0, len(a.dirtyLinks))
+	for id := range a.dirtyLinks {
+		l := a.links[id]
+
+		links = append(links, cache.Result[*ui.NamespaceLink]{
+			Entry:     l.toProto(),
+			EventKind: eventKind(l.isReported),
+		})
+
+		l.isReported = true
+	}
+
+	clear(a.dirtyNodes)
+	clear(a.dirtyLinks)
+
+	slices.SortFunc(nodes, func(lhs, rhs cache.Result[*ui.NamespaceNode]) int {
+		return strings.Compare(lhs.Entry.GetId(), rhs.Entry.GetId())
+	})
+
+	slices.SortFunc(links, func(lhs, rhs cache.Result[*ui.NamespaceLink]) int {
+		return strings.Compare(lhs.Entry.GetId(), rhs.Entry.GetId())
+	})
+
+	return nodes, links
+}
+
+// NOTE: Flow within one namespace is counted on its node once, so the
+// destination isn't counted if it's on the node of the source
+func (a *Aggregator) observeEndpoint(ep *pbFlow.Endpoint, svcId string, src *node) *node {
+	id, name, isReserved := NodeFromEndpoint(ep)
+
+	n, exists := a.nodes[id]
+	if !exists {
+		n = &node{
+			id:         id,
+			name:       name,
+			isReserved: isReserved,
+			serviceIds: make(map[string]struct{}),
+		}
+
+		a.nodes[id] = n
+	}
+
+	if n != src {
+		n.flowAmount += 1
+	}
+
+	n.serviceIds[svcId] = struct{}{}
+	a.dirtyNodes[id] = struct{}{}
+
+	return n
+}
+
+func (a *Aggregator) ensureLink(src, dest *node) *nsLink {
+	id := fmt.Sprintf("%s -> %s", src.id, dest.id)
+
+	l, exists := a.links[id]
+	if !exists {
+		l = &nsLink{
+			id:             id,
+			srcId:          src.id,
+			destId:         dest.id,
+			verdictCounts:  make(map[pbFlow.Verdict]uint64),
+			dropReasons:    make(map[pbFlow.DropReason]uint64),
+			serviceLinkIds: make(map[string]struct{}),
+		}
+
+		a.links[id] = l
+	}
+
+	return l
+}
+
+// NOTE: Returns id, name of the node and whether it is a reserved one, i.e.
+// the endpoint doesn't belong to any namespace
+func NodeFromEndpoint(ep *pbFlow.Endpoint) (string, string, bool) {
+	if ns := ep.GetNamespace(); len(ns) > 0 {
+		return ns, ns, false
+	}
+
+	props := labels.Props(ep.GetLabels())
+
+	reserved := labels.ReservedLabelUnknown
+	switch {
+	case props.IsWorld:
+		reserved = labels.ReservedLabelWorld
+	case props.IsHost:
+		reserved = labels.ReservedLabelHost
+	case props.IsRemoteNode:
+		reserved = labels.ReservedLabelRemoteNode
+	case props.IsKubeAPIServer:
+		reserved = labels.ReservedLabelKubeAPIServer
+	case props.IsHealth:
+		reserved = labels.ReservedLabelHealth
+	case props.IsInit:
+		reserved = labels.ReservedLabelInit
+	}
+
+	return string(reserved), strings.TrimPrefix(string(reserved), "reserved:"), true
+}
+
+func (n *node) toProto() *ui.NamespaceNode {
+	return &ui.NamespaceNode{
+		Id:             n.id,
+		Name:           n.name,
+		IsReserved:     n.isReserved,
+		FlowAmount:     n.flowAmount,
+		ServicesNumber: uint32(len(n.serviceIds)),
+	}
+}
+
+func (l *nsLink) toProto() *ui.NamespaceLink {
+	coverage := float32(0)
+	if l.flowAmount > 0 {
+		coverage = float32(l.encryptedAmount) / float32(l.flowAmount)
+	}
+
+	return &ui.NamespaceLink{
+		Id:                  l.id,
+		SourceId:            l.srcId,
+		DestinationId:       l.destId,
+		FlowAmount:          l.flowAmount,
+		VerdictCounts:       link.VerdictCountsToProto(l.verdictCounts),
+		DropReasons:         link.DropReasonsToProto(l.dropReasons),
+		BytesTransfered:     l.bytesTransfered,
+		EncryptedFlowAmount: l.encryptedAmount,
+		EncryptionCoverage:  coverage,
+		ServiceLinksNumber:  uint32(len(l.serviceLinkIds)),
+	}
+}
+
+func eventKind(isReported bool) events.EventKind {
+	if isReported {
+		return events.Modified
+	}
+
+	return events.Added
+}
@@ -0,0 +1,116 @@
+package cluster_map
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+)
+
+func tcpFlow(src, dst *pbFlow.Endpoint, verdict pbFlow.Verdict, encrypted bool) *pbFlow.Flow {
+	return &pbFlow.Flow{
+		Source:      src,
+		Destination: dst,
+		Verdict:     verdict,
+		IP:          &pbFlow.IP{Encrypted: encrypted},
+		L4: &pbFlow.Layer4{
+			Protocol: &pbFlow.Layer4_TCP{
+				TCP: &pbFlow.TCP{DestinationPort: 8080},
+			},
+		},
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	frontend := &pbFlow.Endpoint{Identity: 1001, Namespace: "shop", Labels: []string{"k8s:app=frontend"}}
+	backend := &pbFlow.Endpoint{Identity: 1002, Namespace: "shop", Labels: []string{"k8s:app=backend"}}
+	db := &pbFlow.Endpoint{Identity: 1003, Namespace: "storage", Labels: []string{"k8s:app=db"}}
+	world := &pbFlow.Endpoint{Identity: 2, Labels: []string{"reserved:world"}}
+
+	sameCard := func(id string) string { return id }
+
+	agg := NewAggregator()
+	agg.ObserveFlows(flow.Wrap([]*pbFlow.Flow{
+		tcpFlow(frontend, backend, pbFlow.Verdict_FORWARDED, false),
+		tcpFlow(backend, db, pbFlow.Verdict_FORWARDED, true),
+		tcpFlow(frontend, db, pbFlow.Verdict_DROPPED, false),
+		tcpFlow(world, frontend, pbFlow.Verdict_FORWARDED, false),
+	}), sameCard)
+
+	nodes, links := agg.Flush()
+	if len(nodes) != 3 || len(links) != 3 {
+		t.Fatalf("expected 3 nodes and 3 links, got %d and %d", len(nodes), len(links))
+	}
+
+	if n := nodes[0].Entry; n.GetId() != "reserved:world" || !n.GetIsReserved() || n.GetName() != "world" {
+		t.Fatalf("unexpected reserved node: %v", n)
+	}
+
+	if n := nodes[1].Entry; n.GetId() != "shop" || n.GetFlowAmount() != 4 || n.GetServicesNumber() != 2 {
+		t.Fatalf("unexpected shop node: %v", n)
+	}
+
+	cross := links[2].Entry
+	if cross.GetId() != "shop -> storage" || cross.GetFlowAmount() != 2 {
+		t.Fatalf("unexpected cross namespace link: %v", cross)
+	}
+
+	if len(cross.GetVerdictCounts()) != 2 || cross.GetServiceLinksNumber() != 2 {
+		t.Fatalf("expected mixed verdicts from 2 service links: %v", cross)
+	}
+
+	if cross.GetEncryptedFlowAmount() != 1 || cross.GetEncryptionCoverage() != 0.5 {
+		t.Fatalf("expected half of flows to be encrypted: %v", cross)
+	}
+
+	for _, l := range links {
+		if l.EventKind != events.Added {
+			t.Fatalf("expected link to be added: %v", l)
+		}
+	}
+
+	if nodes, links := agg.Flush(); len(nodes) != 0 || len(links) != 0 {
+		t.Fatalf("expected nothing to flush, got %v and %v", nodes, links)
+	}
+
+	agg.ObserveFlows(flow.Wrap([]*pbFlow.Flow{
+		tcpFlow(frontend, backend, pbFlow.Verdict_FORWARDED, false),
+	}), sameCard)
+
+	nodes, links = agg.Flush()
+	if len(nodes) != 1 || len(links) != 1 || links[0].EventKind != events.Modified {
+		t.Fatalf("expected only shop node and link to be modified, got %v and %v", nodes, links)
+	}
+}
+
+func TestAggregatorCountsCards(t *testing.T) {
+	frontend := &pbFlow.Endpoint{Identity: 1001, Namespace: "shop", Labels: []string{"k8s:app=frontend"}}
+	backend := &pbFlow.Endpoint{Identity: 1002, Namespace: "shop", Labels: []string{"k8s:app=backend"}}
+	relabeled := &pbFlow.Endpoint{Identity: 1003, Namespace: "shop", Labels: []string{"k8s:app=backend"}}
+
+	cards := map[string]string{"1003": "1002"}
+	cardId := func(id string) string {
+		if card, exists := cards[id]; exists {
+			return card
+		}
+
+		return id
+	}
+
+	agg := NewAggregator()
+	agg.ObserveFlows(flow.Wrap([]*pbFlow.Flow{
+		tcpFlow(frontend, backend, pbFlow.Verdict_FORWARDED, false),
+		tcpFlow(frontend, relabeled, pbFlow.Verdict_FORWARDED, false),
+	}), cardId)
+
+	nodes, links := agg.Flush()
+	if len(nodes) != 1 || nodes[0].Entry.GetServicesNumber() != 2 || nodes[0].Entry.GetFlowAmount() != 2 {
+		t.Fatalf("expected relabeled service to be counted on its card: %v", nodes)
+	}
+
+	if len(links) != 1 || links[0].Entry.GetServiceLinksNumber() != 1 {
+		t.Fatalf("expected links of the card to be counted once: %v", links)
+	}
+}
@@ -0,0 +1,94 @@
+package drops
+
+import (
+	"slices"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+type reasons = map[pbFlow.DropReason]uint64
+
+// NOTE: Aggregator counts drop reasons of dropped flows per namespace. Both
+// source and destination namespaces of a flow are counted. Namespaces which
+// got new drops since the last Flush are considered dirty.
+type Aggregator struct {
+	topSize int
+
+	namespaces map[string]reasons
+	dirty      map[string]struct{}
+}
+
+func NewAggregator(topSize int) *Aggregator {
+	if topSize == 0 {
+		topSize = 5
+	}
+
+	return &Aggregator{
+		topSize:    topSize,
+		namespaces: make(map[string]reasons),
+		dirty:      make(map[string]struct{}),
+	}
+}
+
+func (a *Aggregator) ObserveFlows(flows []*flow.Flow) {
+	for _, f := range flows {
+		ref := f.Ref()
+		if ref.GetVerdict() != pbFlow.Verdict_DROPPED {
+			continue
+		}
+
+		reason := ref.GetDropReasonDesc()
+		srcNs := ref.GetSource().GetNamespace()
+		dstNs := ref.GetDestination().GetNamespace()
+
+		a.count(srcNs, reason)
+		if dstNs != srcNs {
+			a.count(dstNs, reason)
+		}
+	}
+}
+
+// NOTE: Returns top drop reasons of namespaces that have changed since the
+// last call, namespaces are sorted by name
+func (a *Aggregator) Flush() []*ui.NamespaceDropReasons {
+	result := make([]*ui.NamespaceDropReasons, 0, len(a.dirty))
+
+	for ns := range a.dirty {
+		top := link.DropReasonsToProto(a.namespaces[ns])
+		if len(top) > a.topSize {
+			top = top[:a.topSize]
+		}
+
+		result = append(result, &ui.NamespaceDropReasons{
+			Namespace:  ns,
+			TopReasons: top,
+		})
+	}
+
+	clear(a.dirty)
+	slices.SortFunc(result, func(lhs, rhs *ui.NamespaceDropReasons) int {
+		return strings.Compare(lhs.GetNamespace(), rhs.GetNamespace())
+	})
+
+	return result
+}
+
+func (a *Aggregator) count(ns string, reason pbFlow.DropReason) {
+	if len(ns) == 0 {
+		return
+	}
+
+	nsReasons, exists := a.namespaces[ns]
+	if !exists {
+		nsReasons = make(reasons)
+		a.namespaces[ns] = nsReasons
+	}
+
+	nsReasons[reason] += 1
+	a.dirty[ns] = struct{}{}
+}
@@ -10,17 +10,28 @@ import (
 
 type Flow struct {
 	ref *pbFlow.Flow
+
+	// NOTE: Nil when k8s Services are not known
+	readyBackends service.ReadyBackendsCounter
 }
 
 func FromProto(f *pbFlow.Flow) *Flow {
-	return &Flow{f}
+	return &Flow{ref: f}
 }
 
 func Wrap(many []*pbFlow.Flow) []*Flow {
+	return WrapWithReadyBackends(many, nil)
+}
+
+// NOTE: readyBackends is used to build services of the flows, see
+// service.K8sServiceWithoutEndpoints
+func WrapWithReadyBackends(
+	many []*pbFlow.Flow, readyBackends service.ReadyBackendsCounter,
+) []*Flow {
 	w := make([]*Flow, len(many))
 
 	for i, pbf := range many {
-		w[i] = FromProto(pbf)
+		w[i] = &Flow{ref: pbf, readyBackends: readyBackends}
 	}
 
 	return w
@@ -36,6 +47,14 @@ func Unwrap(many []*Flow) []*pbFlow.Flow {
 	return u
 }
 
+func (f *Flow) ReadyBackends() service.ReadyBackendsCounter {
+	return f.readyBackends
+}
+
+func (f *Flow) ServiceIds() (string, string) {
+	return service.IdsFromFlowProto(f.ref, f.readyBackends)
+}
+
 func (f *Flow) BuildServices() (*service.Service, *service.Service) {
 	sender := f.BuildSenderService()
 	receiver := f.BuildReceiverService()
@@ -59,6 +78,7 @@ func (f *Flow) BuildReceiverService() *service.Service {
 	)
 
 	svc.SetIsReceiver(true)
+	svc.SetK8sServiceWithoutEndpoints(service.K8sServiceWithoutEndpoints(f.ref, f.readyBackends))
 
 	return svc
 }
@@ -0,0 +1,154 @@
+package k8s_service_links
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+// NOTE: Describes k8s Service by its namespace, name and the port the flows
+// were sent to, returned ref must have at least name and namespace set
+type ServiceResolver interface {
+	Ref(namespace, name string, destPort uint32) *ui.K8SServiceRef
+}
+
+type svcLink struct {
+	id       string
+	srcId    string
+	ref      *ui.K8SServiceRef
+	destPort uint32
+
+	destIds       map[string]struct{}
+	flowAmount    uint64
+	verdictCounts map[pbFlow.Verdict]uint64
+
+	isReported bool
+}
+
+// NOTE: Aggregator folds flows sent to k8s Services into links between the
+// source service and the port of k8s Service, no matter which backends the
+// flows were balanced to. Like cluster map, changes are reported by Flush.
+type Aggregator struct {
+	resolver ServiceResolver
+
+	// NOTE: Links are keyed by port numbers, since the name of the port can
+	// be unknown until EndpointSlices are synced
+	links map[string]*svcLink
+	dirty map[string]struct{}
+}
+
+func NewAggregator(resolver ServiceResolver) *Aggregator {
+	return &Aggregator{
+		resolver: resolver,
+		links:    make(map[string]*svcLink),
+		dirty:    make(map[string]struct{}),
+	}
+}
+
+// NOTE: Links connect the cards of the service map, cardId resolves service
+// id into id of the card
+func (a *Aggregator) ObserveFlows(flows []*flow.Flow, cardId func(string) string) {
+	for _, f := range flows {
+		l := link.FromFlowProto(f.Ref(), f.ReadyBackends())
+		if l == nil || l.DestinationService == nil {
+			continue
+		}
+
+		l.SetServiceIds(cardId(l.SourceId), cardId(l.DestinationId))
+
+		ns, name := l.DestinationService.GetNamespace(), l.DestinationService.GetName()
+		key := fmt.Sprintf("%s %s/%s:%d", l.SourceId, ns, name, l.DestinationPort)
+
+		sl, exists := a.links[key]
+		if !exists {
+			ref := a.resolver.Ref(ns, name, l.DestinationPort)
+			sl = &svcLink{
+				id:            linkId(l.SourceId, ref, l.DestinationPort),
+				srcId:         l.SourceId,
+				ref:           ref,
+				destPort:      l.DestinationPort,
+				destIds:       make(map[string]struct{}),
+				verdictCounts: make(map[pbFlow.Verdict]uint64),
+			}
+
+			a.links[key] = sl
+		}
+
+		sl.destIds[l.DestinationId] = struct{}{}
+		sl.flowAmount += 1
+		sl.verdictCounts[l.Verdict] += 1
+
+		a.dirty[key] = struct{}{}
+	}
+}
+
+// NOTE: Returns links that have changed since the last call, the ones that
+// were never reported before are reported as added. Refs are resolved again,
+// so that readiness of backends is up to date.
+func (a *Aggregator) Flush() []cache.Result[*ui.K8SServiceLink] {
+	links := make([]cache.Result[*ui.K8SServiceLink], 0, len(a.dirty))
+	for key := range a.dirty {
+		sl := a.links[key]
+		sl.ref = a.resolver.Ref(sl.ref.GetNamespace(), sl.ref.GetName(), sl.destPort)
+
+		kind := events.Added
+		if sl.isReported {
+			kind = events.Modified
+		}
+
+		links = append(links, cache.Result[*ui.K8SServiceLink]{
+			Entry:     sl.toProto(),
+			EventKind: kind,
+		})
+
+		sl.isReported = true
+	}
+
+	clear(a.dirty)
+
+	slices.SortFunc(links, func(lhs, rhs cache.Result[*ui.K8SServiceLink]) int {
+		return strings.Compare(lhs.Entry.GetId(), rhs.Entry.GetId())
+	})
+
+	return links
+}
+
+// NOTE: Port number is used for Service ports without names,
+// e.g. "frontend -> svc/default/api:http"
+func linkId(srcId string, ref *ui.K8SServiceRef, destPort uint32) string {
+	port := ref.GetPortName()
+	if len(port) == 0 {
+		port = strconv.FormatUint(uint64(destPort), 10)
+	}
+
+	return fmt.Sprintf(
+		"%s -> svc/%s/%s:%s", srcId, ref.GetNamespace(), ref.GetName(), port,
+	)
+}
+
+func (sl *svcLink) toProto() *ui.K8SServiceLink {
+	destIds := make([]string, 0, len(sl.destIds))
+	for id := range sl.destIds {
+		destIds = append(destIds, id)
+	}
+
+	slices.Sort(destIds)
+
+	return &ui.K8SServiceLink{
+		Id:             sl.id,
+		SourceId:       sl.srcId,
+		K8SService:     sl.ref,
+		DestinationIds: destIds,
+		FlowAmount:     sl.flowAmount,
+		VerdictCounts:  link.VerdictCountsToProto(sl.verdictCounts),
+	}
+}
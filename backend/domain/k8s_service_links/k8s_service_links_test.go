@@ -0,0 +1,138 @@
+package k8s_service_links
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+type resolver struct {
+	ready uint32
+}
+
+func (r *resolver) Ref(namespace, name string, destPort uint32) *ui.K8SServiceRef {
+	ref := &ui.K8SServiceRef{
+		Name:          name,
+		Namespace:     namespace,
+		Type:          "ClusterIP",
+		ReadyBackends: r.ready,
+		Backends:      2,
+	}
+
+	if destPort == 8080 {
+		ref.PortName = "http"
+	}
+
+	return ref
+}
+
+func svcFlow(src, dst *pbFlow.Endpoint, svc string, port uint32) *pbFlow.Flow {
+	f := &pbFlow.Flow{
+		Source:      src,
+		Destination: dst,
+		Verdict:     pbFlow.Verdict_FORWARDED,
+		L4: &pbFlow.Layer4{
+			Protocol: &pbFlow.Layer4_TCP{
+				TCP: &pbFlow.TCP{DestinationPort: port},
+			},
+		},
+	}
+
+	if len(svc) > 0 {
+		f.DestinationService = &pbFlow.Service{Name: svc, Namespace: "shop"}
+	}
+
+	return f
+}
+
+func TestAggregator(t *testing.T) {
+	frontend := &pbFlow.Endpoint{Identity: 1001, Namespace: "shop", Labels: []string{"k8s:app=frontend"}}
+	api1 := &pbFlow.Endpoint{Identity: 1002, Namespace: "shop", PodName: "api-1", Labels: []string{"k8s:app=api", "k8s:version=v1"}}
+	api2 := &pbFlow.Endpoint{Identity: 1003, Namespace: "shop", PodName: "api-2", Labels: []string{"k8s:app=api", "k8s:version=v2"}}
+
+	sameCard := func(id string) string { return id }
+
+	res := &resolver{ready: 2}
+	agg := NewAggregator(res)
+	agg.ObserveFlows(flow.Wrap([]*pbFlow.Flow{
+		svcFlow(frontend, api1, "api", 8080),
+		svcFlow(frontend, api2, "api", 8080),
+		svcFlow(frontend, api1, "api", 9090),
+		svcFlow(frontend, api1, "", 8080),
+	}), sameCard)
+
+	links := agg.Flush()
+	if len(links) != 2 {
+		t.Fatalf("expected 2 links, got %v", links)
+	}
+
+	if unnamed := links[0].Entry; unnamed.GetId() != "1001 -> svc/shop/api:9090" {
+		t.Fatalf("unexpected link to unnamed port: %v", unnamed)
+	}
+
+	http := links[1].Entry
+	if http.GetId() != "1001 -> svc/shop/api:http" || http.GetSourceId() != "1001" {
+		t.Fatalf("unexpected link to named port: %v", http)
+	}
+
+	if http.GetFlowAmount() != 2 || len(http.GetDestinationIds()) != 2 {
+		t.Fatalf("expected flows to both backends to be folded: %v", http)
+	}
+
+	for _, l := range links {
+		if l.EventKind != events.Added {
+			t.Fatalf("expected link to be added: %v", l)
+		}
+	}
+
+	if links := agg.Flush(); len(links) != 0 {
+		t.Fatalf("expected nothing to flush, got %v", links)
+	}
+
+	res.ready = 1
+	agg.ObserveFlows(flow.Wrap([]*pbFlow.Flow{
+		svcFlow(frontend, api2, "api", 8080),
+	}), sameCard)
+
+	links = agg.Flush()
+	if len(links) != 1 || links[0].EventKind != events.Modified {
+		t.Fatalf("expected http link to be modified, got %v", links)
+	}
+
+	if svc := links[0].Entry.GetK8SService(); svc.GetReadyBackends() != 1 || svc.GetBackends() != 2 {
+		t.Fatalf("expected readiness of backends to be refreshed: %v", svc)
+	}
+}
+
+func TestAggregatorUsesCards(t *testing.T) {
+	frontend := &pbFlow.Endpoint{Identity: 1001, Namespace: "shop", Labels: []string{"k8s:app=frontend"}}
+	api1 := &pbFlow.Endpoint{Identity: 1002, Namespace: "shop", PodName: "api-1", Labels: []string{"k8s:app=api", "k8s:version=v1"}}
+	api2 := &pbFlow.Endpoint{Identity: 1003, Namespace: "shop", PodName: "api-2", Labels: []string{"k8s:app=api", "k8s:version=v2"}}
+
+	cardId := func(id string) string {
+		if id == "1003" {
+			return "1002"
+		}
+
+		return id
+	}
+
+	agg := NewAggregator(&resolver{ready: 2})
+	agg.ObserveFlows(flow.Wrap([]*pbFlow.Flow{
+		svcFlow(frontend, api1, "api", 8080),
+		svcFlow(frontend, api2, "api", 8080),
+	}), cardId)
+
+	links := agg.Flush()
+	if len(links) != 1 {
+		t.Fatalf("expected one link, got %v", links)
+	}
+
+	if ids := links[0].Entry.GetDestinationIds(); len(ids) != 1 || ids[0] != "1002" {
+		t.Fatalf("expected backends to be reported by their cards: %v", ids)
+	}
+}
@@ -0,0 +1,326 @@
+package l7_summary
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const (
+	// NOTE: Paths that can't be templated (e.g. with names in them) would make
+	// the number of endpoints unbounded, the rest of them are folded into one
+	MaxEndpointsPerLink = 100
+	OtherPath           = "{other}"
+
+	// NOTE: Percentiles are computed over the recent latencies only
+	MaxLatencySamples = 512
+)
+
+var (
+	numericSegment = regexp.MustCompile(`^[0-9]+$`)
+	uuidSegment    = regexp.MustCompile(
+		`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+	)
+)
+
+type endpointKey struct {
+	method string
+	path   string
+}
+
+type endpoint struct {
+	key endpointKey
+
+	requests      uint64
+	statusClasses map[string]uint64
+
+	// NOTE: Ring buffer of the recent latencies
+	latencies   []uint64
+	latencyNext int
+}
+
+type linkSummary struct {
+	id     string
+	srcId  string
+	destId string
+
+	endpoints map[endpointKey]*endpoint
+
+	isReported bool
+}
+
+// NOTE: Aggregator folds HTTP flows into endpoints of the service links they
+// were sent over. Requests are counted on request flows, status codes and
+// latencies are taken from responses. Like the other aggregators, changes
+// are reported by Flush.
+type Aggregator struct {
+	links map[string]*linkSummary
+	dirty map[string]struct{}
+}
+
+func NewAggregator() *Aggregator {
+	return &Aggregator{
+		links: make(map[string]*linkSummary),
+		dirty: make(map[string]struct{}),
+	}
+}
+
+// NOTE: cardId maps ids of services to ids of the cards they are shown on,
+// so that link ids are the same as the ones of service links
+func (a *Aggregator) ObserveFlows(flows []*flow.Flow, cardId func(string) string) {
+	for _, f := range flows {
+		ref := f.Ref()
+
+		http := ref.GetL7().GetHttp()
+		if http == nil {
+			continue
+		}
+
+		l := link.FromL7FlowProto(ref, f.ReadyBackends())
+		if l == nil {
+			continue
+		}
+
+		l.SetServiceIds(cardId(l.SourceId), cardId(l.DestinationId))
+		ls := a.ensureLink(l)
+
+		ep := ls.ensureEndpoint(endpointKey{
+			method: strings.ToUpper(http.GetMethod()),
+			path:   TemplatePath(http.GetUrl()),
+		})
+
+		if ref.GetL7().GetType() == pbFlow.L7FlowType_RESPONSE {
+			ep.observeResponse(http.GetCode(), ref.GetL7().GetLatencyNs())
+		} else {
+			ep.requests += 1
+		}
+
+		a.dirty[ls.id] = struct{}{}
+	}
+}
+
+func (a *Aggregator) Flush() []cache.Result[*ui.LinkL7Summary] {
+	results := make([]cache.Result[*ui.LinkL7Summary], 0, len(a.dirty))
+	for id := range a.dirty {
+		ls := a.links[id]
+
+		kind := events.Added
+		if ls.isReported {
+			kind = events.Modified
+		}
+
+		results = append(results, cache.Result[*ui.LinkL7Summary]{
+			Entry:     ls.toProto(),
+			EventKind: kind,
+		})
+
+		ls.isReported = true
+	}
+
+	clear(a.dirty)
+
+	slices.SortFunc(results, func(lhs, rhs cache.Result[*ui.LinkL7Summary]) int {
+		return strings.Compare(lhs.Entry.GetLinkId(), rhs.Entry.GetLinkId())
+	})
+
+	return results
+}
+
+// NOTE: Endpoints of all the links to the card are merged, so that the
+// service gets the same stats its incoming links have
+func (a *Aggregator) ServedEndpoints(cardId string) []*ui.HttpEndpointStats {
+	served := &linkSummary{endpoints: make(map[endpointKey]*endpoint)}
+	for _, ls := range a.links {
+		if ls.destId != cardId {
+			continue
+		}
+
+		for _, ep := range ls.endpoints {
+			served.ensureEndpoint(ep.key).merge(ep)
+		}
+	}
+
+	return served.toProto().GetHttpEndpoints()
+}
+
+func (a *Aggregator) ensureLink(l *link.Link) *linkSummary {
+	ls, exists := a.links[l.Id]
+	if !exists {
+		ls = &linkSummary{
+			id:        l.Id,
+			srcId:     l.SourceId,
+			destId:    l.DestinationId,
+			endpoints: make(map[endpointKey]*endpoint),
+		}
+
+		a.links[l.Id] = ls
+	}
+
+	return ls
+}
+
+func (ls *linkSummary) ensureEndpoint(key endpointKey) *endpoint {
+	if ep, exists := ls.endpoints[key]; exists {
+		return ep
+	}
+
+	if len(ls.endpoints) >= MaxEndpointsPerLink {
+		key.path = OtherPath
+		if ep, exists := ls.endpoints[key]; exists {
+			return ep
+		}
+	}
+
+	ep := &endpoint{
+		key:           key,
+		statusClasses: make(map[string]uint64),
+	}
+
+	ls.endpoints[key] = ep
+	return ep
+}
+
+func (ep *endpoint) observeResponse(code uint32, latencyNs uint64) {
+	if code > 0 {
+		ep.statusClasses[fmt.Sprintf("%dxx", code/100)] += 1
+	}
+
+	if latencyNs == 0 {
+		return
+	}
+
+	if len(ep.latencies) < MaxLatencySamples {
+		ep.latencies = append(ep.latencies, latencyNs)
+		return
+	}
+
+	ep.latencies[ep.latencyNext] = latencyNs
+	ep.latencyNext = (ep.latencyNext + 1) % MaxLatencySamples
+}
+
+func (ep *endpoint) merge(other *endpoint) {
+	ep.requests += other.requests
+	for class, count := range other.statusClasses {
+		ep.statusClasses[class] += count
+	}
+
+	for _, latency := range other.latencies {
+		if len(ep.latencies) >= MaxLatencySamples {
+			break
+		}
+
+		ep.latencies = append(ep.latencies, latency)
+	}
+}
+
+// NOTE: Endpoints are sorted by the number of requests, the most requested
+// one comes first
+func (ls *linkSummary) toProto() *ui.LinkL7Summary {
+	endpoints := make([]*ui.HttpEndpointStats, 0, len(ls.endpoints))
+	for _, ep := range ls.endpoints {
+		endpoints = append(endpoints, ep.toProto())
+	}
+
+	slices.SortFunc(endpoints, func(lhs, rhs *ui.HttpEndpointStats) int {
+		switch {
+		case lhs.GetRequests() > rhs.GetRequests():
+			return -1
+		case lhs.GetRequests() < rhs.GetRequests():
+			return 1
+		}
+
+		if c := strings.Compare(lhs.GetPath(), rhs.GetPath()); c != 0 {
+			return c
+		}
+
+		return strings.Compare(lhs.GetMethod(), rhs.GetMethod())
+	})
+
+	return &ui.LinkL7Summary{
+		LinkId:        ls.id,
+		SourceId:      ls.srcId,
+		DestinationId: ls.destId,
+		HttpEndpoints: endpoints,
+	}
+}
+
+func (ep *endpoint) toProto() *ui.HttpEndpointStats {
+	classes := make([]*ui.HttpStatusClassCount, 0, len(ep.statusClasses))
+	for class, count := range ep.statusClasses {
+		classes = append(classes, &ui.HttpStatusClassCount{
+			StatusClass: class,
+			Count:       count,
+		})
+	}
+
+	slices.SortFunc(classes, func(lhs, rhs *ui.HttpStatusClassCount) int {
+		return strings.Compare(lhs.GetStatusClass(), rhs.GetStatusClass())
+	})
+
+	stats := &ui.HttpEndpointStats{
+		Method:        ep.key.method,
+		Path:          ep.key.path,
+		Requests:      ep.requests,
+		StatusClasses: classes,
+	}
+
+	if len(ep.latencies) == 0 {
+		return stats
+	}
+
+	sorted := slices.Clone(ep.latencies)
+	slices.Sort(sorted)
+
+	stats.LatencyP50 = percentile(sorted, 50)
+	stats.LatencyP90 = percentile(sorted, 90)
+	stats.LatencyP99 = percentile(sorted, 99)
+
+	return stats
+}
+
+// NOTE: Nearest-rank percentile of sorted latencies
+func percentile(sorted []uint64, p int) *durationpb.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return durationpb.New(time.Duration(sorted[rank-1]))
+}
+
+// NOTE: Query and host are dropped, numeric ids and UUIDs are collapsed,
+// e.g. "http://api/users/42/orders?page=2" becomes "/users/{id}/orders"
+func TemplatePath(rawURL string) string {
+	path := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		path = u.Path
+	}
+
+	if len(path) == 0 {
+		return "/"
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case numericSegment.MatchString(segment):
+			segments[i] = "{id}"
+		case uuidSegment.MatchString(segment):
+			segments[i] = "{uuid}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
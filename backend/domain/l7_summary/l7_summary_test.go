@@ -0,0 +1,134 @@
+package l7_summary
+
+import (
+	"testing"
+	"time"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+)
+
+func TestTemplatePath(t *testing.T) {
+	cases := map[string]string{
+		"http://api.shop:8080/users/42/orders?page=2":       "/users/{id}/orders",
+		"/carts/0b8f6c2e-6f4b-4c55-9a53-8f0c6e1d2a11/items": "/carts/{uuid}/items",
+		"http://api.shop/":      "/",
+		"http://api.shop":       "/",
+		"/v1/products/featured": "/v1/products/featured",
+	}
+
+	for rawURL, expected := range cases {
+		if path := TemplatePath(rawURL); path != expected {
+			t.Fatalf("expected %q to be templated as %q, got %q", rawURL, expected, path)
+		}
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	frontend := &pbFlow.Endpoint{Identity: 1001, Namespace: "shop", Labels: []string{"k8s:app=frontend"}}
+	api := &pbFlow.Endpoint{Identity: 1002, Namespace: "shop", Labels: []string{"k8s:app=api"}}
+
+	request := func(url string) *pbFlow.Flow {
+		return &pbFlow.Flow{
+			Source:      frontend,
+			Destination: api,
+			L4: &pbFlow.Layer4{Protocol: &pbFlow.Layer4_TCP{TCP: &pbFlow.TCP{
+				SourcePort: 43210, DestinationPort: 8080,
+			}}},
+			L7: &pbFlow.Layer7{
+				Type:   pbFlow.L7FlowType_REQUEST,
+				Record: &pbFlow.Layer7_Http{Http: &pbFlow.HTTP{Method: "get", Url: url}},
+			},
+		}
+	}
+
+	response := func(url string, code uint32, latency time.Duration) *pbFlow.Flow {
+		return &pbFlow.Flow{
+			Source:      api,
+			Destination: frontend,
+			L4: &pbFlow.Layer4{Protocol: &pbFlow.Layer4_TCP{TCP: &pbFlow.TCP{
+				SourcePort: 8080, DestinationPort: 43210,
+			}}},
+			L7: &pbFlow.Layer7{
+				Type:      pbFlow.L7FlowType_RESPONSE,
+				LatencyNs: uint64(latency),
+				Record: &pbFlow.Layer7_Http{Http: &pbFlow.HTTP{
+					Method: "GET", Url: url, Code: code,
+				}},
+			},
+		}
+	}
+
+	sameCard := func(id string) string { return id }
+
+	pbFlows := []*pbFlow.Flow{
+		request("/users/1"),
+		request("/users/2"),
+		request("/health"),
+		// NOTE: Not an HTTP flow
+		{Source: frontend, Destination: api},
+	}
+
+	for i := 1; i <= 10; i++ {
+		code := uint32(200)
+		if i == 10 {
+			code = 503
+		}
+
+		pbFlows = append(pbFlows, response("/users/7", code, time.Duration(i)*time.Millisecond))
+	}
+
+	agg := NewAggregator()
+	agg.ObserveFlows(flow.Wrap(pbFlows), sameCard)
+
+	results := agg.Flush()
+	if len(results) != 1 || results[0].EventKind != events.Added {
+		t.Fatalf("expected responses to be counted on the link of requests, got %v", results)
+	}
+
+	summary := results[0].Entry
+	if summary.GetSourceId() != "1001" || summary.GetDestinationId() != "1002" {
+		t.Fatalf("unexpected link of the summary: %v", summary)
+	}
+
+	endpoints := summary.GetHttpEndpoints()
+	if len(endpoints) != 2 {
+		t.Fatalf("expected two endpoints, got %v", endpoints)
+	}
+
+	users := endpoints[0]
+	if users.GetMethod() != "GET" || users.GetPath() != "/users/{id}" || users.GetRequests() != 2 {
+		t.Fatalf("unexpected most requested endpoint: %v", users)
+	}
+
+	classes := users.GetStatusClasses()
+	if len(classes) != 2 || classes[0].GetStatusClass() != "2xx" ||
+		classes[0].GetCount() != 9 || classes[1].GetStatusClass() != "5xx" {
+		t.Fatalf("unexpected status classes: %v", classes)
+	}
+
+	if p50 := users.GetLatencyP50().AsDuration(); p50 != 5*time.Millisecond {
+		t.Fatalf("expected p50 to be 5ms, got %v", p50)
+	}
+
+	if p99 := users.GetLatencyP99().AsDuration(); p99 != 10*time.Millisecond {
+		t.Fatalf("expected p99 to be 10ms, got %v", p99)
+	}
+
+	if health := endpoints[1]; health.GetPath() != "/health" || health.GetLatencyP50() != nil {
+		t.Fatalf("unexpected endpoint without responses: %v", health)
+	}
+
+	if results := agg.Flush(); len(results) != 0 {
+		t.Fatalf("expected nothing to flush, got %v", results)
+	}
+
+	agg.ObserveFlows(flow.Wrap([]*pbFlow.Flow{request("/health")}), sameCard)
+
+	results = agg.Flush()
+	if len(results) != 1 || results[0].EventKind != events.Modified {
+		t.Fatalf("expected the summary to be modified, got %v", results)
+	}
+}
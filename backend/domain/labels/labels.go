@@ -1,7 +1,9 @@
 package labels
 
 import (
+	"slices"
 	"strings"
+	"sync/atomic"
 )
 
 type ReservedLabel string
@@ -19,11 +21,17 @@ const (
 
 	SpecialLabelKubeDNS    SpecialLabel = "k8s:k8s-app=kube-dns"
 	SpecialLabelPrometheus SpecialLabel = "k8s:app=prometheus"
+
+	// NOTE: Cilium sets it on every endpoint it manages within ClusterMesh
+	ClusterNameKey = "io.cilium.k8s.policy.cluster"
 )
 
 var (
 	prefixes = []string{"k8s:", "io.kubernetes.pod.", "app.kubernetes.io/"}
-	appKeys  = []string{"app", "name", "functionName", "k8s-app"}
+
+	DefaultAppKeys = []string{"app", "name", "functionName", "k8s-app"}
+
+	appKeys atomic.Pointer[[]string]
 )
 
 type LabelProps struct {
@@ -38,9 +46,37 @@ type LabelProps struct {
 	AppName         *string
 }
 
+// NOTE: Keys are given in the order of precedence, the value of the first
+// key present in labels becomes the app name. Keys are normalized, so both
+// `app.kubernetes.io/name` and `name` can be used.
+func SetAppKeys(keys []string) {
+	normalized := make([]string, 0, len(keys))
+	for _, key := range keys {
+		key = NormalizeKey(strings.TrimSpace(key))
+		if len(key) == 0 || slices.Contains(normalized, key) {
+			continue
+		}
+
+		normalized = append(normalized, key)
+	}
+
+	appKeys.Store(&normalized)
+}
+
+func AppKeys() []string {
+	if keys := appKeys.Load(); keys != nil {
+		return *keys
+	}
+
+	return DefaultAppKeys
+}
+
 func Props(labels []string) *LabelProps {
 	props := new(LabelProps)
 
+	keys := AppKeys()
+	appKeyRank := len(keys)
+
 	for _, lbl := range labels {
 		k, v := LabelAsKeyValue(lbl, true)
 
@@ -59,11 +95,10 @@ func Props(labels []string) *LabelProps {
 			ReservedLabelKubeAPIServer,
 		)
 
-		if props.AppName != nil {
-			continue
+		if rank := slices.Index(keys, k); rank >= 0 && rank < appKeyRank {
+			appKeyRank = rank
+			props.AppName = &v
 		}
-
-		props.AppName = appNameFromKV(k, v)
 	}
 
 	return props
@@ -97,12 +132,16 @@ func NormalizeKey(labelKey string) string {
 	return labelKey
 }
 
-func appNameFromKV(k, v string) *string {
-	for _, appKey := range appKeys {
-		if k == appKey {
-			return &v
+// NOTE: Looks the label up by normalized key
+func Value(labels []string, key string) (string, bool) {
+	key = NormalizeKey(key)
+
+	for _, lbl := range labels {
+		k, v := LabelAsKeyValue(lbl, true)
+		if k == key {
+			return v, true
 		}
 	}
 
-	return nil
+	return "", false
 }
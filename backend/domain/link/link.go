@@ -2,6 +2,7 @@ package link
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -26,16 +27,33 @@ type Link struct {
 	LatenciesNs     []uint64
 	BytesTransfered uint64
 
+	// NOTE: Verdict keeps only the verdict of the last flow, these counters
+	// show the whole picture of the traffic going through the link
+	VerdictCounts map[pbFlow.Verdict]uint64
+	DropReasons   map[pbFlow.DropReason]uint64
+
+	// NOTE: k8s Service the flows were sent to as cilium reports it and its
+	// description from k8s API, the latter is set by the ones who can reach it
+	DestinationService *pbFlow.Service
+	K8sService         *ui.K8SServiceRef
+
 	ref *pbFlow.Flow
 }
 
-func FromFlowProto(f *pbFlow.Flow) *Link {
+// NOTE: readyBackends is nil when k8s Services are not known, see
+// service.IdsFromFlowProto
+func FromFlowProto(f *pbFlow.Flow, readyBackends service.ReadyBackendsCounter) *Link {
 	if f.GetL4() == nil || f.GetSource() == nil || f.GetDestination() == nil {
 		return nil
 	}
 
-	srcId, destId := service.IdsFromFlowProto(f)
+	srcId, destId := service.IdsFromFlowProto(f, readyBackends)
 	destPort, ipProtocol := portProtocolFromFlow(f)
+
+	var destService *pbFlow.Service
+	if len(f.GetDestinationService().GetName()) > 0 {
+		destService = f.GetDestinationService()
+	}
 	linkId := linkIdFromParts(srcId, destId, destPort, ipProtocol)
 
 	latencies := []uint64{}
@@ -47,6 +65,15 @@ func FromFlowProto(f *pbFlow.Flow) *Link {
 	bytesTransfered := GetFlowBytesTransfered(f)
 	isEncrypted := f.GetIP().GetEncrypted()
 
+	verdictCounts := map[pbFlow.Verdict]uint64{
+		f.GetVerdict(): 1,
+	}
+
+	dropReasons := map[pbFlow.DropReason]uint64{}
+	if reason := f.GetDropReasonDesc(); reason != pbFlow.DropReason_DROP_REASON_UNKNOWN {
+		dropReasons[reason] = 1
+	}
+
 	return &Link{
 		Id:              linkId,
 		SourceId:        srcId,
@@ -60,11 +87,55 @@ func FromFlowProto(f *pbFlow.Flow) *Link {
 		LatenciesNs:     latencies,
 		BytesTransfered: bytesTransfered,
 		IsEncrypted:     isEncrypted,
+		VerdictCounts:   verdictCounts,
+		DropReasons:     dropReasons,
+
+		DestinationService: destService,
 
 		ref: f,
 	}
 }
 
+// NOTE: L7 responses are sent back by the server, so the link they belong to
+// is the link of requests, i.e. the reversed one
+func FromL7FlowProto(f *pbFlow.Flow, readyBackends service.ReadyBackendsCounter) *Link {
+	if f.GetL7().GetType() != pbFlow.L7FlowType_RESPONSE {
+		return FromFlowProto(f, readyBackends)
+	}
+
+	reversed := &pbFlow.Flow{
+		Source:           f.GetDestination(),
+		Destination:      f.GetSource(),
+		SourceNames:      f.GetDestinationNames(),
+		DestinationNames: f.GetSourceNames(),
+		Verdict:          f.GetVerdict(),
+		L7:               f.GetL7(),
+	}
+
+	switch {
+	case f.GetL4().GetTCP() != nil:
+		tcp := f.GetL4().GetTCP()
+		reversed.L4 = &pbFlow.Layer4{Protocol: &pbFlow.Layer4_TCP{TCP: &pbFlow.TCP{
+			SourcePort:      tcp.GetDestinationPort(),
+			DestinationPort: tcp.GetSourcePort(),
+		}}}
+	case f.GetL4().GetUDP() != nil:
+		udp := f.GetL4().GetUDP()
+		reversed.L4 = &pbFlow.Layer4{Protocol: &pbFlow.Layer4_UDP{UDP: &pbFlow.UDP{
+			SourcePort:      udp.GetDestinationPort(),
+			DestinationPort: udp.GetSourcePort(),
+		}}}
+	}
+
+	return FromFlowProto(reversed, readyBackends)
+}
+
+func (l *Link) SetServiceIds(srcId, destId string) {
+	l.SourceId = srcId
+	l.DestinationId = destId
+	l.Id = linkIdFromParts(srcId, destId, l.DestinationPort, l.IPProtocol)
+}
+
 func getFlowLatency(f *pbFlow.Flow) uint64 {
 	l7 := f.GetL7()
 	if l7 == nil {
@@ -164,7 +235,62 @@ func (l *Link) ToProto() *ui.ServiceLink {
 		IpProtocol:      l.IPProtocol,
 		AuthType:        l.AuthType,
 		IsEncrypted:     l.IsEncrypted,
+		FlowAmount:      l.FlowAmount,
+		VerdictCounts:   l.verdictCountsProto(),
+		DropReasons:     l.dropReasonsProto(),
+		K8SService:      l.K8sService,
+	}
+}
+
+func (l *Link) verdictCountsProto() []*ui.VerdictCount {
+	return VerdictCountsToProto(l.VerdictCounts)
+}
+
+// NOTE: Verdicts are sorted by their numeric values
+func VerdictCountsToProto(verdicts map[pbFlow.Verdict]uint64) []*ui.VerdictCount {
+	counts := make([]*ui.VerdictCount, 0, len(verdicts))
+
+	for verdict, count := range verdicts {
+		counts = append(counts, &ui.VerdictCount{
+			Verdict: verdict,
+			Count:   count,
+		})
 	}
+
+	slices.SortFunc(counts, func(a, b *ui.VerdictCount) int {
+		return int(a.GetVerdict()) - int(b.GetVerdict())
+	})
+
+	return counts
+}
+
+func (l *Link) dropReasonsProto() []*ui.DropReasonCount {
+	return DropReasonsToProto(l.DropReasons)
+}
+
+// NOTE: Drop reasons are sorted by count, the most frequent comes first
+func DropReasonsToProto(reasons map[pbFlow.DropReason]uint64) []*ui.DropReasonCount {
+	counts := make([]*ui.DropReasonCount, 0, len(reasons))
+
+	for reason, count := range reasons {
+		counts = append(counts, &ui.DropReasonCount{
+			Reason: reason,
+			Count:  count,
+		})
+	}
+
+	slices.SortFunc(counts, func(a, b *ui.DropReasonCount) int {
+		switch {
+		case a.GetCount() > b.GetCount():
+			return -1
+		case a.GetCount() < b.GetCount():
+			return 1
+		}
+
+		return int(a.GetReason()) - int(b.GetReason())
+	})
+
+	return counts
 }
 
 func (l *Link) Equals(rhs *Link) bool {
@@ -188,9 +314,47 @@ func (l *Link) AccumulateLink(rhs *Link) *Link {
 	l.BytesTransfered += rhs.BytesTransfered
 	l.FlowAmount += rhs.FlowAmount
 
+	if l.VerdictCounts == nil {
+		l.VerdictCounts = make(map[pbFlow.Verdict]uint64)
+	}
+
+	for verdict, count := range rhs.VerdictCounts {
+		l.VerdictCounts[verdict] += count
+	}
+
+	if l.DropReasons == nil {
+		l.DropReasons = make(map[pbFlow.DropReason]uint64)
+	}
+
+	for reason, count := range rhs.DropReasons {
+		l.DropReasons[reason] += count
+	}
+
+	if l.DestinationService == nil {
+		l.DestinationService = rhs.DestinationService
+	}
+
 	return l
 }
 
+// NOTE: Returns true if rhs has verdicts or drop reasons that were not seen
+// on this link before
+func (l *Link) HasNewCountersFrom(rhs *Link) bool {
+	for verdict := range rhs.VerdictCounts {
+		if _, exists := l.VerdictCounts[verdict]; !exists {
+			return true
+		}
+	}
+
+	for reason := range rhs.DropReasons {
+		if _, exists := l.DropReasons[reason]; !exists {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (l *Link) DropStats() *Link {
 	l.BytesTransfered = 0
 	l.LatenciesNs = []uint64{}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+)
+
+type GroupingMode string
+
+const (
+	GroupByIdentity  GroupingMode = "identity"
+	GroupByWorkload  GroupingMode = "workload"
+	GroupByLabel     GroupingMode = "label"
+	GroupByNamespace GroupingMode = "namespace"
+)
+
+// NOTE: Grouping defines which endpoints end up on the same service card.
+// Endpoints that can't be grouped the selected way (no workload, no label,
+// no namespace) fall back to grouping by identity, world-like endpoints are
+// always grouped by DNS name.
+type Grouping struct {
+	Mode GroupingMode
+
+	// The label key used to group endpoints with GroupByLabel mode
+	LabelKey string
+}
+
+var (
+	grouping atomic.Pointer[Grouping]
+)
+
+func ParseGroupingMode(str string) (GroupingMode, error) {
+	mode := GroupingMode(strings.ToLower(strings.TrimSpace(str)))
+
+	switch mode {
+	case GroupByIdentity, GroupByWorkload, GroupByLabel, GroupByNamespace:
+		return mode, nil
+	case "":
+		return GroupByIdentity, nil
+	}
+
+	return "", fmt.Errorf("unknown service grouping mode '%s'", str)
+}
+
+// NOTE: Grouping is set once on startup, ids of services and links computed
+// before the call are not updated
+func SetGrouping(g Grouping) error {
+	if _, err := ParseGroupingMode(string(g.Mode)); err != nil {
+		return err
+	}
+
+	if g.Mode == GroupByLabel && len(strings.TrimSpace(g.LabelKey)) == 0 {
+		return fmt.Errorf("label key is required for '%s' service grouping", g.Mode)
+	}
+
+	grouping.Store(&g)
+	return nil
+}
+
+func CurrentGrouping() Grouping {
+	if g := grouping.Load(); g != nil {
+		return *g
+	}
+
+	return Grouping{Mode: GroupByIdentity}
+}
+
+// NOTE: Returns the id and the name of the group the endpoint belongs to,
+// empty id means that endpoint is grouped by identity
+func (g Grouping) groupOf(
+	namespace string, lbls []string, workload *workloadRef,
+) (string, string) {
+	switch g.Mode {
+	case GroupByWorkload:
+		if workload != nil {
+			return fmt.Sprintf("%s/%s/%s", namespace, workload.kind, workload.name),
+				workload.name
+		}
+	case GroupByLabel:
+		if value, exists := labels.Value(lbls, g.LabelKey); exists {
+			key := labels.NormalizeKey(g.LabelKey)
+			return fmt.Sprintf("%s/%s=%s", namespace, key, value), value
+		}
+	case GroupByNamespace:
+		if len(namespace) > 0 {
+			return "namespace/" + namespace, namespace
+		}
+	}
+
+	return "", ""
+}
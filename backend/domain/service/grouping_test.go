@@ -0,0 +1,66 @@
+package service
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+)
+
+func TestGrouping(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetGrouping(Grouping{Mode: GroupByIdentity})
+	})
+
+	f := &pbFlow.Flow{
+		Source: &pbFlow.Endpoint{
+			Identity:  1001,
+			Namespace: "shop",
+			Labels: []string{
+				"k8s:app=frontend",
+				"k8s:app.kubernetes.io/part-of=storefront",
+			},
+			Workloads: []*pbFlow.Workload{{Kind: "Deployment", Name: "frontend"}},
+		},
+		Destination: &pbFlow.Endpoint{
+			Identity: 2,
+			Labels:   []string{"reserved:world"},
+		},
+		DestinationNames: []string{"example.com"},
+	}
+
+	cases := []struct {
+		grouping Grouping
+		id       string
+		name     string
+	}{
+		{Grouping{Mode: GroupByIdentity}, "1001", "frontend"},
+		{Grouping{Mode: GroupByWorkload}, "shop/Deployment/frontend", "frontend"},
+		{Grouping{Mode: GroupByLabel, LabelKey: "app.kubernetes.io/part-of"}, "shop/part-of=storefront", "storefront"},
+		{Grouping{Mode: GroupByLabel, LabelKey: "team"}, "1001", "frontend"},
+		{Grouping{Mode: GroupByNamespace}, "namespace/shop", "shop"},
+	}
+
+	for _, c := range cases {
+		if err := SetGrouping(c.grouping); err != nil {
+			t.Fatalf("failed to set grouping %v: %v", c.grouping, err)
+		}
+
+		srcId, dstId := IdsFromFlowProto(f, nil)
+		if srcId != c.id {
+			t.Fatalf("%v: expected source id %q, got %q", c.grouping, c.id, srcId)
+		}
+
+		if dstId != "example.com-receiver" {
+			t.Fatalf("%v: world service must not be grouped, got %q", c.grouping, dstId)
+		}
+
+		svc := FromEndpointProtoAndDNS(f, f.GetSource(), nil)
+		if svc.Id() != c.id || svc.Name() != c.name {
+			t.Fatalf("%v: expected %q/%q, got %q/%q", c.grouping, c.id, c.name, svc.Id(), svc.Name())
+		}
+	}
+
+	if err := SetGrouping(Grouping{Mode: GroupByLabel}); err == nil {
+		t.Fatalf("expected error for label grouping without label key")
+	}
+}
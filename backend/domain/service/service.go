@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"sync/atomic"
 
 	pbFlow "github.com/cilium/cilium/api/v1/flow"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -21,6 +23,24 @@ type Service struct {
 	dnsNames   []string
 	isSender   bool
 	isReceiver bool
+
+	// NOTE: Set when service is merged into the card of the same workload
+	// that had another id, see cache.DataCache
+	cardId    string
+	idAliases []string
+
+	isBoundary bool
+
+	// NOTE: Policy status of cilium endpoints of the card, it's not reported
+	// in flows and is set by the ones who know the endpoints
+	ingressEnforced  bool
+	egressEnforced   bool
+	visibilityStatus string
+
+	// NOTE: Set for k8s Service that has no ready backends, flows to it are
+	// not translated to any pod, so destination looks like world
+	k8sService *pbFlow.Service
+	k8sLabels  []string
 }
 
 func FromEndpointProtoAndDNS(
@@ -38,7 +58,11 @@ func FromEndpointProtoAndDNS(
 	return svc
 }
 
-func IdsFromFlowProto(f *pbFlow.Flow) (string, string) {
+// NOTE: readyBackends tells k8s Services without endpoints from the ones
+// pointing outside of the cluster, it's nil when k8s Services are not known
+func IdsFromFlowProto(
+	f *pbFlow.Flow, readyBackends ReadyBackendsCounter,
+) (string, string) {
 	sourceProps := labels.Props(f.GetSource().GetLabels())
 	destProps := labels.Props(f.GetDestination().GetLabels())
 
@@ -50,6 +74,10 @@ func IdsFromFlowProto(f *pbFlow.Flow) (string, string) {
 		f.GetDestination(), f.GetDestinationNames(), destProps, true,
 	)
 
+	if k8sSvc := K8sServiceWithoutEndpoints(f, readyBackends); k8sSvc != nil {
+		receiverSvcId = k8sServiceId(k8sSvc)
+	}
+
 	return senderSvcId, receiverSvcId
 }
 
@@ -80,6 +108,20 @@ func (s *Service) Side() string {
 
 // TODO: its not ok to have this code here
 func (s *Service) ToProto() *pbUi.Service {
+	if s.k8sService != nil {
+		return &pbUi.Service{
+			Id:                 s.Id(),
+			Name:               s.Name(),
+			Namespace:          s.k8sService.GetNamespace(),
+			Labels:             s.k8sLabels,
+			DnsNames:           s.dnsNames,
+			IdAliases:          s.idAliases,
+			IsBoundary:         s.isBoundary,
+			IsWithoutEndpoints: true,
+			CreationTimestamp:  timestamppb.Now(),
+		}
+	}
+
 	return &pbUi.Service{
 		Id:                     s.Id(),
 		Name:                   s.Name(),
@@ -88,14 +130,25 @@ func (s *Service) ToProto() *pbUi.Service {
 		DnsNames:               s.dnsNames,
 		Workloads:              s.endpoint.GetWorkloads(),
 		Identity:               s.endpoint.GetIdentity(),
-		EgressPolicyEnforced:   false,
-		IngressPolicyEnforced:  false,
-		VisibilityPolicyStatus: "",
+		IdAliases:              s.idAliases,
+		IsBoundary:             s.isBoundary,
+		Cluster:                ClusterName(s.endpoint),
+		EgressPolicyEnforced:   s.egressEnforced,
+		IngressPolicyEnforced:  s.ingressEnforced,
+		VisibilityPolicyStatus: s.visibilityStatus,
 		CreationTimestamp:      timestamppb.Now(),
 	}
 }
 
 func (s *Service) Name() string {
+	if s.k8sService != nil {
+		return s.k8sService.GetName()
+	}
+
+	if name := s.groupName(); len(name) > 0 {
+		return name
+	}
+
 	serviceName := s.Id()
 	if s.LabelProps.AppName != nil {
 		serviceName = *s.LabelProps.AppName
@@ -103,6 +156,47 @@ func (s *Service) Name() string {
 	return serviceName
 }
 
+func (s *Service) groupName() string {
+	if s.LabelProps.IsWorld || s.endpoint.GetIdentity() == 0 {
+		return ""
+	}
+
+	_, name := CurrentGrouping().groupOf(
+		s.endpoint.GetNamespace(), s.endpoint.GetLabels(), firstWorkload(s.endpoint),
+	)
+
+	return name
+}
+
+// NOTE: Boundary service is outside of the scope the map is built for
+func (s *Service) SetIsBoundary(state bool) {
+	s.isBoundary = state
+}
+
+func (s *Service) SetPolicyStatus(ingress, egress bool, visibility string) {
+	s.ingressEnforced = ingress
+	s.egressEnforced = egress
+	s.visibilityStatus = visibility
+}
+
+func (s *Service) SetK8sServiceWithoutEndpoints(k8sSvc *pbFlow.Service) {
+	s.k8sService = k8sSvc
+}
+
+func (s *Service) K8sServiceWithoutEndpoints() *pbFlow.Service {
+	return s.k8sService
+}
+
+// NOTE: Labels of k8s Service object, they are set once the object is
+// looked up in k8s API
+func (s *Service) SetK8sLabels(lbls []string) {
+	s.k8sLabels = lbls
+}
+
+func (s *Service) Endpoint() *pbFlow.Endpoint {
+	return s.endpoint
+}
+
 func (s *Service) SetIsSender(state bool) {
 	s.isSender = state
 }
@@ -112,9 +206,50 @@ func (s *Service) SetIsReceiver(state bool) {
 }
 
 func (s *Service) Id() string {
+	if len(s.cardId) > 0 {
+		return s.cardId
+	}
+
+	return s.OriginalId()
+}
+
+// NOTE: The id computed from the endpoint itself, it differs from Id() when
+// the service is shown on the card created for its previous identity
+func (s *Service) OriginalId() string {
+	if s.k8sService != nil {
+		return k8sServiceId(s.k8sService)
+	}
+
 	return getServiceId(s.endpoint, s.dnsNames, s.LabelProps, s.isReceiver)
 }
 
+func (s *Service) SetCardId(id string, aliases []string) {
+	s.cardId = id
+	s.idAliases = aliases
+}
+
+// NOTE: Key identifying the workload behind the service regardless of its
+// identity. App label is not enough for that, since different workloads can
+// share it. Empty for world-like, not namespaced services and services that
+// come without workloads in the flows.
+func (s *Service) WorkloadKey() string {
+	ns := s.endpoint.GetNamespace()
+	if s.LabelProps.IsWorld || len(ns) == 0 {
+		return ""
+	}
+
+	wl := firstWorkload(s.endpoint)
+	if wl == nil {
+		return ""
+	}
+
+	// NOTE: The same workload in another cluster is another workload
+	return withCluster(
+		ClusterName(s.endpoint),
+		fmt.Sprintf("%s/%s/%s", ns, wl.kind, wl.name),
+	)
+}
+
 func (s *Service) FlowRef() *pbFlow.Flow {
 	return s.flowRef
 }
@@ -143,26 +278,41 @@ func (l *Service) IsEnrichedWith(r *Service) bool {
 	})
 }
 
-// NOTE: This function delivers an id of service in terms of UI service cards
+// NOTE: This function delivers an id of service in terms of UI service cards,
+// the same workloads of different clusters get different ids
 func getServiceId(
 	ep *pbFlow.Endpoint,
 	dnsNames []string,
 	lblProps *labels.LabelProps,
 	isReceiver bool,
 ) string {
+	return withCluster(
+		ClusterName(ep), localServiceId(ep, dnsNames, lblProps, isReceiver),
+	)
+}
+
+func localServiceId(
+	ep *pbFlow.Endpoint,
+	dnsNames []string,
+	lblProps *labels.LabelProps,
+	isReceiver bool,
+) string {
+	workload := firstWorkload(ep)
+
 	if !lblProps.IsWorld && ep.GetIdentity() > 0 {
+		groupId, _ := CurrentGrouping().groupOf(
+			ep.GetNamespace(), ep.GetLabels(), workload,
+		)
+
+		if len(groupId) > 0 {
+			return groupId
+		}
+
 		return strconv.FormatUint(uint64(ep.GetIdentity()), 10)
 	}
 
-	// NOTE: By some reason, workloads not available for the same service every time
-	if len(ep.GetWorkloads()) > 0 {
-		wl := ep.GetWorkloads()[0]
-		name := wl.GetName()
-		kind := wl.GetKind()
-
-		if len(name) > 0 && len(kind) > 0 {
-			return fmt.Sprintf("%s/%s", kind, name)
-		}
+	if workload != nil {
+		return fmt.Sprintf("%s/%s", workload.kind, workload.name)
 	}
 
 	// NOTE: We only use side prefix for world-like services
@@ -177,3 +327,106 @@ func getServiceId(
 
 	return "world-" + sideStr
 }
+
+// NOTE: Name of ClusterMesh cluster the endpoint belongs to, the label is
+// used for the endpoints that come without the name
+func ClusterName(ep *pbFlow.Endpoint) string {
+	if name := ep.GetClusterName(); len(name) > 0 {
+		return name
+	}
+
+	name, _ := labels.Value(ep.GetLabels(), labels.ClusterNameKey)
+	return name
+}
+
+// NOTE: Local cluster is set once on startup from cilium cluster name. When
+// it's unknown, no id is prefixed, since there is no way to tell which of the
+// clusters seen in the flows is the local one.
+func SetLocalCluster(name string) {
+	if name = strings.TrimSpace(name); len(name) == 0 {
+		localCluster.Store(nil)
+		return
+	}
+
+	localCluster.Store(&name)
+}
+
+func isLocalCluster(cluster string) bool {
+	local := localCluster.Load()
+	return local == nil || *local == cluster
+}
+
+// NOTE: Ids of the services of local cluster are kept as is, so that they
+// are the same with and without ClusterMesh and the cluster is only seen in
+// the cluster field of the service
+func withCluster(cluster, id string) string {
+	if len(cluster) == 0 || isLocalCluster(cluster) {
+		return id
+	}
+
+	return fmt.Sprintf("cluster:%s/%s", cluster, id)
+}
+
+type workloadRef struct {
+	kind string
+	name string
+}
+
+// NOTE: By some reason, workloads not available for the same service every time
+func firstWorkload(ep *pbFlow.Endpoint) *workloadRef {
+	if len(ep.GetWorkloads()) == 0 {
+		return nil
+	}
+
+	wl := ep.GetWorkloads()[0]
+	if len(wl.GetName()) == 0 || len(wl.GetKind()) == 0 {
+		return nil
+	}
+
+	return &workloadRef{kind: wl.GetKind(), name: wl.GetName()}
+}
+
+// NOTE: Returns the number of ready backends of k8s Service, isKnown is
+// false if the Service object is not known
+type ReadyBackendsCounter func(namespace, name string) (ready uint32, isKnown bool)
+
+var (
+	localCluster atomic.Pointer[string]
+)
+
+// NOTE: Returns k8s Service the flow is sent to if the Service has no ready
+// backends, in that case destination is reported as world or by DNS name
+// instead of a pod. Destination alone is not enough, since a Service can
+// legitimately point to something outside of the cluster. Without
+// readyBackends, Services are classified by the destinations only.
+func K8sServiceWithoutEndpoints(
+	f *pbFlow.Flow, readyBackends ReadyBackendsCounter,
+) *pbFlow.Service {
+	k8sSvc := f.GetDestinationService()
+	if k8sSvc == nil || len(k8sSvc.GetName()) == 0 {
+		return nil
+	}
+
+	dest := f.GetDestination()
+	if len(dest.GetPodName()) > 0 {
+		return nil
+	}
+
+	props := labels.Props(dest.GetLabels())
+	if !props.IsWorld && len(f.GetDestinationNames()) == 0 {
+		return nil
+	}
+
+	if readyBackends != nil {
+		ready, isKnown := readyBackends(k8sSvc.GetNamespace(), k8sSvc.GetName())
+		if isKnown && ready > 0 {
+			return nil
+		}
+	}
+
+	return k8sSvc
+}
+
+func k8sServiceId(k8sSvc *pbFlow.Service) string {
+	return fmt.Sprintf("k8s-service/%s/%s", k8sSvc.GetNamespace(), k8sSvc.GetName())
+}
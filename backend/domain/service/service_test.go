@@ -0,0 +1,173 @@
+package service
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+)
+
+func TestK8sServiceWithoutEndpoints(t *testing.T) {
+	client := &pbFlow.Endpoint{
+		Identity:  1001,
+		Namespace: "shop",
+		PodName:   "frontend-1",
+		Labels:    []string{"k8s:app=frontend"},
+	}
+
+	f := &pbFlow.Flow{
+		Source: client,
+		Destination: &pbFlow.Endpoint{
+			Identity: 2,
+			Labels:   []string{"reserved:world"},
+		},
+		DestinationService: &pbFlow.Service{Name: "backend", Namespace: "shop"},
+	}
+
+	_, dstId := IdsFromFlowProto(f, nil)
+	if dstId != "k8s-service/shop/backend" {
+		t.Fatalf("unexpected destination id: %s", dstId)
+	}
+
+	svc := FromEndpointProtoAndDNS(f, f.GetDestination(), nil)
+	svc.SetIsReceiver(true)
+	svc.SetK8sServiceWithoutEndpoints(K8sServiceWithoutEndpoints(f, nil))
+
+	pb := svc.ToProto()
+	if pb.GetId() != dstId || pb.GetName() != "backend" || pb.GetNamespace() != "shop" {
+		t.Fatalf("unexpected service card: %v", pb)
+	}
+
+	if !pb.GetIsWithoutEndpoints() {
+		t.Fatalf("expected service card to be marked as one without endpoints")
+	}
+
+	// NOTE: Service translated to a pod is a regular one
+	f.Destination = &pbFlow.Endpoint{
+		Identity:  1002,
+		Namespace: "shop",
+		PodName:   "backend-1",
+		Labels:    []string{"k8s:app=backend"},
+	}
+
+	if K8sServiceWithoutEndpoints(f, nil) != nil {
+		t.Fatalf("expected service with backends not to be reported")
+	}
+}
+
+func TestK8sServiceWithReadyBackends(t *testing.T) {
+	f := &pbFlow.Flow{
+		Destination: &pbFlow.Endpoint{
+			Identity: 2,
+			Labels:   []string{"reserved:world"},
+		},
+		DestinationService: &pbFlow.Service{Name: "backend", Namespace: "shop"},
+	}
+
+	backends := map[string]uint32{"shop/backend": 0}
+	counter := func(namespace, name string) (uint32, bool) {
+		ready, isKnown := backends[namespace+"/"+name]
+		return ready, isKnown
+	}
+
+	if K8sServiceWithoutEndpoints(f, counter) == nil {
+		t.Fatalf("expected service without ready backends to be reported")
+	}
+
+	// NOTE: Service pointing outside of the cluster is not the one without
+	// endpoints, even though its flows go to the world
+	backends["shop/backend"] = 2
+	if K8sServiceWithoutEndpoints(f, counter) != nil {
+		t.Fatalf("expected service with ready backends not to be reported")
+	}
+
+	delete(backends, "shop/backend")
+	if K8sServiceWithoutEndpoints(f, counter) == nil {
+		t.Fatalf("expected unknown service to be classified by its flows")
+	}
+}
+
+func TestClusterAwareIds(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetGrouping(Grouping{Mode: GroupByIdentity})
+		SetLocalCluster("")
+	})
+
+	if err := SetGrouping(Grouping{Mode: GroupByWorkload}); err != nil {
+		t.Fatalf("failed to set grouping: %v", err)
+	}
+
+	SetLocalCluster("east")
+
+	api := func(cluster string, identity uint32) *pbFlow.Endpoint {
+		return &pbFlow.Endpoint{
+			Identity:    identity,
+			ClusterName: cluster,
+			Namespace:   "shop",
+			Labels:      []string{"k8s:app=api"},
+			Workloads:   []*pbFlow.Workload{{Kind: "Deployment", Name: "api"}},
+		}
+	}
+
+	f := &pbFlow.Flow{Source: api("east", 1001), Destination: api("west", 1001)}
+	srcId, destId := IdsFromFlowProto(f, nil)
+
+	if srcId != "shop/Deployment/api" || destId != "cluster:west/shop/Deployment/api" {
+		t.Fatalf("expected only workloads of remote clusters to be prefixed: %s, %s", srcId, destId)
+	}
+
+	// NOTE: The label is used when the endpoint comes without cluster name
+	ep := api("", 1001)
+	ep.Labels = append(ep.Labels, "k8s:io.cilium.k8s.policy.cluster=east")
+
+	svc := FromEndpointProtoAndDNS(f, ep, nil)
+	if svc.Id() != srcId || svc.ToProto().GetCluster() != "east" {
+		t.Fatalf("unexpected service of labeled endpoint: %v", svc.ToProto())
+	}
+
+	remote := FromEndpointProtoAndDNS(f, api("west", 1001), nil)
+	if key := remote.WorkloadKey(); key != "cluster:west/shop/Deployment/api" {
+		t.Fatalf("expected workload key to be cluster aware: %v", key)
+	}
+
+	if id := getServiceId(api("", 1001), nil, svc.LabelProps, false); id != "shop/Deployment/api" {
+		t.Fatalf("expected id without cluster to be kept as is: %s", id)
+	}
+
+	// NOTE: Unknown local cluster is not guessed from the order of the flows
+	SetLocalCluster("")
+
+	f = &pbFlow.Flow{Source: api("west", 1001), Destination: api("east", 1001)}
+	srcId, destId = IdsFromFlowProto(f, nil)
+	if srcId != "shop/Deployment/api" || destId != srcId {
+		t.Fatalf("expected no ids to be prefixed without local cluster: %s, %s", srcId, destId)
+	}
+}
+
+// NOTE: Endpoints of a cluster without ClusterMesh still have the cluster
+// label, their ids must be the same as they were before clusters were known
+func TestSingleClusterIds(t *testing.T) {
+	t.Cleanup(func() {
+		SetLocalCluster("")
+	})
+
+	SetLocalCluster("")
+
+	ep := func(identity uint32, app string) *pbFlow.Endpoint {
+		return &pbFlow.Endpoint{
+			Identity:  identity,
+			Namespace: "shop",
+			Labels:    []string{"k8s:app=" + app, "k8s:io.cilium.k8s.policy.cluster=default"},
+		}
+	}
+
+	f := &pbFlow.Flow{Source: ep(1001, "frontend"), Destination: ep(1002, "api")}
+	srcId, destId := IdsFromFlowProto(f, nil)
+	if srcId != "1001" || destId != "1002" {
+		t.Fatalf("expected ids to be kept as is: %s, %s", srcId, destId)
+	}
+
+	svc := FromEndpointProtoAndDNS(f, f.GetDestination(), nil)
+	if pb := svc.ToProto(); pb.GetId() != "1002" || pb.GetCluster() != "default" {
+		t.Fatalf("expected cluster to be set only in the cluster field: %v", pb)
+	}
+}
@@ -0,0 +1,228 @@
+package service_endpoints
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+// NOTE: Returns cilium endpoint of the pod, nil if the pod has none
+type StatusResolver interface {
+	Status(namespace, podName string) *ui.CiliumEndpointStatus
+}
+
+type pod struct {
+	namespace string
+	name      string
+}
+
+// NOTE: Policy status shown on the service card
+type Policy struct {
+	CardId          string
+	IngressEnforced bool
+	EgressEnforced  bool
+
+	// NOTE: "OK" if every endpoint is ready, e.g. "1/3 endpoints ready"
+	// otherwise, empty if no endpoints are known
+	VisibilityStatus string
+}
+
+type card struct {
+	id string
+
+	// NOTE: Pod -> whether its endpoint was ever known, pods whose endpoints
+	// are gone after that are forgotten
+	pods map[pod]bool
+
+	reported       *ui.ServiceEndpoints
+	reportedPolicy *Policy
+}
+
+// NOTE: Aggregator collects pods seen behind service cards in flows and
+// reports cilium endpoints of those pods per card. Endpoints change without
+// any flows, e.g. when they are regenerated, so Flush is meant to be called
+// periodically and reports only the cards whose endpoints have changed.
+type Aggregator struct {
+	resolver StatusResolver
+	cards    map[string]*card
+}
+
+func NewAggregator(resolver StatusResolver) *Aggregator {
+	return &Aggregator{
+		resolver: resolver,
+		cards:    make(map[string]*card),
+	}
+}
+
+// NOTE: cardId maps ids of services to ids of the cards they are shown on
+func (a *Aggregator) ObserveFlows(flows []*flow.Flow, cardId func(string) string) {
+	for _, f := range flows {
+		ref := f.Ref()
+		srcId, destId := f.ServiceIds()
+
+		a.observeEndpoint(cardId(srcId), ref.GetSource())
+		a.observeEndpoint(cardId(destId), ref.GetDestination())
+	}
+}
+
+func (a *Aggregator) observeEndpoint(cardId string, ep *pbFlow.Endpoint) {
+	if ep == nil || len(ep.GetNamespace()) == 0 || len(ep.GetPodName()) == 0 {
+		return
+	}
+
+	c, exists := a.cards[cardId]
+	if !exists {
+		c = &card{
+			id:   cardId,
+			pods: make(map[pod]bool),
+		}
+
+		a.cards[cardId] = c
+	}
+
+	p := pod{namespace: ep.GetNamespace(), name: ep.GetPodName()}
+	if _, exists := c.pods[p]; !exists {
+		c.pods[p] = false
+	}
+}
+
+// NOTE: Returned policy is considered reported, Flush reports it again only
+// when it changes
+func (a *Aggregator) Policy(cardId string) Policy {
+	c, exists := a.cards[cardId]
+	if !exists {
+		return Policy{CardId: cardId}
+	}
+
+	policy := policyOf(c.id, c.toProto(a.statuses(c)))
+	c.reportedPolicy = &policy
+
+	return policy
+}
+
+// NOTE: Besides changed endpoints, returns policies of the cards that have
+// changed since they were reported, so that the cards can be updated
+func (a *Aggregator) Flush() ([]cache.Result[*ui.ServiceEndpoints], []Policy) {
+	results := make([]cache.Result[*ui.ServiceEndpoints], 0)
+	policies := make([]Policy, 0)
+
+	for _, c := range a.cards {
+		current := c.toProto(a.statuses(c))
+
+		if c.reportedPolicy != nil {
+			if policy := policyOf(c.id, current); policy != *c.reportedPolicy {
+				policies = append(policies, policy)
+				c.reportedPolicy = &policy
+			}
+		}
+
+		// NOTE: Cards without known endpoints are not reported until they
+		// have some
+		if c.reported == nil && len(current.GetEndpoints()) == 0 {
+			continue
+		}
+
+		if proto.Equal(c.reported, current) {
+			continue
+		}
+
+		kind := events.Modified
+		if c.reported == nil {
+			kind = events.Added
+		}
+
+		results = append(results, cache.Result[*ui.ServiceEndpoints]{
+			Entry:     current,
+			EventKind: kind,
+		})
+
+		c.reported = current
+	}
+
+	slices.SortFunc(results, func(lhs, rhs cache.Result[*ui.ServiceEndpoints]) int {
+		return strings.Compare(lhs.Entry.GetServiceId(), rhs.Entry.GetServiceId())
+	})
+
+	slices.SortFunc(policies, func(lhs, rhs Policy) int {
+		return strings.Compare(lhs.CardId, rhs.CardId)
+	})
+
+	return results, policies
+}
+
+// NOTE: Policy is reported as enforced on the card only if it's enforced on
+// every known endpoint of the card
+func policyOf(cardId string, se *ui.ServiceEndpoints) Policy {
+	policy := Policy{CardId: cardId}
+
+	eps := se.GetEndpoints()
+	if len(eps) == 0 {
+		return policy
+	}
+
+	policy.IngressEnforced, policy.EgressEnforced = true, true
+	for _, st := range eps {
+		policy.IngressEnforced = policy.IngressEnforced && st.GetIngressPolicyEnforced()
+		policy.EgressEnforced = policy.EgressEnforced && st.GetEgressPolicyEnforced()
+	}
+
+	policy.VisibilityStatus = "OK"
+	if int(se.GetReady()) != len(eps) {
+		policy.VisibilityStatus = fmt.Sprintf("%d/%d endpoints ready", se.GetReady(), len(eps))
+	}
+
+	return policy
+}
+
+func (a *Aggregator) statuses(c *card) []*ui.CiliumEndpointStatus {
+	statuses := make([]*ui.CiliumEndpointStatus, 0, len(c.pods))
+
+	for p, wasKnown := range c.pods {
+		st := a.resolver.Status(p.namespace, p.name)
+		switch {
+		case st != nil:
+			c.pods[p] = true
+			statuses = append(statuses, st)
+		case wasKnown:
+			delete(c.pods, p)
+		}
+	}
+
+	slices.SortFunc(statuses, func(lhs, rhs *ui.CiliumEndpointStatus) int {
+		if ns := strings.Compare(lhs.GetNamespace(), rhs.GetNamespace()); ns != 0 {
+			return ns
+		}
+
+		return strings.Compare(lhs.GetPodName(), rhs.GetPodName())
+	})
+
+	return statuses
+}
+
+func (c *card) toProto(statuses []*ui.CiliumEndpointStatus) *ui.ServiceEndpoints {
+	se := &ui.ServiceEndpoints{
+		ServiceId: c.id,
+		Endpoints: statuses,
+	}
+
+	for _, st := range statuses {
+		switch st.GetState() {
+		case ui.EndpointState_ENDPOINT_READY:
+			se.Ready += 1
+		case ui.EndpointState_ENDPOINT_REGENERATING:
+			se.Regenerating += 1
+		case ui.EndpointState_ENDPOINT_NOT_READY:
+			se.NotReady += 1
+		}
+	}
+
+	return se
+}
@@ -0,0 +1,98 @@
+package service_endpoints
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+type resolver map[string]*ui.CiliumEndpointStatus
+
+func (r resolver) Status(namespace, podName string) *ui.CiliumEndpointStatus {
+	return r[namespace+"/"+podName]
+}
+
+func status(pod string, state ui.EndpointState, enforced bool) *ui.CiliumEndpointStatus {
+	return &ui.CiliumEndpointStatus{
+		PodName:               pod,
+		Namespace:             "shop",
+		State:                 state,
+		IngressPolicyEnforced: enforced,
+		EgressPolicyEnforced:  true,
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	frontend := func(pod string) *pbFlow.Endpoint {
+		return &pbFlow.Endpoint{Identity: 1001, Namespace: "shop", PodName: pod, Labels: []string{"k8s:app=frontend"}}
+	}
+
+	api := &pbFlow.Endpoint{Identity: 1002, Namespace: "shop", PodName: "api-1", Labels: []string{"k8s:app=api"}}
+
+	res := resolver{
+		"shop/frontend-1": status("frontend-1", ui.EndpointState_ENDPOINT_READY, true),
+		"shop/frontend-2": status("frontend-2", ui.EndpointState_ENDPOINT_REGENERATING, false),
+	}
+
+	sameCard := func(id string) string { return id }
+
+	agg := NewAggregator(res)
+	agg.ObserveFlows(flow.Wrap([]*pbFlow.Flow{
+		{Source: frontend("frontend-1"), Destination: api},
+		{Source: frontend("frontend-2"), Destination: api},
+	}), sameCard)
+
+	// NOTE: api-1 has no cilium endpoint, so its card is not reported
+	results, _ := agg.Flush()
+	if len(results) != 1 || results[0].EventKind != events.Added {
+		t.Fatalf("expected one added card, got %v", results)
+	}
+
+	se := results[0].Entry
+	if se.GetServiceId() != "1001" || len(se.GetEndpoints()) != 2 {
+		t.Fatalf("unexpected endpoints of the card: %v", se)
+	}
+
+	if se.GetReady() != 1 || se.GetRegenerating() != 1 || se.GetNotReady() != 0 {
+		t.Fatalf("unexpected endpoint counters: %v", se)
+	}
+
+	policy := agg.Policy("1001")
+	if policy.IngressEnforced || !policy.EgressEnforced {
+		t.Fatalf("expected only egress policy to be enforced on every endpoint")
+	}
+
+	if policy.VisibilityStatus != "1/2 endpoints ready" {
+		t.Fatalf("unexpected visibility status: %s", policy.VisibilityStatus)
+	}
+
+	if agg.Policy("1002").VisibilityStatus != "" {
+		t.Fatalf("expected card without endpoints to have no status")
+	}
+
+	if results, policies := agg.Flush(); len(results) != 0 || len(policies) != 0 {
+		t.Fatalf("expected nothing to flush, got %v and %v", results, policies)
+	}
+
+	res["shop/frontend-2"] = status("frontend-2", ui.EndpointState_ENDPOINT_READY, true)
+	delete(res, "shop/frontend-1")
+
+	results, policies := agg.Flush()
+	if len(results) != 1 || results[0].EventKind != events.Modified {
+		t.Fatalf("expected the card to be modified, got %v", results)
+	}
+
+	if se := results[0].Entry; len(se.GetEndpoints()) != 1 || se.GetReady() != 1 {
+		t.Fatalf("expected gone endpoint to be dropped: %v", se)
+	}
+
+	// NOTE: Policy of the card is reported again once it has changed
+	expected := Policy{CardId: "1001", IngressEnforced: true, EgressEnforced: true, VisibilityStatus: "OK"}
+	if len(policies) != 1 || policies[0] != expected {
+		t.Fatalf("expected policies to be enforced on the remaining endpoint: %v", policies)
+	}
+}
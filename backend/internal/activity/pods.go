@@ -0,0 +1,34 @@
+package activity
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type PodsChecker interface {
+	HasPods(ctx context.Context, namespace string) (bool, error)
+}
+
+type K8sPodsChecker struct {
+	k8s kubernetes.Interface
+}
+
+func NewK8sPodsChecker(k8s kubernetes.Interface) *K8sPodsChecker {
+	return &K8sPodsChecker{k8s: k8s}
+}
+
+// NOTE: Only one pod is requested since it is enough to say that namespace
+// is not empty
+func (c *K8sPodsChecker) HasPods(ctx context.Context, namespace string) (bool, error) {
+	pods, err := c.k8s.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		Limit: 1,
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return len(pods.Items) > 0, nil
+}
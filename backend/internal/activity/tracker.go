@@ -0,0 +1,112 @@
+package activity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+)
+
+type State struct {
+	Namespace  string
+	NoActivity bool
+	NoPods     bool
+}
+
+// NOTE: Tracker remembers when the last flow was seen in every tracked
+// namespace. Namespace has no activity if there are no pods in it or if there
+// were no flows during the period. Only state transitions are returned.
+type Tracker struct {
+	period    time.Duration
+	startedAt time.Time
+
+	lastFlows map[string]time.Time
+	states    map[string]*State
+}
+
+func NewTracker(namespaces []string, period time.Duration, now time.Time) *Tracker {
+	t := &Tracker{
+		period:    period,
+		startedAt: now,
+		lastFlows: make(map[string]time.Time, len(namespaces)),
+		states:    make(map[string]*State, len(namespaces)),
+	}
+
+	for _, ns := range namespaces {
+		t.states[ns] = &State{Namespace: ns}
+	}
+
+	return t
+}
+
+func (t *Tracker) Namespaces() []string {
+	nss := make([]string, 0, len(t.states))
+
+	for ns := range t.states {
+		nss = append(nss, ns)
+	}
+
+	slices.Sort(nss)
+	return nss
+}
+
+// NOTE: Returns states of namespaces where activity has appeared again
+func (t *Tracker) ObserveFlows(flows []*flow.Flow, now time.Time) []*State {
+	changes := []*State{}
+
+	for _, f := range flows {
+		for _, ns := range []string{
+			f.Ref().GetSource().GetNamespace(),
+			f.Ref().GetDestination().GetNamespace(),
+		} {
+			st, tracked := t.states[ns]
+			if !tracked {
+				continue
+			}
+
+			t.lastFlows[ns] = now
+			if !st.NoActivity {
+				continue
+			}
+
+			st.NoActivity = false
+			st.NoPods = false
+			changes = append(changes, st.clone())
+		}
+	}
+
+	return changes
+}
+
+// NOTE: hasPods contains only namespaces for which pods presence is known
+func (t *Tracker) Check(hasPods map[string]bool, now time.Time) []*State {
+	changes := []*State{}
+
+	for _, ns := range t.Namespaces() {
+		st := t.states[ns]
+
+		lastFlow, exists := t.lastFlows[ns]
+		if !exists {
+			lastFlow = t.startedAt
+		}
+
+		podsPresent, isKnown := hasPods[ns]
+		noPods := isKnown && !podsPresent
+		noActivity := noPods || now.Sub(lastFlow) >= t.period
+
+		if st.NoActivity == noActivity && st.NoPods == noPods {
+			continue
+		}
+
+		st.NoActivity = noActivity
+		st.NoPods = noPods
+		changes = append(changes, st.clone())
+	}
+
+	return changes
+}
+
+func (st *State) clone() *State {
+	cp := *st
+	return &cp
+}
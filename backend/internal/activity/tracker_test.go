@@ -0,0 +1,71 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+)
+
+func flowBetween(srcNs, dstNs string) *flow.Flow {
+	return flow.FromProto(&pbFlow.Flow{
+		Source:      &pbFlow.Endpoint{Namespace: srcNs},
+		Destination: &pbFlow.Endpoint{Namespace: dstNs},
+	})
+}
+
+func TestTrackerNoFlows(t *testing.T) {
+	now := time.Now()
+	tracker := NewTracker([]string{"jobs"}, time.Minute, now)
+
+	if changes := tracker.Check(nil, now.Add(30*time.Second)); len(changes) != 0 {
+		t.Fatalf("unexpected changes before period: %+v", changes)
+	}
+
+	changes := tracker.Check(nil, now.Add(time.Minute))
+	if len(changes) != 1 || !changes[0].NoActivity || changes[0].NoPods {
+		t.Fatalf("unexpected changes after period: %+v", changes)
+	}
+
+	if changes := tracker.Check(nil, now.Add(2*time.Minute)); len(changes) != 0 {
+		t.Fatalf("state is reported twice: %+v", changes)
+	}
+
+	changes = tracker.ObserveFlows(
+		[]*flow.Flow{flowBetween("other", "jobs")},
+		now.Add(3*time.Minute),
+	)
+
+	if len(changes) != 1 || changes[0].NoActivity || changes[0].Namespace != "jobs" {
+		t.Fatalf("activity is not cleared: %+v", changes)
+	}
+
+	if changes := tracker.Check(nil, now.Add(3*time.Minute+time.Second)); len(changes) != 0 {
+		t.Fatalf("unexpected changes after flow: %+v", changes)
+	}
+}
+
+func TestTrackerNoPods(t *testing.T) {
+	now := time.Now()
+	tracker := NewTracker([]string{"jobs", "empty"}, time.Minute, now)
+
+	changes := tracker.Check(map[string]bool{
+		"jobs":  true,
+		"empty": false,
+	}, now.Add(time.Second))
+
+	if len(changes) != 1 || changes[0].Namespace != "empty" || !changes[0].NoPods {
+		t.Fatalf("unexpected changes: %+v", changes)
+	}
+
+	changes = tracker.Check(map[string]bool{
+		"jobs":  true,
+		"empty": true,
+	}, now.Add(2*time.Second))
+
+	if len(changes) != 1 || changes[0].NoActivity || changes[0].NoPods {
+		t.Fatalf("unexpected changes after pods appeared: %+v", changes)
+	}
+}
@@ -3,6 +3,10 @@ package api_clients
 import (
 	"context"
 
+	"k8s.io/client-go/kubernetes"
+
+	cilium "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
+
 	"github.com/cilium/hubble-ui/backend/internal/ns_watcher"
 	"github.com/cilium/hubble-ui/backend/internal/relay_client"
 )
@@ -10,4 +14,10 @@ import (
 type APIClientsInterface interface {
 	RelayClient() relay_client.RelayClientInterface
 	NSWatcher(context.Context, ns_watcher.NSWatcherOptions) (ns_watcher.NSWatcherInterface, error)
+
+	// NOTE: Returns nil if backend has no access to k8s
+	K8s() kubernetes.Interface
+
+	// NOTE: Returns nil if backend has no access to cilium CRDs
+	Cilium() cilium.Interface
 }
@@ -37,20 +37,22 @@ func New(
 		log: log,
 	}
 
+	// NOTE: Backend is still functional without access to k8s: namespaces
+	// are fetched from hubble-relay in that case
 	k8sConfig, k8s, err := initK8sClientset()
 	if err != nil {
-		return nil, errors.Wrap(err, "k8s clientset init failed")
+		log.Warn("k8s clientset init failed, k8s API will not be used", "error", err)
+	} else {
+		clients.k8s = k8s
+
+		ciliumClientset, err := initCiliumClientset(k8sConfig)
+		if err != nil {
+			log.Warn("cilium clientset init failed", "error", err)
+		} else {
+			clients.cilium = ciliumClientset
+		}
 	}
 
-	clients.k8s = k8s
-
-	ciliumClientset, err := initCiliumClientset(k8sConfig)
-	if err != nil {
-		return nil, errors.Wrap(err, "cilium clientset init failed")
-	}
-
-	clients.cilium = ciliumClientset
-
 	relayGrpc, err := initRelayGRPCClient(cfg, log.With(slog.String("grpc-client", "relay")))
 	if err != nil {
 		return nil, errors.Wrap(err, "relay grpc client init failed")
@@ -63,7 +65,25 @@ func New(
 func (c *APIClients) NSWatcher(ctx context.Context, opts ns_watcher.NSWatcherOptions) (
 	ns_watcher.NSWatcherInterface, error,
 ) {
-	return ns_watcher.New(opts.Log, c.k8s)
+	return ns_watcher.New(
+		opts.Log,
+		c.k8s,
+		c.RelayClient(),
+		c.cfg.NamespacesPollInterval,
+	)
+}
+
+func (c *APIClients) K8s() kubernetes.Interface {
+	return c.k8s
+}
+
+func (c *APIClients) Cilium() cilium.Interface {
+	// NOTE: Typed nil pointer must not be returned as non-nil interface
+	if c.cilium == nil {
+		return nil
+	}
+
+	return c.cilium
 }
 
 func (c *APIClients) RelayClient() relay_client.RelayClientInterface {
@@ -9,6 +9,11 @@ const (
 	SERVICE_STATE_EVENT = ui.EventType_SERVICE_STATE
 	SERVICE_LINK_EVENT  = ui.EventType_SERVICE_LINK_STATE
 	STATUS_EVENT        = ui.EventType_STATUS
+	DROP_REASONS_EVENT  = ui.EventType_DROP_REASONS
+	NAMESPACE_MAP_EVENT = ui.EventType_NAMESPACE_MAP
+	K8S_SVC_LINK_EVENT  = ui.EventType_K8S_SERVICE_LINK_STATE
+	SVC_ENDPOINTS_EVENT = ui.EventType_SERVICE_ENDPOINTS
+	L7_SUMMARY_EVENT    = ui.EventType_L7_SUMMARY
 )
 
 type EventFlags struct {
@@ -19,10 +24,17 @@ type EventFlags struct {
 	Namespaces      bool
 	Status          bool
 	NetworkPolicies bool
+	DropReasons     bool
+	NamespaceMap    bool
+	K8sServiceLinks bool
+	SvcEndpoints    bool
+	L7Summary       bool
 }
 
 func (ef *EventFlags) FlowsRequired() bool {
-	return ef.Flow || ef.Flows || ef.Services || ef.ServiceLinks
+	return ef.Flow || ef.Flows || ef.Services || ef.ServiceLinks ||
+		ef.DropReasons || ef.NamespaceMap || ef.K8sServiceLinks || ef.SvcEndpoints ||
+		ef.L7Summary
 }
 
 func (ef *EventFlags) StatusRequired() bool {
@@ -39,6 +51,11 @@ func GetFlagsWhichEventsRequested(events []ui.EventType) *EventFlags {
 		flags.ServiceLinks = flags.ServiceLinks || event == SERVICE_LINK_EVENT
 		flags.Namespaces = flags.Namespaces || event == NS_STATE_EVENT
 		flags.Status = flags.Status || event == STATUS_EVENT
+		flags.DropReasons = flags.DropReasons || event == DROP_REASONS_EVENT
+		flags.NamespaceMap = flags.NamespaceMap || event == NAMESPACE_MAP_EVENT
+		flags.K8sServiceLinks = flags.K8sServiceLinks || event == K8S_SVC_LINK_EVENT
+		flags.SvcEndpoints = flags.SvcEndpoints || event == SVC_ENDPOINTS_EVENT
+		flags.L7Summary = flags.L7Summary || event == L7_SUMMARY_EVENT
 	}
 
 	return flags
@@ -0,0 +1,36 @@
+package api_helpers
+
+import (
+	"github.com/cilium/hubble-ui/backend/internal/flow_rates"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func FlowStatsFromRates(stats *flow_rates.Stats) *ui.FlowStats {
+	fs := &ui.FlowStats{
+		PerSecond: float32(stats.PerSecond),
+	}
+
+	FillFlowRates(fs, stats)
+	return fs
+}
+
+// NOTE: Total rate is left untouched since it is taken from hubble-relay
+// server status in status responses
+func FillFlowRates(fs *ui.FlowStats, stats *flow_rates.Stats) {
+	fs.PerNamespace = flowRatesToProto(stats.PerNamespace)
+	fs.PerNode = flowRatesToProto(stats.PerNode)
+	fs.PerVerdict = flowRatesToProto(stats.PerVerdict)
+}
+
+func flowRatesToProto(rates []*flow_rates.Rate) []*ui.FlowRate {
+	result := make([]*ui.FlowRate, 0, len(rates))
+
+	for _, r := range rates {
+		result = append(result, &ui.FlowRate{
+			Key:       r.Key,
+			PerSecond: float32(r.PerSecond),
+		})
+	}
+
+	return result
+}
@@ -34,6 +34,34 @@ func EventResponseFromEverything(
 	return resp
 }
 
+func EventResponseFromLinks(links []cache.Result[*link.Link]) *ui.GetEventsResponse {
+	resp := &ui.GetEventsResponse{
+		Node:      "",
+		Timestamp: timestamppb.Now(),
+		Events:    make([]*ui.Event, 0, len(links)),
+	}
+
+	for _, l := range links {
+		resp.Events = append(resp.GetEvents(), EventFromLinkResult(l))
+	}
+
+	return resp
+}
+
+func EventResponseFromServices(svcs []cache.Result[*service.Service]) *ui.GetEventsResponse {
+	resp := &ui.GetEventsResponse{
+		Node:      "",
+		Timestamp: timestamppb.Now(),
+		Events:    make([]*ui.Event, 0, len(svcs)),
+	}
+
+	for _, s := range svcs {
+		resp.Events = append(resp.GetEvents(), EventFromServiceResult(s))
+	}
+
+	return resp
+}
+
 func EventFromServiceResult(s cache.Result[*service.Service]) *ui.Event {
 	return &ui.Event{
 		Event: &ui.Event_ServiceState{
@@ -66,6 +94,130 @@ func EventFromFlows(f []*flow.Flow) *ui.Event {
 	}
 }
 
+func EventResponseFromDropReasons(
+	nsDrops []*ui.NamespaceDropReasons,
+) *ui.GetEventsResponse {
+	resp := &ui.GetEventsResponse{
+		Node:      "",
+		Timestamp: timestamppb.Now(),
+		Events:    make([]*ui.Event, 0, len(nsDrops)),
+	}
+
+	for _, nsd := range nsDrops {
+		resp.Events = append(resp.GetEvents(), &ui.Event{
+			Event: &ui.Event_NamespaceDropReasons{
+				NamespaceDropReasons: nsd,
+			},
+		})
+	}
+
+	return resp
+}
+
+func EventResponseFromNamespaceMap(
+	nodes []cache.Result[*ui.NamespaceNode],
+	links []cache.Result[*ui.NamespaceLink],
+) *ui.GetEventsResponse {
+	resp := &ui.GetEventsResponse{
+		Node:      "",
+		Timestamp: timestamppb.Now(),
+		Events:    make([]*ui.Event, 0, len(nodes)+len(links)),
+	}
+
+	for _, n := range nodes {
+		resp.Events = append(resp.GetEvents(), &ui.Event{
+			Event: &ui.Event_NamespaceNodeState{
+				NamespaceNodeState: &ui.NamespaceNodeState{
+					NamespaceNode: n.Entry,
+					Type:          StateChangeFromEventKind(n.EventKind),
+				},
+			},
+		})
+	}
+
+	for _, l := range links {
+		resp.Events = append(resp.GetEvents(), &ui.Event{
+			Event: &ui.Event_NamespaceLinkState{
+				NamespaceLinkState: &ui.NamespaceLinkState{
+					NamespaceLink: l.Entry,
+					Type:          StateChangeFromEventKind(l.EventKind),
+				},
+			},
+		})
+	}
+
+	return resp
+}
+
+func EventResponseFromK8sServiceLinks(
+	links []cache.Result[*ui.K8SServiceLink],
+) *ui.GetEventsResponse {
+	resp := &ui.GetEventsResponse{
+		Node:      "",
+		Timestamp: timestamppb.Now(),
+		Events:    make([]*ui.Event, 0, len(links)),
+	}
+
+	for _, l := range links {
+		resp.Events = append(resp.GetEvents(), &ui.Event{
+			Event: &ui.Event_K8SServiceLinkState{
+				K8SServiceLinkState: &ui.K8SServiceLinkState{
+					K8SServiceLink: l.Entry,
+					Type:           StateChangeFromEventKind(l.EventKind),
+				},
+			},
+		})
+	}
+
+	return resp
+}
+
+func EventResponseFromServiceEndpoints(
+	endpoints []cache.Result[*ui.ServiceEndpoints],
+) *ui.GetEventsResponse {
+	resp := &ui.GetEventsResponse{
+		Node:      "",
+		Timestamp: timestamppb.Now(),
+		Events:    make([]*ui.Event, 0, len(endpoints)),
+	}
+
+	for _, se := range endpoints {
+		resp.Events = append(resp.GetEvents(), &ui.Event{
+			Event: &ui.Event_ServiceEndpointsState{
+				ServiceEndpointsState: &ui.ServiceEndpointsState{
+					ServiceEndpoints: se.Entry,
+					Type:             StateChangeFromEventKind(se.EventKind),
+				},
+			},
+		})
+	}
+
+	return resp
+}
+
+func EventResponseFromL7Summaries(
+	summaries []cache.Result[*ui.LinkL7Summary],
+) *ui.GetEventsResponse {
+	resp := &ui.GetEventsResponse{
+		Node:      "",
+		Timestamp: timestamppb.Now(),
+		Events:    make([]*ui.Event, 0, len(summaries)),
+	}
+
+	for _, s := range summaries {
+		resp.Events = append(resp.GetEvents(), &ui.Event{
+			Event: &ui.Event_LinkL7SummaryState{
+				LinkL7SummaryState: &ui.LinkL7SummaryState{
+					LinkL7Summary: s.Entry,
+					Type:          StateChangeFromEventKind(s.EventKind),
+				},
+			},
+		})
+	}
+
+	return resp
+}
+
 func StateChangeFromEventKind(cflags events.EventKind) ui.StateChange {
 	switch cflags {
 	case events.Exists:
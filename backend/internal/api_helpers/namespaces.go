@@ -0,0 +1,34 @@
+package api_helpers
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+// NOTE: Namespace is selected in the UI by whitelisting "<namespace>/" pod
+// prefixes, so the namespaces are restored from source/destination pods
+func NamespacesFromEventsRequest(req *ui.GetEventsRequest) []string {
+	nss := []string{}
+
+	for _, eventFilter := range req.GetWhitelist() {
+		ff := eventFilter.GetFlowFilter()
+		if ff == nil {
+			continue
+		}
+
+		pods := append(slices.Clone(ff.GetSourcePod()), ff.GetDestinationPod()...)
+		for _, pod := range pods {
+			ns, _, found := strings.Cut(pod, "/")
+			if !found || len(ns) == 0 || slices.Contains(nss, ns) {
+				continue
+			}
+
+			nss = append(nss, ns)
+		}
+	}
+
+	slices.Sort(nss)
+	return nss
+}
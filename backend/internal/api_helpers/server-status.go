@@ -18,6 +18,7 @@ func StatusResponseFromServerStatus(
 		ServerStatus: fullStatus.Status,
 		Versions:     nil,
 		Flows:        flowsStatusFromSS(fullStatus.Status),
+		NodeStatuses: nodeStatusesToProto(fullStatus.NodeStatuses),
 	}
 }
 
@@ -71,3 +72,16 @@ func flowsStatusFromSS(ss *observer.ServerStatusResponse) *ui.FlowStats {
 		PerSecond: float32(perSecond),
 	}
 }
+
+func nodeStatusesToProto(statuses []*statuschecker.NodeStatus) []*ui.NodeStatus {
+	result := make([]*ui.NodeStatus, 0, len(statuses))
+
+	for _, st := range statuses {
+		result = append(result, &ui.NodeStatus{
+			Name:        st.Name,
+			IsAvailable: st.IsAvailable,
+		})
+	}
+
+	return result
+}
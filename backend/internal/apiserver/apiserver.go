@@ -11,10 +11,17 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 
+	"github.com/cilium/hubble-ui/backend/domain/service"
 	"github.com/cilium/hubble-ui/backend/internal/api_clients"
 	"github.com/cilium/hubble-ui/backend/internal/apiserver/cors"
+	"github.com/cilium/hubble-ui/backend/internal/cilium_endpoints"
+	"github.com/cilium/hubble-ui/backend/internal/cilium_identities"
 	"github.com/cilium/hubble-ui/backend/internal/config"
 	"github.com/cilium/hubble-ui/backend/internal/customprotocol/router"
+	"github.com/cilium/hubble-ui/backend/internal/flow_history"
+	"github.com/cilium/hubble-ui/backend/internal/k8s_services"
+	"github.com/cilium/hubble-ui/backend/internal/k8s_workloads"
+	"github.com/cilium/hubble-ui/backend/internal/saved_views"
 )
 
 type APIServer struct {
@@ -27,6 +34,14 @@ type APIServer struct {
 	clients           api_clients.APIClientsInterface
 	handlerMiddleware HttpHandlerMiddleware
 
+	// NOTE: Flows seen by all the service map streams of this instance
+	flowHistory  *flow_history.History
+	savedViews   *saved_views.Store
+	k8sServices  *k8s_services.Watcher
+	k8sWorkloads *k8s_workloads.Watcher
+	identities   *cilium_identities.Watcher
+	endpoints    *cilium_endpoints.Watcher
+
 	instance *http.Server
 	router   *router.Router
 }
@@ -68,6 +83,11 @@ func New(
 		bctx = context.Background()
 	}
 
+	savedViews, err := saved_views.New(cfg.SavedViewsFile)
+	if err != nil {
+		return nil, err
+	}
+
 	srv := &APIServer{
 		log:               log,
 		cfg:               cfg,
@@ -76,6 +96,18 @@ func New(
 		baseContext:       bctx,
 		clients:           clients,
 		handlerMiddleware: handlerMiddleware,
+		flowHistory:       flow_history.New(int(cfg.FlowHistorySize)),
+		savedViews:        savedViews,
+	}
+
+	if k8s := clients.K8s(); k8s != nil {
+		srv.k8sServices = k8s_services.New(log, k8s)
+		srv.k8sWorkloads = k8s_workloads.New(log, k8s)
+	}
+
+	if ciliumClient := clients.Cilium(); ciliumClient != nil {
+		srv.identities = cilium_identities.New(log, ciliumClient)
+		srv.endpoints = cilium_endpoints.New(log, ciliumClient)
 	}
 
 	if err := srv.prepareRoutes(); err != nil {
@@ -85,6 +117,16 @@ func New(
 	return srv, nil
 }
 
+// NOTE: Services of the flows are built with it, nil is returned when k8s
+// Services are not watched
+func (srv *APIServer) readyBackends() service.ReadyBackendsCounter {
+	if srv.k8sServices == nil {
+		return nil
+	}
+
+	return srv.k8sServices.ReadyBackends
+}
+
 func (srv *APIServer) Listen() error {
 	port := srv.port
 	addr := fmt.Sprintf("0.0.0.0:%d", port)
@@ -117,6 +159,11 @@ func (srv *APIServer) Listen() error {
 		ReadHeaderTimeout: 5 * time.Second,
 	}
 
+	go srv.k8sServices.Run(srv.baseContext)
+	go srv.k8sWorkloads.Run(srv.baseContext)
+	go srv.identities.Run(srv.baseContext)
+	go srv.endpoints.Run(srv.baseContext)
+
 	srv.log.Info("running ListenAndServe", "port", port, "apipath", srv.rootRoute)
 
 	if err := srv.instance.ListenAndServe(); err != nil {
@@ -131,6 +178,11 @@ func (srv *APIServer) Listen() error {
 }
 
 func (srv *APIServer) Shutdown() error {
+	srv.k8sServices.Stop()
+	srv.k8sWorkloads.Stop()
+	srv.identities.Stop()
+	srv.endpoints.Stop()
+
 	if srv.instance == nil {
 		return nil
 	}
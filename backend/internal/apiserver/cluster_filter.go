@@ -0,0 +1,39 @@
+package apiserver
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/internal/filter_expression"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+// NOTE: Flows are let through if either of their sides belongs to one of
+// the requested clusters, so that links between meshed clusters are kept
+func (ef *eventsFilters) applyClusterFilter(req *ui.GetEventsRequest) error {
+	clusters := make([]string, 0, len(req.GetClusters()))
+	for _, cluster := range req.GetClusters() {
+		cluster = strings.TrimSpace(cluster)
+		if len(cluster) > 0 && !slices.Contains(clusters, cluster) {
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	if len(clusters) == 0 {
+		return nil
+	}
+
+	ffs := []*pbFlow.FlowFilter{
+		{SourceClusterName: clusters},
+		{DestinationClusterName: slices.Clone(clusters)},
+	}
+
+	if !ef.restrictWhitelist(filter_expression.Clauses(ffs)) {
+		return errors.New("clusters contradict filters of the request")
+	}
+
+	return nil
+}
@@ -82,8 +82,42 @@ F:
 				return err
 			}
 		case err := <-nsWatcher.Errors():
-			log.Error("ns watcher failed", "error", err)
-			return err
+			if !api_helpers.IsK8sResourcePermissionsError(err) {
+				log.Error("ns watcher failed", "error", err)
+				return err
+			}
+
+			log.Warn("ns watcher has no permissions", "error", err)
+			evt := notifs.NoPermission(err, "namespaces")
+			if evt == nil {
+				break
+			}
+
+			if err := ch.SendProto(evt.AsControlResponse()); err != nil {
+				log.Error("failed to send no permission notification", "error", err)
+				return err
+			}
+		case st := <-nsWatcher.K8sStates():
+			var evt *notifications.Notification
+
+			switch st {
+			case ns_watcher.K8sUnavailable:
+				evt = notifs.ShouldNotifyOnK8sUnavailable()
+			case ns_watcher.K8sConnected:
+				evt = notifs.K8sConnected()
+			}
+
+			if evt == nil {
+				break
+			}
+
+			if err := ch.SendProto(evt.AsControlResponse()); err != nil {
+				log.Error("failed to send k8s state change notification",
+					"state", st.String(),
+					"error", err)
+
+				return err
+			}
 		case fullStatus := <-statusChecker.Statuses():
 			evt := serverStatusResponse(fullStatus)
 
@@ -0,0 +1,140 @@
+package apiserver
+
+import (
+	"errors"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
+	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/internal/filter_expression"
+	"github.com/cilium/hubble-ui/backend/internal/flow_stream"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func (srv *APIServer) FilterExpression(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.FilterExpressionRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	resp := new(ui.FilterExpressionResponse)
+
+	parsed, err := filter_expression.Parse(req.GetExpression())
+	if err != nil {
+		resp.Error = &ui.FilterExpressionError{Message: err.Error()}
+
+		perr := new(filter_expression.Error)
+		if errors.As(err, &perr) {
+			resp.Error.Message = perr.Msg
+			resp.Error.Position = uint32(perr.Pos)
+		}
+
+		return ch.TerminateProto(resp)
+	}
+
+	// NOTE: Clauses with several filters would be widened to their first
+	// filters, such expressions are only applied by the backend
+	if !parsed.IsSimple() {
+		resp.Error = &ui.FilterExpressionError{
+			Message: "expression can't be expressed as flow filters, " +
+				"send it as filter expression of the events request",
+		}
+
+		return ch.TerminateProto(resp)
+	}
+
+	resp.Whitelist = filter_expression.Primaries(parsed.Whitelist)
+	resp.Blacklist = filter_expression.Primaries(parsed.Blacklist)
+
+	return ch.TerminateProto(resp)
+}
+
+// NOTE: Flow filters of GetEventsRequest as clauses, so that filters that
+// can't be joined into one hubble filter are kept till the request is built
+type eventsFilters struct {
+	whitelist []filter_expression.Clause
+	blacklist []filter_expression.Clause
+}
+
+func newEventsFilters(req *ui.GetEventsRequest) *eventsFilters {
+	return &eventsFilters{
+		whitelist: filter_expression.Clauses(flow_stream.FlowFilters(req.GetWhitelist())),
+	}
+}
+
+// NOTE: Expression filters are joined with the flow filters of the request,
+// service filters are left as is
+func (ef *eventsFilters) applyFilterExpression(req *ui.GetEventsRequest) error {
+	if len(strings.TrimSpace(req.GetFilterExpression())) == 0 {
+		return nil
+	}
+
+	parsed, err := filter_expression.Parse(req.GetFilterExpression())
+	if err != nil {
+		return err
+	}
+
+	if !ef.restrictWhitelist(parsed.Whitelist) {
+		return errors.New("filter expression contradicts filters of the request")
+	}
+
+	ef.blacklist = append(ef.blacklist, parsed.Blacklist...)
+	return nil
+}
+
+// NOTE: Whitelist is replaced with its conjunction with the given clauses,
+// false is returned if nothing can match it
+func (ef *eventsFilters) restrictWhitelist(clauses []filter_expression.Clause) bool {
+	whitelist, ok := filter_expression.ConjunctionAll(ef.whitelist, clauses)
+	if !ok {
+		return false
+	}
+
+	ef.whitelist = whitelist
+	return true
+}
+
+// NOTE: Puts the filters hubble can apply into the request, the returned
+// Matcher checks the rest of them on the flows hubble sends
+func (ef *eventsFilters) build(
+	req *ui.GetEventsRequest,
+) (*filter_expression.Matcher, error) {
+	eventFilters := []*ui.EventFilter{}
+	for _, f := range req.GetWhitelist() {
+		if f.GetFlowFilter() == nil {
+			eventFilters = append(eventFilters, f)
+		}
+	}
+
+	req.Whitelist = append(
+		eventFilters,
+		wrapFlowFilters(filter_expression.Primaries(ef.whitelist))...,
+	)
+
+	req.Blacklist = append(
+		req.Blacklist,
+		wrapFlowFilters(filter_expression.HubbleBlacklist(ef.blacklist))...,
+	)
+
+	return filter_expression.NewMatcher(ef.whitelist, ef.blacklist)
+}
+
+func wrapFlowFilters(ffs []*pbFlow.FlowFilter) []*ui.EventFilter {
+	wrapped := make([]*ui.EventFilter, 0, len(ffs))
+	for _, ff := range ffs {
+		wrapped = append(wrapped, &ui.EventFilter{
+			Filter: &ui.EventFilter_FlowFilter{FlowFilter: ff},
+		})
+	}
+
+	return wrapped
+}
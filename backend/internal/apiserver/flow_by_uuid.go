@@ -0,0 +1,86 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/api/v1/observer"
+
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
+	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const (
+	flowLookupTimeout = 10 * time.Second
+)
+
+func (srv *APIServer) FlowByUUID(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	log, ctx := rctx.Log, rctx.Context()
+
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.FlowByUUIDRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	if len(req.GetUuid()) == 0 {
+		log.Info("uuid is not set in FlowByUUIDRequest")
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	if f := srv.flowHistory.ByUUID(req.GetUuid()); f != nil {
+		return ch.TerminateProto(&ui.FlowByUUIDResponse{
+			Flow:          f,
+			IsFromHistory: true,
+		})
+	}
+
+	f, err := srv.lookupRelayFlow(ctx, req.GetUuid())
+	if err != nil {
+		log.Error("failed to look flow up in hubble-relay", "error", err)
+		return err
+	}
+
+	if f == nil {
+		log.Info("flow is not found", "uuid", req.GetUuid())
+		return ch.TerminateStatus(http.StatusNotFound)
+	}
+
+	return ch.TerminateProto(&ui.FlowByUUIDResponse{Flow: f})
+}
+
+// NOTE: Relay looks the flow up only in the ring buffers of hubble
+// instances, the request is bounded by both the number of flows and time
+func (srv *APIServer) lookupRelayFlow(
+	ctx context.Context, uuid string,
+) (*pbFlow.Flow, error) {
+	ctx, cancel := context.WithTimeout(ctx, flowLookupTimeout)
+	defer cancel()
+
+	req := &observer.GetFlowsRequest{
+		Number: 1,
+		Whitelist: []*pbFlow.FlowFilter{{
+			Uuid: []string{uuid},
+		}},
+	}
+
+	flows, err := srv.clients.RelayClient().FlowStream().CollectLimit(ctx, req, 1)
+	if len(flows) > 0 {
+		return flows[0], nil
+	}
+
+	if err != nil && ctx.Err() == nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,124 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/hubble/filters"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
+	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/internal/flow_stream"
+	"github.com/cilium/hubble-ui/backend/internal/map_export"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func (srv *APIServer) MapExport(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	log := rctx.Log
+
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.MapExportRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	flows := flowsWithinWindow(
+		srv.flowHistory.Flows(),
+		req.GetNamespace(),
+		req.GetSince(),
+		req.GetUntil(),
+	)
+
+	flows, err = filterFlows(
+		rctx.Context(),
+		log,
+		flows,
+		flow_stream.FlowFilters(req.GetWhitelist()),
+		flow_stream.FlowFilters(req.GetBlacklist()),
+	)
+
+	if err != nil {
+		log.Warn("invalid filters in MapExportRequest", "error", err)
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	// NOTE: Exported map consists of the same services and links as the one
+	// the user sees in the UI
+	dcache := cache.New()
+	wflows := flow.WrapWithReadyBackends(flows, srv.readyBackends())
+	dcache.UpsertServicesFromFlows(wflows)
+	dcache.UpsertLinksFromFlows(wflows)
+
+	graph := map_export.FromCache(dcache, req.GetNamespace())
+	resp := &ui.MapExportResponse{
+		Format:      req.GetFormat(),
+		NodesNumber: uint32(len(graph.Nodes)),
+		EdgesNumber: uint32(len(graph.Edges)),
+		FlowsNumber: uint32(len(flows)),
+	}
+
+	switch req.GetFormat() {
+	case ui.MapExportFormat_MAP_EXPORT_DOT:
+		resp.Content = graph.DOT()
+		resp.ContentType = "text/vnd.graphviz"
+	case ui.MapExportFormat_MAP_EXPORT_MERMAID:
+		resp.Content = graph.Mermaid()
+		resp.ContentType = "text/vnd.mermaid"
+	default:
+		raw, err := graph.JSON()
+		if err != nil {
+			log.Error("failed to serialize map graph", "error", err)
+			return err
+		}
+
+		resp.Content = string(raw)
+		resp.ContentType = "application/json"
+	}
+
+	return ch.TerminateProto(resp)
+}
+
+func filterFlows(
+	ctx context.Context,
+	log *slog.Logger,
+	flows []*pbFlow.Flow,
+	wl, bl []*pbFlow.FlowFilter,
+) ([]*pbFlow.Flow, error) {
+	if len(wl) == 0 && len(bl) == 0 {
+		return flows, nil
+	}
+
+	whitelist, err := filters.BuildFilterList(ctx, wl, filters.DefaultFilters(log))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build whitelist: %w", err)
+	}
+
+	blacklist, err := filters.BuildFilterList(ctx, bl, filters.DefaultFilters(log))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build blacklist: %w", err)
+	}
+
+	filtered := make([]*pbFlow.Flow, 0, len(flows))
+	for _, f := range flows {
+		evt := &v1.Event{Timestamp: f.GetTime(), Event: f}
+		if !filters.Apply(whitelist, blacklist, evt) {
+			continue
+		}
+
+		filtered = append(filtered, f)
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,39 @@
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cilium/hubble-ui/backend/internal/filter_expression"
+	"github.com/cilium/hubble-ui/backend/internal/map_scope"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const (
+	mapScopeResolveTimeout = 10 * time.Second
+)
+
+// NOTE: Scope is resolved once and its filters are joined with the request
+// whitelist, nil scope is returned if it's not requested
+func (srv *APIServer) applyMapScope(
+	ctx context.Context, req *ui.GetEventsRequest, ef *eventsFilters,
+) (*map_scope.Scope, error) {
+	if !map_scope.IsRequested(req.GetScope()) {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, mapScopeResolveTimeout)
+	defer cancel()
+
+	scope, err := map_scope.Resolve(ctx, srv.clients.K8s(), req.GetScope())
+	if err != nil {
+		return nil, err
+	}
+
+	if !ef.restrictWhitelist(filter_expression.Clauses(scope.FlowFilters())) {
+		return nil, errors.New("scope contradicts filters of the request")
+	}
+
+	return scope, nil
+}
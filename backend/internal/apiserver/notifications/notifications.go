@@ -68,6 +68,30 @@ func NewK8sConnected() *Notification {
 	}
 }
 
+func NewDataState(namespace string, noActivity, noPods bool) *Notification {
+	return &Notification{
+		ref: &ui.Notification{
+			Notification: &ui.Notification_DataState{
+				DataState: &ui.DataState{
+					NoActivity: noActivity,
+					Namespace:  namespace,
+					NoPods:     noPods,
+				},
+			},
+		},
+	}
+}
+
+func NewFlowStats(stats *ui.FlowStats) *Notification {
+	return &Notification{
+		ref: &ui.Notification{
+			Notification: &ui.Notification_FlowStats{
+				FlowStats: stats,
+			},
+		},
+	}
+}
+
 func NewNoPermission(resource, err string) *Notification {
 	return &Notification{
 		ref: &ui.Notification{
@@ -0,0 +1,105 @@
+package apiserver
+
+import (
+	"fmt"
+	"net/http"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
+	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/internal/policies"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func (srv *APIServer) PolicyDrafts(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	log := rctx.Log
+
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.PolicyDraftsRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	if len(req.GetNamespace()) == 0 {
+		log.Info("namespace is not set in PolicyDraftsRequest")
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	flows := flowsWithinWindow(
+		srv.flowHistory.Flows(),
+		req.GetNamespace(),
+		req.GetSince(),
+		req.GetUntil(),
+	)
+
+	// NOTE: Drafts are generated from the same services and links as the
+	// ones the user sees on the map
+	dcache := cache.New()
+	wflows := flow.WrapWithReadyBackends(flows, srv.readyBackends())
+	dcache.UpsertServicesFromFlows(wflows)
+	dcache.UpsertLinksFromFlows(wflows)
+
+	description := fmt.Sprintf(
+		"Generated by Hubble UI from %d flows observed in namespace %s",
+		len(flows), req.GetNamespace(),
+	)
+
+	drafts, err := policies.GenerateDrafts(dcache, req.GetNamespace(), description)
+	if err != nil {
+		log.Error("failed to generate policy drafts", "error", err)
+		return err
+	}
+
+	resp := &ui.PolicyDraftsResponse{
+		Drafts:      make([]*ui.PolicyDraft, 0, len(drafts)),
+		FlowsNumber: uint32(len(flows)),
+	}
+
+	for _, d := range drafts {
+		resp.Drafts = append(resp.Drafts, &ui.PolicyDraft{
+			Name:      d.Name,
+			Namespace: d.Namespace,
+			ServiceId: d.ServiceId,
+			Yaml:      d.YAML,
+		})
+	}
+
+	return ch.TerminateProto(resp)
+}
+
+func flowsWithinWindow(
+	flows []*pbFlow.Flow, namespace string, since, until *timestamppb.Timestamp,
+) []*pbFlow.Flow {
+	filtered := make([]*pbFlow.Flow, 0, len(flows))
+
+	for _, f := range flows {
+		if len(namespace) > 0 &&
+			f.GetSource().GetNamespace() != namespace &&
+			f.GetDestination().GetNamespace() != namespace {
+			continue
+		}
+
+		t := f.GetTime().AsTime()
+		if since.IsValid() && t.Before(since.AsTime()) {
+			continue
+		}
+
+		if until.IsValid() && t.After(until.AsTime()) {
+			continue
+		}
+
+		filtered = append(filtered, f)
+	}
+
+	return filtered
+}
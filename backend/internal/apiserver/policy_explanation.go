@@ -0,0 +1,63 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
+	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/internal/policies"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func (srv *APIServer) PolicyVerdictExplanation(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	log, ctx := rctx.Log, rctx.Context()
+
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.PolicyVerdictExplanationRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	f := srv.flowForExplanation(req)
+	if f == nil {
+		log.Info("flow to explain is not found", "req", req)
+		return ch.TerminateStatus(http.StatusNotFound)
+	}
+
+	resolver := policies.NewResolver(srv.clients.K8s(), srv.clients.Cilium())
+	explainer := policies.NewExplainer(resolver)
+
+	// NOTE: Every policy is fetched separately, so the whole explanation is
+	// limited in time to not keep the oneshot request for too long
+	explainCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return ch.TerminateProto(&ui.PolicyVerdictExplanationResponse{
+		Explanation: explainer.Explain(explainCtx, f),
+	})
+}
+
+func (srv *APIServer) flowForExplanation(
+	req *ui.PolicyVerdictExplanationRequest,
+) *pbFlow.Flow {
+	switch subj := req.GetSubject().(type) {
+	case *ui.PolicyVerdictExplanationRequest_Flow:
+		return subj.Flow
+	case *ui.PolicyVerdictExplanationRequest_FlowUuid:
+		return srv.flowHistory.ByUUID(subj.FlowUuid)
+	case *ui.PolicyVerdictExplanationRequest_LinkId:
+		return srv.flowHistory.LatestByLink(subj.LinkId, srv.readyBackends())
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package apiserver
+
+import (
+	"net/http"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/domain/service"
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
+	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/internal/flow_history"
+	"github.com/cilium/hubble-ui/backend/internal/policies"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func (srv *APIServer) PolicySimulation(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	log := rctx.Log
+
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.PolicySimulationRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	if len(req.GetNamespace()) == 0 {
+		log.Info("namespace is not set in PolicySimulationRequest")
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	rules, err := policies.ParseRules(req.GetYaml(), req.GetNamespace())
+	if err != nil {
+		log.Info("failed to parse draft policies", "error", err)
+
+		return ch.TerminateProto(&ui.PolicySimulationResponse{
+			Error: err.Error(),
+		})
+	}
+
+	history := srv.flowHistory.Flows()
+	flows := flowsWithinWindow(history, req.GetNamespace(), nil, nil)
+	simulator := policies.NewSimulator(rules)
+
+	resp := &ui.PolicySimulationResponse{
+		Links:       []*ui.SimulatedLink{},
+		RulesNumber: uint32(len(rules)),
+	}
+
+	readyBackends := srv.readyBackends()
+	cardId := flow_history.CardIds(history, readyBackends)
+
+	for _, l := range latestLinks(flows, cardId, readyBackends) {
+		f := l.IntoFlow()
+		result := simulator.Simulate(f)
+		resp.LinksNumber += 1
+
+		if result.Impact == ui.PolicyImpact_UNCHANGED_IMPACT {
+			continue
+		}
+
+		resp.Links = append(resp.Links, &ui.SimulatedLink{
+			LinkId:           l.Id,
+			SourceId:         l.SourceId,
+			DestinationId:    l.DestinationId,
+			DestinationPort:  l.DestinationPort,
+			IpProtocol:       l.IPProtocol,
+			CurrentVerdict:   f.GetVerdict(),
+			SimulatedVerdict: result.SimulatedVerdict,
+			Impact:           result.Impact,
+			Policies:         result.Policies,
+			Reason:           result.Reason,
+		})
+	}
+
+	return ch.TerminateProto(resp)
+}
+
+// NOTE: Every link is represented by its latest flow, since it reflects the
+// policies that are in effect now. Links are the ones between service cards.
+func latestLinks(
+	flows []*pbFlow.Flow,
+	cardId func(string) string,
+	readyBackends service.ReadyBackendsCounter,
+) []*link.Link {
+	links := []*link.Link{}
+	indices := make(map[string]int)
+
+	for _, f := range flows {
+		l := link.FromFlowProto(f, readyBackends)
+		if l == nil {
+			continue
+		}
+
+		l.SetServiceIds(cardId(l.SourceId), cardId(l.DestinationId))
+		if idx, exists := indices[l.Id]; exists {
+			links[idx] = l
+			continue
+		}
+
+		indices[l.Id] = len(links)
+		links = append(links, l)
+	}
+
+	return links
+}
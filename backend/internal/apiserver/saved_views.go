@@ -0,0 +1,152 @@
+package apiserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
+	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/internal/filter_expression"
+	"github.com/cilium/hubble-ui/backend/internal/saved_views"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func (srv *APIServer) CreateSavedView(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	log := rctx.Log
+
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.CreateSavedViewRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	if err := validateSavedView(req.GetView()); err != nil {
+		log.Info("invalid view in CreateSavedViewRequest", "error", err)
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	view, err := srv.savedViews.Create(req.GetView())
+	if err != nil {
+		return srv.terminateSavedViewError(ch, rctx, err)
+	}
+
+	return ch.TerminateProto(&ui.SavedViewResponse{View: view})
+}
+
+func (srv *APIServer) GetSavedView(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.GetSavedViewRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	view, err := srv.savedViews.Get(req.GetId())
+	if err != nil {
+		return srv.terminateSavedViewError(ch, rctx, err)
+	}
+
+	return ch.TerminateProto(&ui.SavedViewResponse{View: view})
+}
+
+func (srv *APIServer) ListSavedViews(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.ListSavedViewsRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	return ch.TerminateProto(&ui.ListSavedViewsResponse{
+		Views: srv.savedViews.List(),
+	})
+}
+
+func (srv *APIServer) UpdateSavedView(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	log := rctx.Log
+
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.UpdateSavedViewRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	if err := validateSavedView(req.GetView()); err != nil {
+		log.Info("invalid view in UpdateSavedViewRequest", "error", err)
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	view, err := srv.savedViews.Update(req.GetView())
+	if err != nil {
+		return srv.terminateSavedViewError(ch, rctx, err)
+	}
+
+	return ch.TerminateProto(&ui.SavedViewResponse{View: view})
+}
+
+func (srv *APIServer) DeleteSavedView(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.DeleteSavedViewRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	if err := srv.savedViews.Delete(req.GetId()); err != nil {
+		return srv.terminateSavedViewError(ch, rctx, err)
+	}
+
+	return ch.TerminateProto(&ui.DeleteSavedViewResponse{})
+}
+
+func (srv *APIServer) terminateSavedViewError(
+	ch *cp.Channel, rctx *req_context.Context, err error,
+) error {
+	switch {
+	case errors.Is(err, saved_views.ErrNotFound):
+		return ch.TerminateStatus(http.StatusNotFound)
+	case errors.Is(err, saved_views.ErrNoName):
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	rctx.Log.Error("saved views store failure", "error", err)
+	return err
+}
+
+func validateSavedView(view *ui.SavedView) error {
+	if view == nil {
+		return errors.New("view is not set")
+	}
+
+	// NOTE: View is opened by a colleague later, so broken filters are
+	// rejected right away instead of failing the stream then
+	_, err := filter_expression.Parse(view.GetFilterExpression())
+	return err
+}
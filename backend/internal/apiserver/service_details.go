@@ -0,0 +1,45 @@
+package apiserver
+
+import (
+	"net/http"
+
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
+	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/internal/service_details"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func (srv *APIServer) ServiceDetails(
+	ch *cp.Channel, rctx *req_context.Context,
+) error {
+	log := rctx.Log
+
+	firstMsg, err := ch.ReceiveNonblock()
+	if err != nil {
+		return err
+	}
+
+	req := new(ui.ServiceDetailsRequest)
+	if err := firstMsg.DeserializeProtoBody(req); err != nil {
+		return err
+	}
+
+	if len(req.GetServiceId()) == 0 {
+		log.Info("service_id is not set in ServiceDetailsRequest")
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	details := service_details.Build(
+		req.GetServiceId(),
+		srv.flowHistory.Flows(),
+		int(req.GetFlowsLimit()),
+		srv.readyBackends(),
+	)
+
+	if details == nil {
+		log.Info("service is not found in flow history", "service-id", req.GetServiceId())
+		return ch.TerminateStatus(http.StatusNotFound)
+	}
+
+	return ch.TerminateProto(details)
+}
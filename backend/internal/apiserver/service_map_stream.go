@@ -1,25 +1,37 @@
 package apiserver
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"time"
 
 	pb_flow "github.com/cilium/cilium/api/v1/flow"
 	"github.com/cilium/hubble-ui/backend/proto/ui"
 
 	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/cluster_map"
+	"github.com/cilium/hubble-ui/backend/domain/drops"
+	"github.com/cilium/hubble-ui/backend/domain/events"
 	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/domain/k8s_service_links"
+	"github.com/cilium/hubble-ui/backend/domain/l7_summary"
 	"github.com/cilium/hubble-ui/backend/domain/link"
 	"github.com/cilium/hubble-ui/backend/domain/service"
+	"github.com/cilium/hubble-ui/backend/domain/service_endpoints"
 	"github.com/cilium/hubble-ui/backend/pkg/data_throttler"
 	grpc_errors "github.com/cilium/hubble-ui/backend/pkg/grpc_utils/errors"
 
+	"github.com/cilium/hubble-ui/backend/internal/activity"
 	"github.com/cilium/hubble-ui/backend/internal/api_helpers"
+	"github.com/cilium/hubble-ui/backend/internal/apiserver/notifications"
 	"github.com/cilium/hubble-ui/backend/internal/apiserver/req_context"
 	cp "github.com/cilium/hubble-ui/backend/internal/customprotocol"
+	"github.com/cilium/hubble-ui/backend/internal/flow_rates"
 	"github.com/cilium/hubble-ui/backend/internal/flow_stream"
 	"github.com/cilium/hubble-ui/backend/internal/hubble_client"
+	"github.com/cilium/hubble-ui/backend/internal/k8s_services"
 	"github.com/cilium/hubble-ui/backend/internal/msg"
 	"github.com/cilium/hubble-ui/backend/internal/statuschecker"
 )
@@ -46,6 +58,29 @@ func (srv *APIServer) ServiceMapStream(
 		return ch.TerminateStatus(http.StatusBadRequest)
 	}
 
+	reqFilters := newEventsFilters(req)
+	if err := reqFilters.applyFilterExpression(req); err != nil {
+		log.Warn("invalid filter expression in GetEventsRequest", "error", err)
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	if err := reqFilters.applyClusterFilter(req); err != nil {
+		log.Warn("invalid clusters in GetEventsRequest", "error", err)
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	scope, err := srv.applyMapScope(ctx, req, reqFilters)
+	if err != nil {
+		log.Warn("failed to resolve scope of GetEventsRequest", "error", err)
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
+	flowMatcher, err := reqFilters.build(req)
+	if err != nil {
+		log.Warn("invalid flow filters in GetEventsRequest", "error", err)
+		return ch.TerminateStatus(http.StatusBadRequest)
+	}
+
 	relayClient := srv.clients.RelayClient()
 
 	eventsRequested := api_helpers.GetFlagsWhichEventsRequested(req.GetEventTypes())
@@ -70,9 +105,12 @@ func (srv *APIServer) ServiceMapStream(
 	var statusChecker statuschecker.ServerStatusCheckerInterface
 	statusChecker = statuschecker.NewDumb()
 	if eventsRequested.Status {
+		// NOTE: Flow stats of the status are refreshed on every check, so
+		// the status is sent every time
 		statusChecker, err = relayClient.ServerStatusChecker(hubble_client.StatusCheckerOptions{
-			Delay: 5 * time.Second,
-			Log:   log,
+			Delay:     5 * time.Second,
+			Heartbeat: 5 * time.Second,
+			Log:       log,
 		})
 
 		if err != nil {
@@ -84,9 +122,177 @@ func (srv *APIServer) ServiceMapStream(
 		defer statusChecker.Stop()
 	}
 
+	flowRates := flow_rates.New(1 * time.Minute)
+
+	// NOTE: Flow rates are streamed only to clients that are interested in
+	// status updates
+	var flowRatesTick <-chan time.Time
+	if eventsRequested.FlowsRequired() && eventsRequested.Status {
+		flowRatesTicker := time.NewTicker(5 * time.Second)
+		defer flowRatesTicker.Stop()
+
+		flowRatesTick = flowRatesTicker.C
+	}
+
+	// NOTE: Counters of links are sent separately from the flows, not more
+	// often than this
+	var staleLinksTick <-chan time.Time
+	if eventsRequested.ServiceLinks {
+		staleLinksTicker := time.NewTicker(5 * time.Second)
+		defer staleLinksTicker.Stop()
+
+		staleLinksTick = staleLinksTicker.C
+	}
+
+	dropReasons := drops.NewAggregator(5)
+
+	var dropReasonsTick <-chan time.Time
+	if eventsRequested.DropReasons {
+		dropReasonsTicker := time.NewTicker(5 * time.Second)
+		defer dropReasonsTicker.Stop()
+
+		dropReasonsTick = dropReasonsTicker.C
+	}
+
+	// NOTE: Cluster map is aggregated from the flows of all the namespaces
+	// the request lets through, so it's up to the client to not limit them
+	namespaceMap := cluster_map.NewAggregator()
+
+	var namespaceMapTick <-chan time.Time
+	if eventsRequested.NamespaceMap {
+		namespaceMapTicker := time.NewTicker(2 * time.Second)
+		defer namespaceMapTicker.Stop()
+
+		namespaceMapTick = namespaceMapTicker.C
+	}
+
+	k8sServiceLinks := k8s_service_links.NewAggregator(srv.k8sServices)
+
+	var k8sServiceLinksTick <-chan time.Time
+	if eventsRequested.K8sServiceLinks {
+		k8sServiceLinksTicker := time.NewTicker(2 * time.Second)
+		defer k8sServiceLinksTicker.Stop()
+
+		k8sServiceLinksTick = k8sServiceLinksTicker.C
+	}
+
+	// NOTE: Endpoints are checked periodically even without flows, so that
+	// endpoints stuck in regeneration are noticed
+	svcEndpoints := service_endpoints.NewAggregator(srv.endpoints)
+	observeEndpoints := eventsRequested.SvcEndpoints || eventsRequested.Services
+
+	// NOTE: Endpoints are flushed for services as well, since policy status
+	// of the cards changes together with their endpoints
+	var svcEndpointsTick <-chan time.Time
+	if observeEndpoints {
+		svcEndpointsTicker := time.NewTicker(5 * time.Second)
+		defer svcEndpointsTicker.Stop()
+
+		svcEndpointsTick = svcEndpointsTicker.C
+	}
+
+	l7Summary := l7_summary.NewAggregator()
+
+	var l7SummaryTick <-chan time.Time
+	if eventsRequested.L7Summary {
+		l7SummaryTicker := time.NewTicker(2 * time.Second)
+		defer l7SummaryTicker.Stop()
+
+		l7SummaryTick = l7SummaryTicker.C
+	}
+
+	activityTracker := activity.NewTracker(
+		api_helpers.NamespacesFromEventsRequest(req),
+		srv.cfg.NoActivityPeriod,
+		time.Now(),
+	)
+
+	var podsChecker activity.PodsChecker
+	if k8s := srv.clients.K8s(); k8s != nil {
+		podsChecker = activity.NewK8sPodsChecker(k8s)
+	}
+
+	// NOTE: nil channel is never selected, so activity is not checked at all
+	// when there are no namespaces in request or the check is disabled
+	var activityCheck <-chan time.Time
+	if eventsRequested.FlowsRequired() && srv.cfg.NoActivityPeriod > 0 &&
+		len(activityTracker.Namespaces()) > 0 {
+		activityTicker := time.NewTicker(10 * time.Second)
+		defer activityTicker.Stop()
+
+		activityCheck = activityTicker.C
+	}
+
+	// NOTE: Pods are listed in k8s API outside of the stream loop, so that
+	// flows are not delayed by slow responses. There is at most one check in
+	// progress, podsChecker is used only by that check.
+	podsChecked := make(chan map[string]bool, 1)
+	isCheckingPods := false
+
+	checkPods := func(namespaces []string) {
+		hasPods := make(map[string]bool)
+		defer func() {
+			podsChecked <- hasPods
+		}()
+
+		if podsChecker == nil {
+			return
+		}
+
+		for _, ns := range namespaces {
+			checkCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+			isPresented, err := podsChecker.HasPods(checkCtx, ns)
+			cancel()
+
+			if err == nil {
+				hasPods[ns] = isPresented
+				continue
+			}
+
+			log.Warn("failed to check pods in namespace", "namespace", ns, "error", err)
+			if api_helpers.IsK8sResourcePermissionsError(err) {
+				podsChecker = nil
+				break
+			}
+		}
+	}
+
+	sendActivityStates := func(states []*activity.State) error {
+		for _, st := range states {
+			notif := notifications.NewDataState(st.Namespace, st.NoActivity, st.NoPods)
+			if err := ch.SendProto(notif.AsEventResponse()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
 	flushFlows := func() error {
 		// NOTE: take links and services from flow
-		wflows := flow.Wrap(flows.Flush())
+		pbFlows := flows.Flush()
+
+		// NOTE: Labels and workloads are filled before flows are shared with
+		// the others, so that the same pod doesn't appear with and without them
+		srv.identities.EnrichFlows(pbFlows)
+		srv.k8sWorkloads.EnrichFlows(pbFlows)
+		srv.flowHistory.Push(pbFlows)
+
+		if scope != nil {
+			pbFlows = slices.DeleteFunc(pbFlows, func(f *pb_flow.Flow) bool {
+				return !scope.ContainsFlow(f)
+			})
+		}
+
+		wflows := flow.WrapWithReadyBackends(pbFlows, srv.readyBackends())
+		if err := sendActivityStates(activityTracker.ObserveFlows(wflows, time.Now())); err != nil {
+			return err
+		}
+
+		if eventsRequested.DropReasons {
+			dropReasons.ObserveFlows(wflows)
+		}
+
 		var svcs []cache.Result[*service.Service]
 		var links []cache.Result[*link.Link]
 
@@ -94,8 +300,42 @@ func (srv *APIServer) ServiceMapStream(
 			svcs = dcache.UpsertServicesFromFlows(wflows)
 		}
 
+		srv.resolveK8sServices(svcs)
+
+		// NOTE: Aggregators below refer to service cards, so they observe the
+		// flows once the cards of these flows are known
+		if observeEndpoints {
+			svcEndpoints.ObserveFlows(wflows, dcache.CardId)
+		}
+
+		if eventsRequested.L7Summary {
+			l7Summary.ObserveFlows(wflows, dcache.CardId)
+		}
+
+		if eventsRequested.NamespaceMap {
+			namespaceMap.ObserveFlows(wflows, dcache.CardId)
+		}
+
+		if eventsRequested.K8sServiceLinks {
+			k8sServiceLinks.ObserveFlows(wflows, dcache.CardId)
+		}
+
+		for _, svc := range svcs {
+			policy := svcEndpoints.Policy(svc.Entry.Id())
+			svc.Entry.SetPolicyStatus(
+				policy.IngressEnforced, policy.EgressEnforced, policy.VisibilityStatus,
+			)
+		}
+
+		if scope != nil {
+			for _, svc := range svcs {
+				svc.Entry.SetIsBoundary(!scope.Contains(svc.Entry.Endpoint()))
+			}
+		}
+
 		if eventsRequested.ServiceLinks {
 			links = dcache.UpsertLinksFromFlows(wflows)
+			srv.annotateK8sServiceLinks(links)
 		}
 
 		resp := api_helpers.EventResponseFromEverything(
@@ -138,6 +378,12 @@ F:
 				return err
 			}
 		case pbFlow := <-flowStream.Flows():
+			if !flowMatcher.Match(pbFlow) {
+				break
+			}
+
+			flowRates.Count(pbFlow)
+
 			if isAdded := flows.Push(pbFlow); isAdded {
 				break
 			}
@@ -148,8 +394,127 @@ F:
 			}
 
 			flows.Push(pbFlow)
+		case <-activityCheck:
+			if isCheckingPods {
+				break
+			}
+
+			isCheckingPods = true
+			go checkPods(activityTracker.Namespaces())
+		case hasPods := <-podsChecked:
+			isCheckingPods = false
+
+			states := activityTracker.Check(hasPods, time.Now())
+			if err := sendActivityStates(states); err != nil {
+				log.Error("failed to send data state notification", "error", err)
+				return err
+			}
+		case <-staleLinksTick:
+			links := dcache.FlushStaleLinks()
+			if len(links) == 0 {
+				break
+			}
+
+			resp := api_helpers.EventResponseFromLinks(links)
+			if err := ch.SendProto(resp); err != nil {
+				log.Error("failed to send link counters", "error", err)
+				return err
+			}
+		case <-dropReasonsTick:
+			nsDrops := dropReasons.Flush()
+			if len(nsDrops) == 0 {
+				break
+			}
+
+			resp := api_helpers.EventResponseFromDropReasons(nsDrops)
+			if err := ch.SendProto(resp); err != nil {
+				log.Error("failed to send drop reasons", "error", err)
+				return err
+			}
+		case <-namespaceMapTick:
+			nodes, links := namespaceMap.Flush()
+			if len(nodes) == 0 && len(links) == 0 {
+				break
+			}
+
+			resp := api_helpers.EventResponseFromNamespaceMap(nodes, links)
+			if err := ch.SendProto(resp); err != nil {
+				log.Error("failed to send namespace map", "error", err)
+				return err
+			}
+		case <-k8sServiceLinksTick:
+			svcLinks := k8sServiceLinks.Flush()
+			if len(svcLinks) == 0 {
+				break
+			}
+
+			resp := api_helpers.EventResponseFromK8sServiceLinks(svcLinks)
+			if err := ch.SendProto(resp); err != nil {
+				log.Error("failed to send k8s service links", "error", err)
+				return err
+			}
+		case <-svcEndpointsTick:
+			endpoints, policies := svcEndpoints.Flush()
+
+			svcs := make([]cache.Result[*service.Service], 0, len(policies))
+			for _, policy := range policies {
+				svc := dcache.Service(policy.CardId)
+				if svc == nil {
+					continue
+				}
+
+				svc.SetPolicyStatus(
+					policy.IngressEnforced, policy.EgressEnforced, policy.VisibilityStatus,
+				)
+
+				svcs = append(svcs, cache.Result[*service.Service]{
+					Entry:     svc,
+					EventKind: events.Modified,
+				})
+			}
+
+			if eventsRequested.Services && len(svcs) > 0 {
+				resp := api_helpers.EventResponseFromServices(svcs)
+				if err := ch.SendProto(resp); err != nil {
+					log.Error("failed to send services with changed policies", "error", err)
+					return err
+				}
+			}
+
+			if !eventsRequested.SvcEndpoints || len(endpoints) == 0 {
+				break
+			}
+
+			resp := api_helpers.EventResponseFromServiceEndpoints(endpoints)
+			if err := ch.SendProto(resp); err != nil {
+				log.Error("failed to send service endpoints", "error", err)
+				return err
+			}
+		case <-l7SummaryTick:
+			summaries := l7Summary.Flush()
+			if len(summaries) == 0 {
+				break
+			}
+
+			resp := api_helpers.EventResponseFromL7Summaries(summaries)
+			if err := ch.SendProto(resp); err != nil {
+				log.Error("failed to send l7 summaries", "error", err)
+				return err
+			}
+		case <-flowRatesTick:
+			notif := notifications.NewFlowStats(
+				api_helpers.FlowStatsFromRates(flowRates.Stats()),
+			)
+
+			if err := ch.SendProto(notif.AsEventResponse()); err != nil {
+				log.Error("failed to send flow stats", "error", err)
+				return err
+			}
 		case fullStatus := <-statusChecker.Statuses():
-			statusEvent := api_helpers.EventResponseFromServerStatus(fullStatus)
+			status := api_helpers.StatusResponseFromServerStatus(fullStatus)
+			api_helpers.FillFlowRates(status.GetFlows(), flowRates.Stats())
+
+			statusEvent := api_helpers.EventResponseFromStatusResponse(status)
 
 			if err := ch.SendProto(statusEvent); err != nil {
 				log.Error("failed to send hubble status update", "error", err)
@@ -161,3 +526,34 @@ F:
 	log.Info("stream is closed")
 	return nil
 }
+
+// NOTE: Cards of k8s Services without backends get labels of Service objects,
+// the card is shown even if the object is not known
+func (srv *APIServer) resolveK8sServices(svcs []cache.Result[*service.Service]) {
+	for _, svc := range svcs {
+		k8sSvc := svc.Entry.K8sServiceWithoutEndpoints()
+		if k8sSvc == nil {
+			continue
+		}
+
+		obj := srv.k8sServices.Service(k8sSvc.GetNamespace(), k8sSvc.GetName())
+		if obj != nil {
+			svc.Entry.SetK8sLabels(k8s_services.Labels(obj))
+		}
+	}
+}
+
+// NOTE: Links are annotated only when they are sent, so readiness of backends
+// is as fresh as the last change of the link
+func (srv *APIServer) annotateK8sServiceLinks(links []cache.Result[*link.Link]) {
+	for _, l := range links {
+		destSvc := l.Entry.DestinationService
+		if destSvc == nil {
+			continue
+		}
+
+		l.Entry.K8sService = srv.k8sServices.Ref(
+			destSvc.GetNamespace(), destSvc.GetName(), l.Entry.DestinationPort,
+		)
+	}
+}
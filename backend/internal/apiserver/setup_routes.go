@@ -54,6 +54,102 @@ func (srv *APIServer) setRouteHandlers() error {
 			srv.wrapHandler(srv.ServiceMapStream, WrappedRouteOptions{}),
 		)
 
+	srv.router.Route("policy-verdict-explanation").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("PolicyVerdictExplanation"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.PolicyVerdictExplanation, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("policy-drafts").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("PolicyDrafts"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.PolicyDrafts, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("policy-simulation").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("PolicySimulation"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.PolicySimulation, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("map-export").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("MapExport"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.MapExport, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("flow-by-uuid").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("FlowByUUID"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.FlowByUUID, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("filter-expression").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("FilterExpression"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.FilterExpression, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("saved-view-create").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("CreateSavedView"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.CreateSavedView, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("saved-view-get").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("GetSavedView"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.GetSavedView, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("saved-views-list").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("ListSavedViews"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.ListSavedViews, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("saved-view-update").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("UpdateSavedView"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.UpdateSavedView, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("saved-view-delete").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("DeleteSavedView"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.DeleteSavedView, WrappedRouteOptions{}),
+		)
+
+	srv.router.Route("service-details").
+		Middlewares([]cp.ChannelMiddleware{
+			srv.loggerMiddleware("ServiceDetails"),
+		}).
+		Oneshot(
+			srv.wrapHandler(srv.ServiceDetails, WrappedRouteOptions{}),
+		)
+
 	return nil
 }
 
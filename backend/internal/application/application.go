@@ -10,6 +10,8 @@ import (
 	gops "github.com/google/gops/agent"
 	"golang.org/x/sys/unix"
 
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+	"github.com/cilium/hubble-ui/backend/domain/service"
 	"github.com/cilium/hubble-ui/backend/internal/api_clients"
 	"github.com/cilium/hubble-ui/backend/internal/apiserver"
 	"github.com/cilium/hubble-ui/backend/internal/config"
@@ -33,6 +35,20 @@ type Options struct {
 }
 
 func New(log *slog.Logger, cfg *config.Config, opts Options) (*Application, error) {
+	// NOTE: Grouping is global since ids of services are computed everywhere
+	// the flows are handled: in service map, links, caches and flow streams
+	if err := service.SetGrouping(cfg.ServiceGrouping); err != nil {
+		return nil, err
+	}
+
+	labels.SetAppKeys(cfg.ServiceNameLabels)
+	service.SetLocalCluster(cfg.LocalClusterName)
+	log.Info("service grouping is set",
+		"mode", cfg.ServiceGrouping.Mode,
+		"label", cfg.ServiceGrouping.LabelKey,
+		"name-labels", labels.AppKeys(),
+		"local-cluster", cfg.LocalClusterName)
+
 	return &Application{
 		cfg:  cfg,
 		log:  log,
@@ -0,0 +1,149 @@
+package cilium_endpoints
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/cilium/cilium/api/v1/models"
+	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	cilium "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/hubble-ui/backend/internal/informers"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+// NOTE: Watcher keeps statuses of CiliumEndpoint objects, they are named
+// after the pods they belong to.
+type Watcher struct {
+	endpoints cache.Store
+	informers *informers.Informers
+}
+
+func New(log *slog.Logger, ciliumClient cilium.Interface) *Watcher {
+	endpoints, controller := cache.NewInformerWithOptions(cache.InformerOptions{
+		ListerWatcher: cache.NewListWatchFromClient(
+			ciliumClient.CiliumV2().RESTClient(),
+			ciliumv2.CEPPluralName,
+			metav1.NamespaceAll,
+			fields.Everything(),
+		),
+		ObjectType: &ciliumv2.CiliumEndpoint{},
+		Handler:    cache.ResourceEventHandlerFuncs{},
+		Transform:  statusOnly,
+	})
+
+	return &Watcher{
+		endpoints: endpoints,
+		informers: informers.New(log, "cilium endpoints", controller),
+	}
+}
+
+func (w *Watcher) Run(ctx context.Context) {
+	if w != nil {
+		w.informers.Run(ctx)
+	}
+}
+
+func (w *Watcher) Stop() {
+	if w != nil {
+		w.informers.Stop()
+	}
+}
+
+// NOTE: Returns nil if the pod has no cilium endpoint
+func (w *Watcher) Status(namespace, podName string) *ui.CiliumEndpointStatus {
+	if w == nil || len(namespace) == 0 || len(podName) == 0 {
+		return nil
+	}
+
+	obj, exists, err := w.endpoints.GetByKey(namespace + "/" + podName)
+	if err != nil || !exists {
+		return nil
+	}
+
+	cep, ok := obj.(*ciliumv2.CiliumEndpoint)
+	if !ok {
+		return nil
+	}
+
+	return StatusFromCEP(cep)
+}
+
+func StatusFromCEP(cep *ciliumv2.CiliumEndpoint) *ui.CiliumEndpointStatus {
+	st := &ui.CiliumEndpointStatus{
+		PodName:     cep.Name,
+		Namespace:   cep.Namespace,
+		State:       State(cep.Status.State),
+		CiliumState: cep.Status.State,
+	}
+
+	if id := cep.Status.Identity; id != nil && id.ID > 0 {
+		st.Identity = uint32(id.ID)
+	}
+
+	if policy := cep.Status.Policy; policy != nil {
+		st.IngressPolicyEnforced = policy.Ingress != nil && policy.Ingress.Enforcing
+		st.EgressPolicyEnforced = policy.Egress != nil && policy.Egress.Enforcing
+	}
+
+	return st
+}
+
+func State(ciliumState string) ui.EndpointState {
+	switch models.EndpointState(ciliumState) {
+	case models.EndpointStateReady:
+		return ui.EndpointState_ENDPOINT_READY
+	case models.EndpointStateRegenerating,
+		models.EndpointStateWaitingDashToDashRegenerate,
+		models.EndpointStateRestoring:
+		return ui.EndpointState_ENDPOINT_REGENERATING
+	case "":
+		return ui.EndpointState_UNKNOWN_ENDPOINT_STATE
+	}
+
+	return ui.EndpointState_ENDPOINT_NOT_READY
+}
+
+// NOTE: Policies of endpoints list all the allowed identities, they are
+// dropped to not keep them in memory
+func statusOnly(obj any) (any, error) {
+	cep, ok := obj.(*ciliumv2.CiliumEndpoint)
+	if !ok {
+		return obj, nil
+	}
+
+	trimmed := &ciliumv2.CiliumEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cep.Name,
+			Namespace:       cep.Namespace,
+			ResourceVersion: cep.ResourceVersion,
+		},
+		Status: ciliumv2.EndpointStatus{
+			State:    cep.Status.State,
+			Identity: cep.Status.Identity,
+		},
+	}
+
+	if policy := cep.Status.Policy; policy != nil {
+		trimmed.Status.Policy = &ciliumv2.EndpointPolicy{
+			Ingress: enforcementOnly(policy.Ingress),
+			Egress:  enforcementOnly(policy.Egress),
+		}
+	}
+
+	return trimmed, nil
+}
+
+func enforcementOnly(dir *ciliumv2.EndpointPolicyDirection) *ciliumv2.EndpointPolicyDirection {
+	if dir == nil {
+		return nil
+	}
+
+	return &ciliumv2.EndpointPolicyDirection{
+		Enforcing: dir.Enforcing,
+		State:     dir.State,
+	}
+}
@@ -0,0 +1,89 @@
+package cilium_endpoints
+
+import (
+	"testing"
+
+	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func TestState(t *testing.T) {
+	states := map[string]ui.EndpointState{
+		"ready":                 ui.EndpointState_ENDPOINT_READY,
+		"regenerating":          ui.EndpointState_ENDPOINT_REGENERATING,
+		"waiting-to-regenerate": ui.EndpointState_ENDPOINT_REGENERATING,
+		"restoring":             ui.EndpointState_ENDPOINT_REGENERATING,
+		"waiting-for-identity":  ui.EndpointState_ENDPOINT_NOT_READY,
+		"not-ready":             ui.EndpointState_ENDPOINT_NOT_READY,
+		"disconnected":          ui.EndpointState_ENDPOINT_NOT_READY,
+		"":                      ui.EndpointState_UNKNOWN_ENDPOINT_STATE,
+	}
+
+	for ciliumState, expected := range states {
+		if state := State(ciliumState); state != expected {
+			t.Fatalf("unexpected state of '%s': %v", ciliumState, state)
+		}
+	}
+}
+
+func TestStatus(t *testing.T) {
+	allowed := ciliumv2.AllowedIdentityList{{Identity: 1001}, {Identity: 1002}}
+	cep := &ciliumv2.CiliumEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-1", Namespace: "shop"},
+		Status: ciliumv2.EndpointStatus{
+			State:    "regenerating",
+			Identity: &ciliumv2.EndpointIdentity{ID: 1003},
+			Policy: &ciliumv2.EndpointPolicy{
+				Ingress: &ciliumv2.EndpointPolicyDirection{Enforcing: true, Allowed: allowed},
+				Egress:  &ciliumv2.EndpointPolicyDirection{Enforcing: false, Allowed: allowed},
+			},
+		},
+	}
+
+	// NOTE: Endpoints are kept in the store the way the informer keeps them
+	trimmed, err := statusOnly(cep)
+	if err != nil {
+		t.Fatalf("failed to transform endpoint: %v", err)
+	}
+
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	if err := store.Add(trimmed); err != nil {
+		t.Fatalf("failed to add endpoint: %v", err)
+	}
+
+	if policy := trimmed.(*ciliumv2.CiliumEndpoint).Status.Policy; len(policy.Ingress.Allowed) > 0 {
+		t.Fatalf("expected allowed identities to be dropped, got %v", policy.Ingress)
+	}
+
+	w := &Watcher{endpoints: store}
+	st := w.Status("shop", "api-1")
+
+	switch {
+	case st == nil:
+		t.Fatalf("expected status of the endpoint")
+	case st.GetState() != ui.EndpointState_ENDPOINT_REGENERATING || st.GetCiliumState() != "regenerating":
+		t.Fatalf("unexpected state of the endpoint: %v", st)
+	case st.GetIdentity() != 1003:
+		t.Fatalf("unexpected identity of the endpoint: %v", st)
+	case !st.GetIngressPolicyEnforced() || st.GetEgressPolicyEnforced():
+		t.Fatalf("expected only ingress policy to be enforced: %v", st)
+	}
+
+	// NOTE: Endpoint without policy status has no policy enforced
+	cep.Status = ciliumv2.EndpointStatus{State: "not-ready"}
+	st = StatusFromCEP(cep)
+	if st.GetState() != ui.EndpointState_ENDPOINT_NOT_READY || st.GetIdentity() != 0 {
+		t.Fatalf("unexpected status of not ready endpoint: %v", st)
+	}
+
+	if st.GetIngressPolicyEnforced() || st.GetEgressPolicyEnforced() {
+		t.Fatalf("expected no policy to be enforced: %v", st)
+	}
+
+	if w.Status("shop", "api-2") != nil {
+		t.Fatalf("expected no status of unknown pod")
+	}
+}
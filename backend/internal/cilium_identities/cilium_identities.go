@@ -0,0 +1,147 @@
+package cilium_identities
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	cilium "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/hubble-ui/backend/internal/informers"
+)
+
+// NOTE: Identities below this one are reserved and never stored as
+// CiliumIdentity objects
+const minClusterIdentity = 256
+
+const namespaceLabel = "k8s:io.kubernetes.pod.namespace"
+
+// NOTE: Watcher keeps labels of CiliumIdentity objects, so that endpoints
+// that come in flows with empty or truncated labels can be described
+// properly.
+type Watcher struct {
+	identities cache.Store
+	informers  *informers.Informers
+}
+
+func New(log *slog.Logger, ciliumClient cilium.Interface) *Watcher {
+	identities, controller := cache.NewInformerWithOptions(cache.InformerOptions{
+		ListerWatcher: cache.NewListWatchFromClient(
+			ciliumClient.CiliumV2().RESTClient(),
+			ciliumv2.CIDPluralName,
+			metav1.NamespaceAll,
+			fields.Everything(),
+		),
+		ObjectType: &ciliumv2.CiliumIdentity{},
+		Handler:    cache.ResourceEventHandlerFuncs{},
+		Transform:  labelsOnly,
+	})
+
+	return &Watcher{
+		identities: identities,
+		informers:  informers.New(log, "cilium identities", controller),
+	}
+}
+
+func (w *Watcher) Run(ctx context.Context) {
+	if w != nil {
+		w.informers.Run(ctx)
+	}
+}
+
+func (w *Watcher) Stop() {
+	if w != nil {
+		w.informers.Stop()
+	}
+}
+
+// NOTE: Labels are formatted the same way they come in flows, e.g.
+// "k8s:app=frontend". Returns nil for unknown identities.
+func (w *Watcher) Labels(identity uint32) []string {
+	if w == nil || identity < minClusterIdentity {
+		return nil
+	}
+
+	obj, exists, err := w.identities.GetByKey(strconv.FormatUint(uint64(identity), 10))
+	if err != nil || !exists {
+		return nil
+	}
+
+	cid, ok := obj.(*ciliumv2.CiliumIdentity)
+	if !ok {
+		return nil
+	}
+
+	return FlowLabels(cid.SecurityLabels)
+}
+
+// NOTE: Labels of the endpoint are replaced only if the identity has more of
+// them, namespace is filled if it's missing
+func (w *Watcher) EnrichFlows(flows []*pbFlow.Flow) {
+	if w == nil {
+		return
+	}
+
+	for _, f := range flows {
+		for _, ep := range []*pbFlow.Endpoint{f.GetSource(), f.GetDestination()} {
+			if ep == nil {
+				continue
+			}
+
+			lbls := w.Labels(ep.GetIdentity())
+			if len(lbls) <= len(ep.GetLabels()) {
+				continue
+			}
+
+			ep.Labels = lbls
+			if len(ep.GetNamespace()) > 0 {
+				continue
+			}
+
+			for _, lbl := range lbls {
+				if ns, found := strings.CutPrefix(lbl, namespaceLabel+"="); found {
+					ep.Namespace = ns
+					break
+				}
+			}
+		}
+	}
+}
+
+// NOTE: Security labels are keyed by source and key, e.g. "k8s:app"
+func FlowLabels(securityLabels map[string]string) []string {
+	lbls := make([]string, 0, len(securityLabels))
+	for key, value := range securityLabels {
+		if len(value) == 0 {
+			lbls = append(lbls, key)
+			continue
+		}
+
+		lbls = append(lbls, key+"="+value)
+	}
+
+	slices.Sort(lbls)
+	return lbls
+}
+
+func labelsOnly(obj any) (any, error) {
+	cid, ok := obj.(*ciliumv2.CiliumIdentity)
+	if !ok {
+		return obj, nil
+	}
+
+	return &ciliumv2.CiliumIdentity{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cid.Name,
+			ResourceVersion: cid.ResourceVersion,
+		},
+		SecurityLabels: cid.SecurityLabels,
+	}, nil
+}
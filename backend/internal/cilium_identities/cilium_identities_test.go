@@ -0,0 +1,57 @@
+package cilium_identities
+
+import (
+	"slices"
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestEnrichFlows(t *testing.T) {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	err := store.Add(&ciliumv2.CiliumIdentity{
+		ObjectMeta: metav1.ObjectMeta{Name: "1001"},
+		SecurityLabels: map[string]string{
+			"k8s:app":                          "frontend",
+			"k8s:io.kubernetes.pod.namespace":  "shop",
+			"k8s:io.cilium.k8s.policy.cluster": "default",
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("failed to add identity: %v", err)
+	}
+
+	w := &Watcher{identities: store}
+	expected := []string{
+		"k8s:app=frontend",
+		"k8s:io.cilium.k8s.policy.cluster=default",
+		"k8s:io.kubernetes.pod.namespace=shop",
+	}
+
+	if lbls := w.Labels(1001); !slices.Equal(lbls, expected) {
+		t.Fatalf("unexpected labels of identity: %v", lbls)
+	}
+
+	full := []string{"k8s:app=frontend", "k8s:version=v2", "k8s:team=a", "k8s:tier=web"}
+	f := &pbFlow.Flow{
+		Source:      &pbFlow.Endpoint{Identity: 1001},
+		Destination: &pbFlow.Endpoint{Identity: 1001, Namespace: "shop", Labels: full},
+	}
+
+	w.EnrichFlows([]*pbFlow.Flow{f})
+	if src := f.GetSource(); !slices.Equal(src.GetLabels(), expected) || src.GetNamespace() != "shop" {
+		t.Fatalf("expected source to be backfilled, got %v", src)
+	}
+
+	if dest := f.GetDestination(); !slices.Equal(dest.GetLabels(), full) {
+		t.Fatalf("expected labels from the flow to be kept, got %v", dest)
+	}
+
+	if lbls := w.Labels(2); lbls != nil {
+		t.Fatalf("expected reserved identity to be skipped, got %v", lbls)
+	}
+}
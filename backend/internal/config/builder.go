@@ -1,11 +1,14 @@
 package config
 
 import (
+	"fmt"
 	"log/slog"
 
 	"github.com/cilium/cilium/pkg/crypto/certloader"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/pkg/errors"
+
+	"github.com/cilium/hubble-ui/backend/domain/service"
 )
 
 type ConfigBuilder struct {
@@ -32,6 +35,30 @@ func (b *ConfigBuilder) Build() (*Config, error) {
 		return nil, err
 	}
 
+	if err := b.initNamespaces(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := b.initActivity(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := b.initFlowHistory(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := b.initSavedViews(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := b.initServiceGrouping(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := b.initLocalCluster(cfg); err != nil {
+		return nil, err
+	}
+
 	if err := b.initTLSToRelay(cfg); err != nil {
 		return nil, err
 	}
@@ -114,6 +141,118 @@ func (b *ConfigBuilder) initServerPort(cfg *Config) error {
 	return nil
 }
 
+func (b *ConfigBuilder) initNamespaces(cfg *Config) error {
+	pollInterval := b.props.NamespacesPollInterval()
+	if err := pollInterval.Err(); err != nil {
+		return err
+	}
+
+	pollInterval.LogIfFallback(b.logger)
+	cfg.NamespacesPollInterval = pollInterval.Value
+
+	return nil
+}
+
+func (b *ConfigBuilder) initActivity(cfg *Config) error {
+	noActivityPeriod := b.props.NoActivityPeriod()
+	if err := noActivityPeriod.Err(); err != nil {
+		return err
+	}
+
+	noActivityPeriod.LogIfFallback(b.logger)
+	cfg.NoActivityPeriod = noActivityPeriod.Value
+
+	return nil
+}
+
+func (b *ConfigBuilder) initFlowHistory(cfg *Config) error {
+	size := b.props.FlowHistorySize()
+	if err := size.Err(); err != nil {
+		return err
+	}
+
+	size.LogIfFallback(b.logger)
+	cfg.FlowHistorySize = size.Value
+
+	return nil
+}
+
+func (b *ConfigBuilder) initSavedViews(cfg *Config) error {
+	file := b.props.SavedViewsFile()
+	if err := file.Err(); err != nil {
+		return err
+	}
+
+	file.LogIfFallback(b.logger)
+	cfg.SavedViewsFile = file.Value
+
+	// NOTE: Persistence is opt-in, since there is no writable location that
+	// every deployment is guaranteed to have
+	if len(file.Value) == 0 {
+		b.logger.Warn("saved views are kept in memory only and are lost on restart, " +
+			"set SAVED_VIEWS_FILE to a path on a persistent volume to keep them")
+	}
+
+	return nil
+}
+
+func (b *ConfigBuilder) initServiceGrouping(cfg *Config) error {
+	mode := b.props.ServiceGrouping()
+	if err := mode.Err(); err != nil {
+		return err
+	}
+
+	labelKey := b.props.ServiceGroupingLabel()
+	if err := labelKey.Err(); err != nil {
+		return err
+	}
+
+	nameLabels := b.props.ServiceNameLabels()
+	if err := nameLabels.Err(); err != nil {
+		return err
+	}
+
+	mode.LogIfFallback(b.logger)
+	labelKey.LogIfFallback(b.logger)
+	nameLabels.LogIfFallback(b.logger)
+
+	groupingMode, err := service.ParseGroupingMode(mode.Value)
+	if err != nil {
+		return fmt.Errorf("failed to parse env var '%s' value: %w", mode.VarName, err)
+	}
+
+	if groupingMode == service.GroupByLabel && len(labelKey.Value) == 0 {
+		return fmt.Errorf(
+			"env var '%s' is required for '%s' service grouping",
+			labelKey.VarName, groupingMode,
+		)
+	}
+
+	cfg.ServiceGrouping = service.Grouping{
+		Mode:     groupingMode,
+		LabelKey: labelKey.Value,
+	}
+
+	cfg.ServiceNameLabels = b.separatedStringList(nameLabels.Value, ",")
+	if len(cfg.ServiceNameLabels) == 0 {
+		return fmt.Errorf("env var '%s' has no label keys", nameLabels.VarName)
+	}
+
+	return nil
+}
+
+func (b *ConfigBuilder) initLocalCluster(cfg *Config) error {
+	name := b.props.LocalClusterName()
+	if err := name.Err(); err != nil {
+		return err
+	}
+
+	name.LogIfFallback(b.logger)
+	cfg.LocalClusterName = name.Value
+
+	return nil
+}
+
 func (b ConfigBuilder) initTLSToRelay(cfg *Config) error {
 	isEnabled := b.props.TLSToRelayEnabled()
 	if err := isEnabled.Err(); err != nil {
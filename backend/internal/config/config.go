@@ -9,6 +9,8 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/cilium/cilium/pkg/crypto/certloader"
+
+	"github.com/cilium/hubble-ui/backend/domain/service"
 )
 
 const (
@@ -33,6 +35,32 @@ type Config struct {
 	// The port which will be used to listen to on grpc server setup
 	UIServerPort uint16
 
+	// The delay between two GetNamespaces requests to hubble-relay, used when
+	// k8s namespaces cannot be listed
+	NamespacesPollInterval time.Duration
+
+	// The period without flows after which namespace is reported as having
+	// no activity, zero disables the notification
+	NoActivityPeriod time.Duration
+
+	// The number of recent flows kept in memory by backend to look them up
+	// by id or by link, zero disables the history
+	FlowHistorySize uint32
+
+	// The file where saved views are stored. Persistence is opt-in: views
+	// are kept in memory only and lost on restart if it's empty
+	SavedViewsFile string
+
+	// The way endpoints are grouped into service cards
+	ServiceGrouping service.Grouping
+
+	// The label keys used to name service cards, in the order of precedence
+	ServiceNameLabels []string
+
+	// ClusterMesh cluster the backend runs in, ids of its services are not
+	// prefixed with the cluster name. No ids are prefixed if it's empty.
+	LocalClusterName string
+
 	// NOTE: The delays that will be used to calculate the delay the client
 	// should use for waiting between two poll requests (custom protocol).
 	MinClientPollDelay time.Duration
@@ -15,6 +15,14 @@ type PropGetters struct {
 	DebugLogs                EnvVarGetter[bool]
 	RelayAddr                EnvVarGetter[string]
 	UIServerPort             EnvVarGetter[uint16]
+	NamespacesPollInterval   EnvVarGetter[time.Duration]
+	NoActivityPeriod         EnvVarGetter[time.Duration]
+	FlowHistorySize          EnvVarGetter[uint32]
+	SavedViewsFile           EnvVarGetter[string]
+	ServiceGrouping          EnvVarGetter[string]
+	ServiceGroupingLabel     EnvVarGetter[string]
+	ServiceNameLabels        EnvVarGetter[string]
+	LocalClusterName         EnvVarGetter[string]
 	TLSToRelayEnabled        EnvVarGetter[bool]
 	TLSToRelayServerName     EnvVarGetter[string]
 	TLSToRelayCACertFiles    EnvVarGetter[string]
@@ -100,6 +108,31 @@ func Uint16Or(envName string, def uint16) EnvVarGetter[uint16] {
 	}
 }
 
+func Uint32Or(envName string, def uint32) EnvVarGetter[uint32] {
+	return func() EnvVarResult[uint32] {
+		val, ok := os.LookupEnv(envName)
+		intValue := def
+		var err error
+
+		if ok {
+			parsed, _err := strconv.ParseUint(val, 10, 32)
+			if _err == nil {
+				intValue = uint32(parsed)
+			} else {
+				err = _err
+			}
+		}
+
+		return EnvVarResult[uint32]{
+			IsRequired:  false,
+			IsPresented: ok,
+			VarName:     envName,
+			Value:       intValue,
+			ParseErr:    err,
+		}
+	}
+}
+
 func StrOr(envName string, def string) EnvVarGetter[string] {
 	return func() EnvVarResult[string] {
 		val, ok := os.LookupEnv(envName)
@@ -0,0 +1,244 @@
+package filter_expression
+
+import (
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/hubble/k8s"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NOTE: Values of these fields are compared as is, so conjunction of two
+// filters keeps only the values both of them accept
+var exactFields = map[protoreflect.Name]struct{}{
+	"verdict":                  {},
+	"drop_reason_desc":         {},
+	"traffic_direction":        {},
+	"source_identity":          {},
+	"destination_identity":     {},
+	"source_port":              {},
+	"destination_port":         {},
+	"reply":                    {},
+	"uuid":                     {},
+	"protocol":                 {},
+	"http_method":              {},
+	"source_cluster_name":      {},
+	"destination_cluster_name": {},
+}
+
+// NOTE: Label selectors are joined into one selector with all requirements
+var selectorFields = map[protoreflect.Name]struct{}{
+	"source_label":      {},
+	"destination_label": {},
+	"node_labels":       {},
+}
+
+// NOTE: Pod filters are `namespace/pod-name-prefix`, so they are narrowed
+// down when one of them accepts a part of what another one does
+var prefixFields = map[protoreflect.Name]struct{}{
+	"source_pod":      {},
+	"destination_pod": {},
+}
+
+// NOTE: Filters that all have to match. Hubble ORs values of the same field,
+// so values that can't be intersected (CIDRs, regexps, wildcards) are ANDed
+// by keeping them in separate filters. The first filter is the one hubble
+// applies, the rest of them are checked by the backend on the flows it gets.
+type Clause []*flow.FlowFilter
+
+// NOTE: Returns the filters hubble applies
+func Primaries(clauses []Clause) []*flow.FlowFilter {
+	primaries := make([]*flow.FlowFilter, 0, len(clauses))
+	for _, c := range clauses {
+		primaries = append(primaries, c.Primary())
+	}
+
+	return primaries
+}
+
+func Clauses(ffs []*flow.FlowFilter) []Clause {
+	clauses := make([]Clause, 0, len(ffs))
+	for _, ff := range ffs {
+		clauses = append(clauses, Clause{ff})
+	}
+
+	return clauses
+}
+
+func (c Clause) Primary() *flow.FlowFilter {
+	if len(c) == 0 {
+		return &flow.FlowFilter{}
+	}
+
+	return c[0]
+}
+
+func (c Clause) IsSimple() bool {
+	return len(c) <= 1
+}
+
+// NOTE: The result is false if there are no values both clauses accept and
+// conjunction never matches
+func Conjunction(lhs, rhs Clause) (Clause, bool) {
+	merged := proto.Clone(lhs.Primary()).(*flow.FlowFilter)
+	mr := merged.ProtoReflect()
+
+	result := Clause{merged}
+	for _, c := range []Clause{lhs, rhs} {
+		if len(c) > 1 {
+			result = append(result, c[1:]...)
+		}
+	}
+
+	isSatisfiable := true
+	rhs.Primary().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, rv protoreflect.Value) bool {
+		_, isExact := exactFields[fd.Name()]
+		_, isSelector := selectorFields[fd.Name()]
+		_, isPrefix := prefixFields[fd.Name()]
+
+		switch {
+		case !fd.IsList() && mr.Has(fd):
+			proto.Merge(mr.Mutable(fd).Message().Interface(), rv.Message().Interface())
+		case !fd.IsList():
+			mr.Set(fd, cloneValue(fd, rv))
+		case !mr.Has(fd):
+			dst := mr.Mutable(fd).List()
+			for i := 0; i < rv.List().Len(); i++ {
+				dst.Append(cloneValue(fd, rv.List().Get(i)))
+			}
+		case isExact || isPrefix:
+			common := intersect(fd, mr.Get(fd).List(), rv.List(), mr.NewField(fd).List())
+			if common.Len() == 0 {
+				isSatisfiable = false
+				return false
+			}
+
+			mr.Set(fd, protoreflect.ValueOfList(common))
+		case isSelector:
+			mr.Set(fd, protoreflect.ValueOfList(
+				joinSelectors(mr.Get(fd).List(), rv.List(), mr.NewField(fd).List()),
+			))
+		default:
+			extra := &flow.FlowFilter{}
+			er := extra.ProtoReflect()
+
+			dst := er.Mutable(fd).List()
+			for i := 0; i < rv.List().Len(); i++ {
+				dst.Append(cloneValue(fd, rv.List().Get(i)))
+			}
+
+			result = append(result, extra)
+		}
+
+		return true
+	})
+
+	if !isSatisfiable {
+		return nil, false
+	}
+
+	return result, true
+}
+
+// NOTE: Conjunction of every pair of clauses, the result is false when none
+// of the pairs can match
+func ConjunctionAll(lhs, rhs []Clause) ([]Clause, bool) {
+	switch {
+	case len(lhs) == 0:
+		return rhs, true
+	case len(rhs) == 0:
+		return lhs, true
+	}
+
+	result := []Clause{}
+	for _, l := range lhs {
+		for _, r := range rhs {
+			if c, ok := Conjunction(l, r); ok {
+				result = append(result, c)
+			}
+		}
+	}
+
+	return result, len(result) > 0
+}
+
+func intersect(
+	fd protoreflect.FieldDescriptor, lhs, rhs, dst protoreflect.List,
+) protoreflect.List {
+	for i := 0; i < lhs.Len(); i++ {
+		for j := 0; j < rhs.Len(); j++ {
+			if v, ok := narrower(fd, lhs.Get(i), rhs.Get(j)); ok {
+				dst.Append(cloneValue(fd, v))
+			}
+		}
+	}
+
+	return dst
+}
+
+// NOTE: Selectors of the same field are ORed, so conjunction of them is the
+// selectors of every pair with requirements of both
+func joinSelectors(lhs, rhs, dst protoreflect.List) protoreflect.List {
+	for i := 0; i < lhs.Len(); i++ {
+		for j := 0; j < rhs.Len(); j++ {
+			l, r := lhs.Get(i).String(), rhs.Get(j).String()
+
+			joined := l + "," + r
+			if l == r {
+				joined = l
+			}
+
+			dst.Append(protoreflect.ValueOfString(joined))
+		}
+	}
+
+	return dst
+}
+
+func narrower(fd protoreflect.FieldDescriptor, lhs, rhs protoreflect.Value) (protoreflect.Value, bool) {
+	if fd.Message() != nil {
+		return lhs, proto.Equal(lhs.Message().Interface(), rhs.Message().Interface())
+	}
+
+	if lhs.Interface() == rhs.Interface() {
+		return lhs, true
+	}
+
+	if _, isPrefix := prefixFields[fd.Name()]; !isPrefix {
+		return lhs, false
+	}
+
+	pod, ok := narrowerPod(lhs.String(), rhs.String())
+	return protoreflect.ValueOfString(pod), ok
+}
+
+// NOTE: Pod filter `ns/` matches every pod of the namespace and `/pod`
+// matches pods of any namespace, pod without namespace is in `default`
+func narrowerPod(lhs, rhs string) (string, bool) {
+	lns, lpod := k8s.ParseNamespaceName(lhs)
+	rns, rpod := k8s.ParseNamespaceName(rhs)
+
+	if len(lns) > 0 && len(rns) > 0 && lns != rns {
+		return "", false
+	}
+
+	ns := max(lns, rns)
+
+	switch {
+	case strings.HasPrefix(rpod, lpod):
+		return ns + "/" + rpod, true
+	case strings.HasPrefix(lpod, rpod):
+		return ns + "/" + lpod, true
+	}
+
+	return "", false
+}
+
+func cloneValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+	if fd.Message() == nil {
+		return v
+	}
+
+	return protoreflect.ValueOfMessage(proto.Clone(v.Message().Interface()).ProtoReflect())
+}
@@ -0,0 +1,239 @@
+package filter_expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/flow"
+)
+
+type setter func(ff *flow.FlowFilter, v string) error
+
+// NOTE: Directional fields have source and destination setters and are
+// available as `from-<name>`, `to-<name>` and `<name>`, the latter one matches
+// both sides just like corresponding `hubble observe` flag
+type field struct {
+	source      setter
+	destination setter
+	any         setter
+}
+
+var fields = map[string]*field{
+	"pod": {
+		source:      appendString(func(ff *flow.FlowFilter) *[]string { return &ff.SourcePod }),
+		destination: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.DestinationPod }),
+	},
+	"namespace": {
+		source:      namespace(func(ff *flow.FlowFilter) *[]string { return &ff.SourcePod }),
+		destination: namespace(func(ff *flow.FlowFilter) *[]string { return &ff.DestinationPod }),
+	},
+	"ip": {
+		source:      appendString(func(ff *flow.FlowFilter) *[]string { return &ff.SourceIp }),
+		destination: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.DestinationIp }),
+	},
+	"label": {
+		source:      appendString(func(ff *flow.FlowFilter) *[]string { return &ff.SourceLabel }),
+		destination: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.DestinationLabel }),
+	},
+	"fqdn": {
+		source:      appendString(func(ff *flow.FlowFilter) *[]string { return &ff.SourceFqdn }),
+		destination: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.DestinationFqdn }),
+	},
+	"service": {
+		source:      appendString(func(ff *flow.FlowFilter) *[]string { return &ff.SourceService }),
+		destination: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.DestinationService }),
+	},
+	"cluster": {
+		source:      appendString(func(ff *flow.FlowFilter) *[]string { return &ff.SourceClusterName }),
+		destination: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.DestinationClusterName }),
+	},
+	"port": {
+		source:      port(func(ff *flow.FlowFilter) *[]string { return &ff.SourcePort }),
+		destination: port(func(ff *flow.FlowFilter) *[]string { return &ff.DestinationPort }),
+	},
+	"identity": {
+		source:      identity(func(ff *flow.FlowFilter) *[]uint32 { return &ff.SourceIdentity }),
+		destination: identity(func(ff *flow.FlowFilter) *[]uint32 { return &ff.DestinationIdentity }),
+	},
+	"workload": {
+		source:      workload(func(ff *flow.FlowFilter) *[]*flow.Workload { return &ff.SourceWorkload }),
+		destination: workload(func(ff *flow.FlowFilter) *[]*flow.Workload { return &ff.DestinationWorkload }),
+	},
+	"verdict": {
+		any: func(ff *flow.FlowFilter, v string) error {
+			verdict, err := enumValue("verdict", flow.Verdict_value, v)
+			if err != nil {
+				return err
+			}
+
+			ff.Verdict = append(ff.Verdict, flow.Verdict(verdict))
+			return nil
+		},
+	},
+	"drop-reason": {
+		any: func(ff *flow.FlowFilter, v string) error {
+			reason, err := enumValue("drop reason", flow.DropReason_value, v)
+			if err != nil {
+				return err
+			}
+
+			ff.DropReasonDesc = append(ff.DropReasonDesc, flow.DropReason(reason))
+			return nil
+		},
+	},
+	"traffic-direction": {
+		any: func(ff *flow.FlowFilter, v string) error {
+			dir, err := enumValue("traffic direction", flow.TrafficDirection_value, v)
+			if err != nil {
+				return err
+			}
+
+			ff.TrafficDirection = append(ff.TrafficDirection, flow.TrafficDirection(dir))
+			return nil
+		},
+	},
+	"protocol": {
+		any: func(ff *flow.FlowFilter, v string) error {
+			ff.Protocol = append(ff.Protocol, strings.ToLower(v))
+			return nil
+		},
+	},
+	"reply": {
+		any: func(ff *flow.FlowFilter, v string) error {
+			reply, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("reply must be true or false, got '%s'", v)
+			}
+
+			ff.Reply = append(ff.Reply, reply)
+			return nil
+		},
+	},
+	"http-status": {
+		any: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.HttpStatusCode }),
+	},
+	"http-method": {
+		any: func(ff *flow.FlowFilter, v string) error {
+			ff.HttpMethod = append(ff.HttpMethod, strings.ToUpper(v))
+			return nil
+		},
+	},
+	"http-path": {
+		any: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.HttpPath }),
+	},
+	"http-url": {
+		any: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.HttpUrl }),
+	},
+	"dns-query": {
+		any: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.DnsQuery }),
+	},
+	"node-name": {
+		any: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.NodeName }),
+	},
+	"node-label": {
+		any: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.NodeLabels }),
+	},
+	"uuid": {
+		any: appendString(func(ff *flow.FlowFilter) *[]string { return &ff.Uuid }),
+	},
+}
+
+// NOTE: Returns setters that are ORed, i.e. `pod=x` gives two of them
+func settersForKey(key string) ([]setter, bool) {
+	if f, exists := fields[key]; exists {
+		if f.any != nil {
+			return []setter{f.any}, true
+		}
+
+		return []setter{f.source, f.destination}, true
+	}
+
+	if name, found := strings.CutPrefix(key, "from-"); found {
+		if f, exists := fields[name]; exists && f.source != nil {
+			return []setter{f.source}, true
+		}
+	}
+
+	if name, found := strings.CutPrefix(key, "to-"); found {
+		if f, exists := fields[name]; exists && f.destination != nil {
+			return []setter{f.destination}, true
+		}
+	}
+
+	return nil, false
+}
+
+func appendString(get func(ff *flow.FlowFilter) *[]string) setter {
+	return func(ff *flow.FlowFilter, v string) error {
+		dst := get(ff)
+		*dst = append(*dst, v)
+		return nil
+	}
+}
+
+// NOTE: Namespace filter is a pod filter with namespace prefix only, the
+// same way hubble CLI does that
+func namespace(get func(ff *flow.FlowFilter) *[]string) setter {
+	return func(ff *flow.FlowFilter, v string) error {
+		if strings.Contains(v, "/") {
+			return fmt.Errorf("namespace must not contain '/', got '%s'", v)
+		}
+
+		dst := get(ff)
+		*dst = append(*dst, v+"/")
+		return nil
+	}
+}
+
+func port(get func(ff *flow.FlowFilter) *[]string) setter {
+	return func(ff *flow.FlowFilter, v string) error {
+		if _, err := strconv.ParseUint(v, 10, 16); err != nil {
+			return fmt.Errorf("port must be a number from 0 to 65535, got '%s'", v)
+		}
+
+		dst := get(ff)
+		*dst = append(*dst, v)
+		return nil
+	}
+}
+
+func identity(get func(ff *flow.FlowFilter) *[]uint32) setter {
+	return func(ff *flow.FlowFilter, v string) error {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("identity must be a number, got '%s'", v)
+		}
+
+		dst := get(ff)
+		*dst = append(*dst, uint32(id))
+		return nil
+	}
+}
+
+// NOTE: Workload is either `name` or `kind/name`
+func workload(get func(ff *flow.FlowFilter) *[]*flow.Workload) setter {
+	return func(ff *flow.FlowFilter, v string) error {
+		w := &flow.Workload{Name: v}
+		if kind, name, found := strings.Cut(v, "/"); found {
+			w.Kind, w.Name = kind, name
+		}
+
+		if len(w.Name) == 0 {
+			return fmt.Errorf("workload name is empty in '%s'", v)
+		}
+
+		dst := get(ff)
+		*dst = append(*dst, w)
+		return nil
+	}
+}
+
+func enumValue(what string, values map[string]int32, v string) (int32, error) {
+	n, exists := values[strings.ToUpper(strings.ReplaceAll(v, "-", "_"))]
+	if !exists {
+		return 0, fmt.Errorf("unknown %s '%s'", what, v)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,238 @@
+package filter_expression
+
+import (
+	"context"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/hubble/filters"
+
+	"github.com/cilium/hubble-ui/backend/pkg/logger"
+)
+
+// NOTE: Expression is compiled into disjunctive normal form, this limits the
+// number of produced filters so that `(a or b) and (c or d) and ...` can't
+// blow up the request
+const MaxFilters = 64
+
+var (
+	log = logger.New("filter-expression")
+)
+
+// NOTE: Flow passes the filters if it matches any of Whitelist clauses and
+// doesn't match any of Blacklist clauses
+type Filters struct {
+	Whitelist []Clause
+	Blacklist []Clause
+}
+
+// NOTE: Filters are simple if hubble can apply them as they are, i.e. every
+// clause consists of one filter
+func (f *Filters) IsSimple() bool {
+	return allSimple(f.Whitelist) && allSimple(f.Blacklist)
+}
+
+type literal struct {
+	pos int
+	ff  *flow.FlowFilter
+}
+
+// NOTE: Disjunction of conjunctions of literals
+type dnf [][]literal
+
+// NOTE: Negations are only allowed for the operands of top level `and` since
+// they are turned into blacklist filters, which are applied to every flow
+func Parse(expr string) (*Filters, error) {
+	root, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Filters{}
+	if root == nil {
+		return result, nil
+	}
+
+	positive := []*node{}
+	for _, n := range flattenAnd(root) {
+		for n.kind == nodeNot && n.children[0].kind == nodeNot {
+			n = n.children[0].children[0]
+		}
+
+		if n.kind != nodeNot {
+			positive = append(positive, n)
+			continue
+		}
+
+		negated, err := toDNF(n.children[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, conj := range negated {
+			// NOTE: Contradicting conjunction never matches, nothing to exclude
+			if c, _ := conjunctionClause(conj); c != nil {
+				result.Blacklist = append(result.Blacklist, c)
+			}
+		}
+	}
+
+	if len(positive) == 0 {
+		return result, checkSize(result, root.pos)
+	}
+
+	whitelist := dnf{{}}
+	for _, n := range positive {
+		d, err := toDNF(n)
+		if err != nil {
+			return nil, err
+		}
+
+		if whitelist, err = product(whitelist, d, n.pos); err != nil {
+			return nil, err
+		}
+	}
+
+	conflictPos := -1
+	for _, conj := range whitelist {
+		c, pos := conjunctionClause(conj)
+		if c == nil {
+			conflictPos = max(conflictPos, pos)
+			continue
+		}
+
+		result.Whitelist = append(result.Whitelist, c)
+	}
+
+	// NOTE: Empty whitelist means "everything", so expression that never
+	// matches has to be rejected
+	if len(result.Whitelist) == 0 {
+		return nil, errorf(conflictPos, "filter contradicts the rest of expression, nothing can match it")
+	}
+
+	return result, checkSize(result, root.pos)
+}
+
+func flattenAnd(n *node) []*node {
+	if n.kind != nodeAnd {
+		return []*node{n}
+	}
+
+	flat := []*node{}
+	for _, child := range n.children {
+		flat = append(flat, flattenAnd(child)...)
+	}
+
+	return flat
+}
+
+func toDNF(n *node) (dnf, error) {
+	switch n.kind {
+	case nodeTerm:
+		return termDNF(n.term)
+	case nodeOr:
+		result := dnf{}
+		for _, child := range n.children {
+			d, err := toDNF(child)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, d...)
+			if len(result) > MaxFilters {
+				return nil, errorf(n.pos, "expression is too complex, it produces more than %d filters", MaxFilters)
+			}
+		}
+
+		return result, nil
+	case nodeAnd:
+		result := dnf{{}}
+		for _, child := range n.children {
+			d, err := toDNF(child)
+			if err != nil {
+				return nil, err
+			}
+
+			if result, err = product(result, d, n.pos); err != nil {
+				return nil, err
+			}
+		}
+
+		return result, nil
+	}
+
+	return nil, errorf(n.pos, "'not' can only be applied to the whole expression or its parts joined with 'and'")
+}
+
+func product(lhs, rhs dnf, pos int) (dnf, error) {
+	if len(lhs)*len(rhs) > MaxFilters {
+		return nil, errorf(pos, "expression is too complex, it produces more than %d filters", MaxFilters)
+	}
+
+	result := make(dnf, 0, len(lhs)*len(rhs))
+	for _, l := range lhs {
+		for _, r := range rhs {
+			conj := make([]literal, 0, len(l)+len(r))
+			conj = append(conj, l...)
+			conj = append(conj, r...)
+
+			result = append(result, conj)
+		}
+	}
+
+	return result, nil
+}
+
+func termDNF(tok *token) (dnf, error) {
+	setters, exists := settersForKey(tok.key)
+	if !exists {
+		return nil, errorf(tok.pos, "unknown filter '%s'", tok.key)
+	}
+
+	result := make(dnf, 0, len(setters))
+	for _, set := range setters {
+		ff := &flow.FlowFilter{}
+		for _, v := range tok.values {
+			if err := set(ff, v.raw); err != nil {
+				return nil, errorf(v.pos, "%s", err.Error())
+			}
+		}
+
+		// NOTE: Hubble validates things like CIDRs and regexps itself, so the
+		// error is reported at the position of the filter that causes it
+		if _, err := filters.BuildFilterList(
+			context.Background(),
+			[]*flow.FlowFilter{ff},
+			filters.DefaultFilters(log),
+		); err != nil {
+			return nil, errorf(tok.pos, "invalid filter '%s': %s", tok.key, err.Error())
+		}
+
+		result = append(result, []literal{{pos: tok.pos, ff: ff}})
+	}
+
+	return result, nil
+}
+
+// NOTE: Returns nil and position of the literal that makes conjunction
+// contradictory if there is such
+func conjunctionClause(conj []literal) (Clause, int) {
+	c := Clause{&flow.FlowFilter{}}
+	for _, lit := range conj {
+		merged, ok := Conjunction(c, Clause{lit.ff})
+		if !ok {
+			return nil, lit.pos
+		}
+
+		c = merged
+	}
+
+	return c, -1
+}
+
+func checkSize(f *Filters, pos int) error {
+	if len(f.Whitelist)+len(f.Blacklist) > MaxFilters {
+		return errorf(pos, "expression is too complex, it produces more than %d filters", MaxFilters)
+	}
+
+	return nil
+}
@@ -0,0 +1,279 @@
+package filter_expression
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParse(t *testing.T) {
+	f, err := Parse("from-pod=default/api and verdict=DROPPED and not port=53")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectFilters(t, Primaries(f.Whitelist), &flow.FlowFilter{
+		SourcePod: []string{"default/api"},
+		Verdict:   []flow.Verdict{flow.Verdict_DROPPED},
+	})
+
+	if !f.IsSimple() {
+		t.Fatalf("expected filters to be simple: %v", f)
+	}
+
+	expectFilters(t, Primaries(f.Blacklist),
+		&flow.FlowFilter{SourcePort: []string{"53"}},
+		&flow.FlowFilter{DestinationPort: []string{"53"}},
+	)
+}
+
+func TestParseDisjunction(t *testing.T) {
+	f, err := Parse(`namespace=kube-system (to-port=53,"8053" or protocol=ICMPv4)`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectFilters(t, Primaries(f.Whitelist),
+		&flow.FlowFilter{SourcePod: []string{"kube-system/"}, DestinationPort: []string{"53", "8053"}},
+		&flow.FlowFilter{SourcePod: []string{"kube-system/"}, Protocol: []string{"icmpv4"}},
+		&flow.FlowFilter{DestinationPod: []string{"kube-system/"}, DestinationPort: []string{"53", "8053"}},
+		&flow.FlowFilter{DestinationPod: []string{"kube-system/"}, Protocol: []string{"icmpv4"}},
+	)
+
+	if len(f.Blacklist) != 0 {
+		t.Fatalf("unexpected blacklist: %v", f.Blacklist)
+	}
+}
+
+func TestParseConjunctionOfSameField(t *testing.T) {
+	f, err := Parse("from-namespace=default and from-pod=default/api and verdict=DROPPED,FORWARDED and verdict=DROPPED")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectFilters(t, Primaries(f.Whitelist), &flow.FlowFilter{
+		SourcePod: []string{"default/api"},
+		Verdict:   []flow.Verdict{flow.Verdict_DROPPED},
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		expr string
+		pos  int
+	}{
+		{"verdict=DROPED", 8},
+		{"from-pod=a and", 14},
+		{"(verdict=DROPPED", 0},
+		{"verdict=DROPPED )", 16},
+		{"unknown=1", 0},
+		{"port=80,", 8},
+		{"to-port=http", 8},
+		{"verdict=DROPPED or not port=53", 19},
+		{"from-pod=\"a", 9},
+		{"verdict=DROPPED verdict=FORWARDED", 16},
+		{"from-ip=10.0.0.0/33", 0},
+		{"port 80", 0},
+	}
+
+	for _, c := range cases {
+		_, err := Parse(c.expr)
+
+		perr := new(Error)
+		if !errors.As(err, &perr) {
+			t.Fatalf("expected parse error for %q, got %v", c.expr, err)
+		}
+
+		if perr.Pos != c.pos {
+			t.Fatalf("expected error at %d for %q, got %d: %v", c.pos, c.expr, perr.Pos, perr)
+		}
+	}
+}
+
+func TestConjunctionAll(t *testing.T) {
+	requested := []*flow.FlowFilter{
+		{SourcePod: []string{"default/"}},
+		{DestinationPod: []string{"default/"}},
+	}
+
+	expr := []*flow.FlowFilter{
+		{DestinationPod: []string{"default/api"}, Verdict: []flow.Verdict{flow.Verdict_DROPPED}},
+	}
+
+	combined, ok := ConjunctionAll(Clauses(requested), Clauses(expr))
+	if !ok {
+		t.Fatalf("expected combined filters to be satisfiable")
+	}
+
+	expectFilters(t, Primaries(combined),
+		&flow.FlowFilter{
+			SourcePod:      []string{"default/"},
+			DestinationPod: []string{"default/api"},
+			Verdict:        []flow.Verdict{flow.Verdict_DROPPED},
+		},
+		&flow.FlowFilter{
+			DestinationPod: []string{"default/api"},
+			Verdict:        []flow.Verdict{flow.Verdict_DROPPED},
+		},
+	)
+
+	if _, ok := ConjunctionAll(Clauses(requested[1:]), Clauses([]*flow.FlowFilter{
+		{DestinationPod: []string{"kube-system/"}},
+	})); ok {
+		t.Fatalf("expected combined filters to be contradictory")
+	}
+}
+
+func TestParseConjunctionOfLabels(t *testing.T) {
+	f, err := Parse("from-label=app=api and from-label=env=prod,env=dev and node-label=zone=a and node-label=zone=a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectClause(t, f.Whitelist, &flow.FlowFilter{
+		SourceLabel: []string{"app=api,env=prod", "app=api,env=dev"},
+		NodeLabels:  []string{"zone=a"},
+	})
+}
+
+func TestParseConjunctionOfPods(t *testing.T) {
+	cases := map[string]string{
+		"from-pod=default/api and from-pod=default/api-7d":  "default/api-7d",
+		"from-namespace=shop and from-pod=/cart":            "shop/cart",
+		"from-pod=api and from-pod=default/api-server":      "default/api-server",
+		"from-namespace=shop and from-namespace=shop":       "shop/",
+		"from-pod=shop/cart and from-pod=shop/cart-1,api-1": "shop/cart-1",
+	}
+
+	for expr, pod := range cases {
+		f, err := Parse(expr)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", expr, err)
+		}
+
+		expectClause(t, f.Whitelist, &flow.FlowFilter{SourcePod: []string{pod}})
+	}
+
+	if _, err := Parse("from-namespace=shop and from-pod=default/api"); err == nil {
+		t.Fatalf("expected pods of different namespaces to be contradictory")
+	}
+}
+
+// NOTE: Values of these fields can't be intersected, so every one of them
+// is kept in a separate filter of the clause
+func TestParseConjunctionOfPatterns(t *testing.T) {
+	cases := []struct {
+		expr     string
+		expected []*flow.FlowFilter
+	}{
+		{
+			"from-ip=10.0.0.0/8 and from-ip=10.1.2.3",
+			[]*flow.FlowFilter{
+				{SourceIp: []string{"10.0.0.0/8"}},
+				{SourceIp: []string{"10.1.2.3"}},
+			},
+		},
+		{
+			"to-fqdn=*.example.com and to-fqdn=api.*",
+			[]*flow.FlowFilter{
+				{DestinationFqdn: []string{"*.example.com"}},
+				{DestinationFqdn: []string{"api.*"}},
+			},
+		},
+		{
+			"verdict=FORWARDED and http-path=/api/.* and http-path=.*/users",
+			[]*flow.FlowFilter{
+				{Verdict: []flow.Verdict{flow.Verdict_FORWARDED}, HttpPath: []string{"/api/.*"}},
+				{HttpPath: []string{".*/users"}},
+			},
+		},
+		{
+			"node-name=eu-*/* and node-name=*/worker-*",
+			[]*flow.FlowFilter{
+				{NodeName: []string{"eu-*/*"}},
+				{NodeName: []string{"*/worker-*"}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		f, err := Parse(c.expr)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", c.expr, err)
+		}
+
+		expectClause(t, f.Whitelist, c.expected...)
+
+		if f.IsSimple() {
+			t.Fatalf("expected filters of %q not to be simple", c.expr)
+		}
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	f, err := Parse("from-ip=10.0.0.0/8 and from-ip=10.1.0.0/16 and not (to-ip=192.168.0.0/16 and to-ip=192.168.1.1)")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if hubble := HubbleBlacklist(f.Blacklist); len(hubble) != 0 {
+		t.Fatalf("expected blacklist to be left for matcher, got %v", hubble)
+	}
+
+	m, err := NewMatcher(f.Whitelist, f.Blacklist)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		src, dst string
+		expected bool
+	}{
+		{"10.1.2.3", "172.16.0.1", true},
+		{"10.2.3.4", "172.16.0.1", false},
+		{"10.1.2.3", "192.168.2.2", true},
+		{"10.1.2.3", "192.168.1.1", false},
+	}
+
+	for _, c := range cases {
+		fl := &flow.Flow{IP: &flow.IP{Source: c.src, Destination: c.dst}}
+		if m.Match(fl) != c.expected {
+			t.Fatalf("expected match of %s -> %s to be %v", c.src, c.dst, c.expected)
+		}
+	}
+
+	simple, err := Parse("verdict=DROPPED and not port=53")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if m, err := NewMatcher(simple.Whitelist, simple.Blacklist); m != nil || err != nil {
+		t.Fatalf("expected no matcher when hubble applies every filter, got %v, %v", m, err)
+	}
+}
+
+func expectClause(t *testing.T, actual []Clause, expected ...*flow.FlowFilter) {
+	t.Helper()
+
+	if len(actual) != 1 {
+		t.Fatalf("expected one clause, got %v", actual)
+	}
+
+	expectFilters(t, actual[0], expected...)
+}
+
+func expectFilters(t *testing.T, actual []*flow.FlowFilter, expected ...*flow.FlowFilter) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d filters, got %d: %v", len(expected), len(actual), actual)
+	}
+
+	for i := range expected {
+		if !proto.Equal(actual[i], expected[i]) {
+			t.Fatalf("filter %d: expected %v, got %v", i, expected[i], actual[i])
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package filter_expression
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenTerm
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+)
+
+type value struct {
+	pos int
+	raw string
+}
+
+type token struct {
+	kind tokenKind
+	pos  int
+
+	// NOTE: Only set for terms, i.e. for `key=value1,value2` or `key!=value`
+	key       string
+	isNegated bool
+	values    []value
+}
+
+type lexer struct {
+	input string
+	pos   int
+}
+
+func tokenize(input string) ([]*token, error) {
+	lx := &lexer{input: input}
+	tokens := []*token{}
+
+	for {
+		tok, err := lx.next()
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, tok)
+		if tok.kind == tokenEOF {
+			return tokens, nil
+		}
+	}
+}
+
+func (lx *lexer) next() (*token, error) {
+	lx.skipSpaces()
+	if lx.pos >= len(lx.input) {
+		return &token{kind: tokenEOF, pos: len(lx.input)}, nil
+	}
+
+	start := lx.pos
+	switch lx.input[lx.pos] {
+	case '(':
+		lx.pos += 1
+		return &token{kind: tokenLParen, pos: start}, nil
+	case ')':
+		lx.pos += 1
+		return &token{kind: tokenRParen, pos: start}, nil
+	}
+
+	word := lx.readWhile(isKeyChar)
+	if len(word) == 0 {
+		return nil, errorf(start, "unexpected character '%c'", lx.input[start])
+	}
+
+	switch {
+	case strings.HasPrefix(lx.input[lx.pos:], "!="):
+		lx.pos += 2
+		return lx.term(start, word, true)
+	case strings.HasPrefix(lx.input[lx.pos:], "="):
+		lx.pos += 1
+		return lx.term(start, word, false)
+	}
+
+	switch strings.ToLower(word) {
+	case "and":
+		return &token{kind: tokenAnd, pos: start}, nil
+	case "or":
+		return &token{kind: tokenOr, pos: start}, nil
+	case "not":
+		return &token{kind: tokenNot, pos: start}, nil
+	}
+
+	return nil, errorf(start, "expected filter in form key=value, got '%s'", word)
+}
+
+func (lx *lexer) term(start int, key string, isNegated bool) (*token, error) {
+	tok := &token{
+		kind:      tokenTerm,
+		pos:       start,
+		key:       strings.ToLower(key),
+		isNegated: isNegated,
+	}
+
+	for {
+		v, err := lx.value()
+		if err != nil {
+			return nil, err
+		}
+
+		tok.values = append(tok.values, v)
+		if lx.pos >= len(lx.input) || lx.input[lx.pos] != ',' {
+			return tok, nil
+		}
+
+		lx.pos += 1
+	}
+}
+
+func (lx *lexer) value() (value, error) {
+	start := lx.pos
+	if lx.pos < len(lx.input) && lx.input[lx.pos] == '"' {
+		return lx.quoted()
+	}
+
+	raw := lx.readWhile(isValueChar)
+	if len(raw) == 0 {
+		return value{}, errorf(start, "value is expected")
+	}
+
+	return value{pos: start, raw: raw}, nil
+}
+
+func (lx *lexer) quoted() (value, error) {
+	start := lx.pos
+	lx.pos += 1
+
+	b := new(strings.Builder)
+	for lx.pos < len(lx.input) {
+		c := lx.input[lx.pos]
+		switch {
+		case c == '\\' && lx.pos+1 < len(lx.input):
+			b.WriteByte(lx.input[lx.pos+1])
+			lx.pos += 2
+		case c == '"':
+			lx.pos += 1
+			return value{pos: start, raw: b.String()}, nil
+		default:
+			b.WriteByte(c)
+			lx.pos += 1
+		}
+	}
+
+	return value{}, errorf(start, "unterminated quoted value")
+}
+
+func (lx *lexer) skipSpaces() {
+	lx.readWhile(func(r rune) bool { return unicode.IsSpace(r) })
+}
+
+func (lx *lexer) readWhile(pred func(r rune) bool) string {
+	start := lx.pos
+	for lx.pos < len(lx.input) && pred(rune(lx.input[lx.pos])) {
+		lx.pos += 1
+	}
+
+	return lx.input[start:lx.pos]
+}
+
+func isKeyChar(r rune) bool {
+	return r == '-' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isValueChar(r rune) bool {
+	return !unicode.IsSpace(r) && r != ',' && r != ')' && r != '(' && r != '"'
+}
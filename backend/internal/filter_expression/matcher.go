@@ -0,0 +1,114 @@
+package filter_expression
+
+import (
+	"context"
+
+	"github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/hubble/filters"
+)
+
+// NOTE: Matcher applies the clauses hubble can't apply itself to the flows
+// hubble returns. Nil Matcher lets every flow through.
+type Matcher struct {
+	whitelist []filters.FilterFuncs
+	blacklist []filters.FilterFuncs
+}
+
+// NOTE: Returns nil if hubble applies all the clauses on its own, i.e. if
+// every clause consists of one filter
+func NewMatcher(whitelist, blacklist []Clause) (*Matcher, error) {
+	if allSimple(whitelist) && allSimple(blacklist) {
+		return nil, nil
+	}
+
+	m := &Matcher{}
+
+	for _, c := range whitelist {
+		fs, err := buildClause(c)
+		if err != nil {
+			return nil, err
+		}
+
+		m.whitelist = append(m.whitelist, fs)
+	}
+
+	// NOTE: Simple blacklist clauses are applied by hubble
+	for _, c := range blacklist {
+		if c.IsSimple() {
+			continue
+		}
+
+		fs, err := buildClause(c)
+		if err != nil {
+			return nil, err
+		}
+
+		m.blacklist = append(m.blacklist, fs)
+	}
+
+	return m, nil
+}
+
+func (m *Matcher) Match(f *flow.Flow) bool {
+	if m == nil {
+		return true
+	}
+
+	ev := &v1.Event{Event: f}
+
+	for _, fs := range m.blacklist {
+		if fs.MatchAll(ev) {
+			return false
+		}
+	}
+
+	if len(m.whitelist) == 0 {
+		return true
+	}
+
+	for _, fs := range m.whitelist {
+		if fs.MatchAll(ev) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NOTE: Blacklist clauses that hubble can't apply are left for Matcher,
+// sending their first filters would exclude more flows than they should
+func HubbleBlacklist(clauses []Clause) []*flow.FlowFilter {
+	ffs := []*flow.FlowFilter{}
+	for _, c := range clauses {
+		if c.IsSimple() {
+			ffs = append(ffs, c.Primary())
+		}
+	}
+
+	return ffs
+}
+
+func buildClause(c Clause) (filters.FilterFuncs, error) {
+	fs := filters.FilterFuncs{}
+	for _, ff := range c {
+		built, err := filters.BuildFilter(context.Background(), ff, filters.DefaultFilters(log))
+		if err != nil {
+			return nil, err
+		}
+
+		fs = append(fs, built...)
+	}
+
+	return fs, nil
+}
+
+func allSimple(clauses []Clause) bool {
+	for _, c := range clauses {
+		if !c.IsSimple() {
+			return false
+		}
+	}
+
+	return true
+}
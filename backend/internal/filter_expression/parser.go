@@ -0,0 +1,187 @@
+package filter_expression
+
+import "fmt"
+
+type Error struct {
+	// NOTE: Zero-based byte offset in the expression
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (at position %d)", e.Msg, e.Pos+1)
+}
+
+func errorf(pos int, format string, args ...any) *Error {
+	return &Error{
+		Pos: pos,
+		Msg: fmt.Sprintf(format, args...),
+	}
+}
+
+type nodeKind int
+
+const (
+	nodeTerm nodeKind = iota
+	nodeNot
+	nodeAnd
+	nodeOr
+)
+
+type node struct {
+	kind     nodeKind
+	pos      int
+	term     *token
+	children []*node
+}
+
+// NOTE: Grammar, adjacent terms without operator are joined with `and` just
+// like `hubble observe` flags are:
+//
+//	expr  = and { "or" and }
+//	and   = unary { ["and"] unary }
+//	unary = "not" unary | "(" expr ")" | key "=" values | key "!=" values
+type parser struct {
+	tokens []*token
+	pos    int
+}
+
+func parse(input string) (*node, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, nil
+	}
+
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, unexpected(tok)
+	}
+
+	return n, nil
+}
+
+func (p *parser) expr() (*node, error) {
+	first, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	or := &node{kind: nodeOr, pos: first.pos, children: []*node{first}}
+	for p.peek().kind == tokenOr {
+		p.advance()
+
+		n, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+
+		or.children = append(or.children, n)
+	}
+
+	if len(or.children) == 1 {
+		return first, nil
+	}
+
+	return or, nil
+}
+
+func (p *parser) and() (*node, error) {
+	first, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	and := &node{kind: nodeAnd, pos: first.pos, children: []*node{first}}
+	for {
+		switch p.peek().kind {
+		case tokenAnd:
+			p.advance()
+		case tokenTerm, tokenNot, tokenLParen:
+		default:
+			if len(and.children) == 1 {
+				return first, nil
+			}
+
+			return and, nil
+		}
+
+		n, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		and.children = append(and.children, n)
+	}
+}
+
+func (p *parser) unary() (*node, error) {
+	tok := p.advance()
+
+	switch tok.kind {
+	case tokenNot:
+		n, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &node{kind: nodeNot, pos: tok.pos, children: []*node{n}}, nil
+	case tokenLParen:
+		n, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek().kind != tokenRParen {
+			return nil, errorf(tok.pos, "unclosed '('")
+		}
+
+		p.advance()
+		return n, nil
+	case tokenTerm:
+		n := &node{kind: nodeTerm, pos: tok.pos, term: tok}
+		if tok.isNegated {
+			return &node{kind: nodeNot, pos: tok.pos, children: []*node{n}}, nil
+		}
+
+		return n, nil
+	}
+
+	return nil, unexpected(tok)
+}
+
+func (p *parser) peek() *token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) advance() *token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos += 1
+	}
+
+	return tok
+}
+
+func unexpected(tok *token) *Error {
+	switch tok.kind {
+	case tokenEOF:
+		return errorf(tok.pos, "unexpected end of expression")
+	case tokenRParen:
+		return errorf(tok.pos, "unexpected ')'")
+	case tokenAnd:
+		return errorf(tok.pos, "unexpected 'and'")
+	case tokenOr:
+		return errorf(tok.pos, "unexpected 'or'")
+	}
+
+	return errorf(tok.pos, "unexpected token")
+}
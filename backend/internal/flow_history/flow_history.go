@@ -0,0 +1,155 @@
+package flow_history
+
+import (
+	"sync"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/domain/service"
+	"github.com/cilium/hubble-ui/backend/pkg/ring_buffer"
+)
+
+// NOTE: History keeps a bounded amount of recently seen flows shared between
+// all the streams of backend instance, so that oneshot routes are able to
+// refer to flows and links that user has seen on the map
+type History struct {
+	mx sync.RWMutex
+
+	size int
+	ring *ring_buffer.RingBuffer[entry]
+
+	byUUID map[string]*pbFlow.Flow
+}
+
+type entry struct {
+	flow *pbFlow.Flow
+}
+
+func New(size int) *History {
+	return &History{
+		size:   size,
+		ring:   ring_buffer.New[entry](size),
+		byUUID: make(map[string]*pbFlow.Flow),
+	}
+}
+
+func (h *History) Push(flows []*pbFlow.Flow) {
+	if h == nil || h.size == 0 {
+		return
+	}
+
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	for _, f := range flows {
+		if f == nil {
+			continue
+		}
+
+		uuid := f.GetUuid()
+		if len(uuid) > 0 {
+			// NOTE: The same flow can come from several streams at once
+			if _, exists := h.byUUID[uuid]; exists {
+				continue
+			}
+		}
+
+		h.ring.PushUpdate(func(e *entry) {
+			h.evict(e)
+
+			e.flow = f
+		})
+
+		if len(uuid) > 0 {
+			h.byUUID[uuid] = f
+		}
+	}
+}
+
+func (h *History) ByUUID(uuid string) *pbFlow.Flow {
+	if h == nil {
+		return nil
+	}
+
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return h.byUUID[uuid]
+}
+
+// NOTE: linkId is the id of the link between service cards, the way it is
+// shown on the map
+func (h *History) LatestByLink(
+	linkId string, readyBackends service.ReadyBackendsCounter,
+) *pbFlow.Flow {
+	flows := h.Flows()
+	cardId := CardIds(flows, readyBackends)
+
+	for i := len(flows) - 1; i >= 0; i-- {
+		// NOTE: Flows without L4 don't form a link
+		l := link.FromFlowProto(flows[i], readyBackends)
+		if l == nil {
+			continue
+		}
+
+		l.SetServiceIds(cardId(l.SourceId), cardId(l.DestinationId))
+		if l.Id == linkId {
+			return flows[i]
+		}
+	}
+
+	return nil
+}
+
+// NOTE: Services of the flows are merged into cards the same way they are
+// on the map, so that ids of relabeled workloads lead to the same cards
+func CardIds(
+	flows []*pbFlow.Flow, readyBackends service.ReadyBackendsCounter,
+) func(string) string {
+	dcache := cache.New()
+	dcache.UpsertServicesFromFlows(flow.WrapWithReadyBackends(flows, readyBackends))
+
+	return dcache.CardId
+}
+
+// NOTE: Flows are returned from the oldest to the newest one
+func (h *History) Flows() []*pbFlow.Flow {
+	if h == nil {
+		return nil
+	}
+
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	flows := make([]*pbFlow.Flow, 0, h.ring.Size())
+	h.ring.Iterate(func(e *entry) bool {
+		flows = append(flows, e.flow)
+		return false
+	})
+
+	return flows
+}
+
+func (h *History) Len() int {
+	if h == nil {
+		return 0
+	}
+
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return int(h.ring.Size())
+}
+
+func (h *History) evict(e *entry) {
+	if e.flow == nil {
+		return
+	}
+
+	if uuid := e.flow.GetUuid(); len(uuid) > 0 && h.byUUID[uuid] == e.flow {
+		delete(h.byUUID, uuid)
+	}
+}
@@ -0,0 +1,111 @@
+package flow_history
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/link"
+)
+
+func linkIdOf(f *pbFlow.Flow) string {
+	return link.FromFlowProto(f, nil).Id
+}
+
+var identities = map[string]uint32{
+	"frontend": 1001,
+	"client":   1002,
+}
+
+func testFlow(uuid string, srcPod string) *pbFlow.Flow {
+	return &pbFlow.Flow{
+		Uuid: uuid,
+		Source: &pbFlow.Endpoint{
+			Identity:  identities[srcPod],
+			Namespace: "default",
+			PodName:   srcPod,
+			Labels:    []string{"k8s:app=" + srcPod},
+		},
+		Destination: &pbFlow.Endpoint{
+			Namespace: "default",
+			Identity:  1003,
+			PodName:   "backend",
+			Labels:    []string{"k8s:app=backend"},
+		},
+		L4: &pbFlow.Layer4{
+			Protocol: &pbFlow.Layer4_TCP{
+				TCP: &pbFlow.TCP{DestinationPort: 80},
+			},
+		},
+	}
+}
+
+func TestHistoryEviction(t *testing.T) {
+	h := New(2)
+
+	h.Push([]*pbFlow.Flow{
+		testFlow("a", "frontend"),
+		testFlow("a", "frontend"),
+		testFlow("b", "client"),
+		testFlow("c", "client"),
+	})
+
+	if h.Len() != 2 {
+		t.Fatalf("expected 2 flows, got %d", h.Len())
+	}
+
+	if h.ByUUID("a") != nil {
+		t.Fatalf("flow 'a' should be evicted")
+	}
+
+	if h.ByUUID("b") == nil || h.ByUUID("c") == nil {
+		t.Fatalf("flows 'b' and 'c' should be found")
+	}
+
+	flows := h.Flows()
+	if flows[0].GetUuid() != "b" || flows[1].GetUuid() != "c" {
+		t.Fatalf("unexpected order of flows: %v", flows)
+	}
+
+	h.Push([]*pbFlow.Flow{testFlow("d", "frontend")})
+
+	// NOTE: Eviction of 'b' must not drop the link of 'c'
+	c := h.ByUUID("c")
+	if h.LatestByLink(linkIdOf(c), nil) != c {
+		t.Fatalf("link of flow 'c' is lost")
+	}
+}
+
+func TestLatestByLinkOfRelabeledWorkload(t *testing.T) {
+	backend := func(identity uint32, labels ...string) *pbFlow.Endpoint {
+		return &pbFlow.Endpoint{
+			Identity:  identity,
+			Namespace: "default",
+			Labels:    append([]string{"k8s:app=backend"}, labels...),
+			Workloads: []*pbFlow.Workload{{Kind: "Deployment", Name: "backend"}},
+		}
+	}
+
+	before := testFlow("a", "frontend")
+	before.Destination = backend(1003)
+
+	after := testFlow("b", "frontend")
+	after.Destination = backend(1004, "k8s:version=v2")
+
+	h := New(10)
+	h.Push([]*pbFlow.Flow{before, after})
+
+	// NOTE: The card keeps the id of the first identity
+	if h.LatestByLink(linkIdOf(before), nil) != after {
+		t.Fatalf("expected the flow of relabeled workload to be found by card link")
+	}
+}
+
+func TestZeroSizeHistory(t *testing.T) {
+	h := New(0)
+	h.Push([]*pbFlow.Flow{testFlow("a", "frontend")})
+
+	if h.Len() != 0 || h.ByUUID("a") != nil {
+		t.Fatalf("zero sized history must not keep flows")
+	}
+}
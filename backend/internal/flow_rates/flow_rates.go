@@ -0,0 +1,126 @@
+package flow_rates
+
+import (
+	"slices"
+	"time"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/pkg/rate_counter"
+)
+
+type Rate struct {
+	Key       string
+	PerSecond float64
+}
+
+type Stats struct {
+	PerSecond    float64
+	PerNamespace []*Rate
+	PerNode      []*Rate
+	PerVerdict   []*Rate
+}
+
+type counters = map[string]*rate_counter.RateCounter
+
+// NOTE: Collector is not thread safe, it is supposed to be used from the
+// stream loop that processes the flows
+type Collector struct {
+	// NOTE: Counters that have not been touched during this period are
+	// dropped, so that stats don't grow with every namespace ever seen
+	ttl time.Duration
+
+	total      *rate_counter.RateCounter
+	namespaces counters
+	nodes      counters
+	verdicts   counters
+}
+
+func New(ttl time.Duration) *Collector {
+	if ttl == 0 {
+		ttl = 1 * time.Minute
+	}
+
+	return &Collector{
+		ttl:        ttl,
+		total:      rate_counter.New(),
+		namespaces: make(counters),
+		nodes:      make(counters),
+		verdicts:   make(counters),
+	}
+}
+
+func (c *Collector) Count(f *pbFlow.Flow) {
+	c.total.Count()
+
+	srcNs := f.GetSource().GetNamespace()
+	dstNs := f.GetDestination().GetNamespace()
+
+	countKey(c.namespaces, srcNs)
+	if dstNs != srcNs {
+		countKey(c.namespaces, dstNs)
+	}
+
+	countKey(c.nodes, f.GetNodeName())
+	countKey(c.verdicts, f.GetVerdict().String())
+}
+
+func (c *Collector) Stats() *Stats {
+	now := time.Now()
+
+	return &Stats{
+		PerSecond:    c.total.Rate(),
+		PerNamespace: c.rates(c.namespaces, now),
+		PerNode:      c.rates(c.nodes, now),
+		PerVerdict:   c.rates(c.verdicts, now),
+	}
+}
+
+// NOTE: Rates are sorted from the highest to the lowest one
+func (c *Collector) rates(cs counters, now time.Time) []*Rate {
+	rates := make([]*Rate, 0, len(cs))
+
+	for key, counter := range cs {
+		last := counter.LastCount()
+		if last == nil || now.Sub(*last) > c.ttl {
+			delete(cs, key)
+			continue
+		}
+
+		rates = append(rates, &Rate{
+			Key:       key,
+			PerSecond: counter.Rate(),
+		})
+	}
+
+	slices.SortFunc(rates, func(a, b *Rate) int {
+		switch {
+		case a.PerSecond > b.PerSecond:
+			return -1
+		case a.PerSecond < b.PerSecond:
+			return 1
+		case a.Key < b.Key:
+			return -1
+		case a.Key > b.Key:
+			return 1
+		}
+
+		return 0
+	})
+
+	return rates
+}
+
+func countKey(cs counters, key string) {
+	if len(key) == 0 {
+		return
+	}
+
+	counter, exists := cs[key]
+	if !exists {
+		counter = rate_counter.New()
+		cs[key] = counter
+	}
+
+	counter.Count()
+}
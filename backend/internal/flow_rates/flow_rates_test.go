@@ -0,0 +1,68 @@
+package flow_rates
+
+import (
+	"testing"
+	"time"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+)
+
+func TestCollectorKeys(t *testing.T) {
+	c := New(time.Minute)
+
+	for range 10 {
+		c.Count(&pbFlow.Flow{
+			NodeName:    "node-a",
+			Verdict:     pbFlow.Verdict_FORWARDED,
+			Source:      &pbFlow.Endpoint{Namespace: "jobs"},
+			Destination: &pbFlow.Endpoint{Namespace: "jobs"},
+		})
+
+		c.Count(&pbFlow.Flow{
+			NodeName:    "node-b",
+			Verdict:     pbFlow.Verdict_DROPPED,
+			Source:      &pbFlow.Endpoint{Namespace: "jobs"},
+			Destination: &pbFlow.Endpoint{Namespace: "kube-system"},
+		})
+
+		time.Sleep(time.Millisecond)
+	}
+
+	stats := c.Stats()
+	if stats.PerSecond <= 0 {
+		t.Fatalf("total rate is not positive: %v", stats.PerSecond)
+	}
+
+	if keys := keysOf(stats.PerNamespace); len(keys) != 2 || keys[0] != "jobs" {
+		t.Fatalf("unexpected namespaces: %v", keys)
+	}
+
+	if keys := keysOf(stats.PerNode); len(keys) != 2 {
+		t.Fatalf("unexpected nodes: %v", keys)
+	}
+
+	if keys := keysOf(stats.PerVerdict); len(keys) != 2 {
+		t.Fatalf("unexpected verdicts: %v", keys)
+	}
+}
+
+func TestCollectorTTL(t *testing.T) {
+	c := New(10 * time.Millisecond)
+
+	c.Count(&pbFlow.Flow{NodeName: "node-a"})
+	time.Sleep(20 * time.Millisecond)
+
+	if stats := c.Stats(); len(stats.PerNode) != 0 {
+		t.Fatalf("stale counter is not dropped: %v", keysOf(stats.PerNode))
+	}
+}
+
+func keysOf(rates []*Rate) []string {
+	keys := make([]string, 0, len(rates))
+
+	for _, r := range rates {
+		keys = append(keys, r.Key)
+	}
+
+	return keys
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/cilium/cilium/api/v1/observer"
 	"google.golang.org/grpc"
 
-	"github.com/cilium/hubble-ui/backend/domain/labels"
 	"github.com/cilium/hubble-ui/backend/domain/service"
 	"github.com/cilium/hubble-ui/backend/pkg/grpc_client"
 	grpc_errors "github.com/cilium/hubble-ui/backend/pkg/grpc_utils/errors"
@@ -353,28 +352,14 @@ func (h *FlowStream) handleFlow(ctx context.Context, f *pbFlow.Flow) {
 	if f.GetL4() == nil || f.GetSource() == nil || f.GetDestination() == nil {
 		return
 	}
-	sourceId, destId := service.IdsFromFlowProto(f)
+	// NOTE: Only identities matter here, so k8s Services are not needed
+	sourceId, destId := service.IdsFromFlowProto(f, nil)
 	if sourceId == "0" || destId == "0" {
 		h.log.Warn(msg.ZeroIdentityInSourceOrDest)
 		h.printZeroIdentityFlow(f)
 		return
 	}
 
-	// TODO: workaround to hide flows/services which are showing as "World",
-	// but actually they are k8s services without initialized pods.
-	// Appropriate fix is to construct and show special service map cards
-	// and show these flows in special way inside flows table.
-	if f.GetDestination() != nil {
-		destService := f.GetDestinationService()
-		destLabelsProps := labels.Props(f.GetDestination().GetLabels())
-		destNames := f.GetDestinationNames()
-		isDestOutside := destLabelsProps.IsWorld || len(destNames) > 0
-
-		if destService != nil && isDestOutside {
-			return
-		}
-	}
-
 	h.sendFlow(ctx, f)
 }
 
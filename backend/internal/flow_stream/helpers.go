@@ -20,28 +20,27 @@ var (
 	log = logger.New("get-flows-helpers")
 )
 
-func ExtractFlowsRequest(
-	req *ui.GetEventsRequest,
-) *observer.GetFlowsRequest {
-	var bl, wl []*flow.FlowFilter
+// NOTE: Service and link filters are not supported by hubble, they are skipped
+func FlowFilters(eventFilters []*ui.EventFilter) []*flow.FlowFilter {
+	var ffs []*flow.FlowFilter
 
-	for _, eventFilter := range req.GetBlacklist() {
+	for _, eventFilter := range eventFilters {
 		flowFilter := eventFilter.GetFlowFilter()
 		if flowFilter == nil {
 			continue
 		}
 
-		bl = append(bl, flowFilter)
+		ffs = append(ffs, flowFilter)
 	}
 
-	for _, eventFilter := range req.GetWhitelist() {
-		flowFilter := eventFilter.GetFlowFilter()
-		if flowFilter == nil {
-			continue
-		}
+	return ffs
+}
 
-		wl = append(wl, flowFilter)
-	}
+func ExtractFlowsRequest(
+	req *ui.GetEventsRequest,
+) *observer.GetFlowsRequest {
+	bl := FlowFilters(req.GetBlacklist())
+	wl := FlowFilters(req.GetWhitelist())
 
 	// below is a workaround for cilium/hubble bug
 	// https://github.com/cilium/hubble/issues/363
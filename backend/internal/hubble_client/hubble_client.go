@@ -16,6 +16,7 @@ import (
 type HubbleClientInterface interface {
 	FlowStream() flow_stream.FlowStreamInterface
 	ServerStatus(context.Context) (*observer.ServerStatusResponse, error)
+	GetNamespaces(context.Context) (*observer.GetNamespacesResponse, error)
 	ServerStatusChecker(opts StatusCheckerOptions) (statuschecker.ServerStatusCheckerInterface, error)
 }
 
@@ -53,9 +53,25 @@ func (c *GRPCHubbleClient) HubbleNodes(
 	return obClient.GetNodes(ctx, &observer.GetNodesRequest{}, c.callPropsProvider.CallOptions(ctx)...)
 }
 
+func (c *GRPCHubbleClient) GetNamespaces(
+	ctx context.Context,
+) (*observer.GetNamespacesResponse, error) {
+	conn, err := c.GetStableConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	obClient := observer.NewObserverClient(conn)
+	return obClient.GetNamespaces(ctx, &observer.GetNamespacesRequest{}, c.callPropsProvider.CallOptions(ctx)...)
+}
+
 type StatusCheckerOptions struct {
 	Delay time.Duration
 	Log   *slog.Logger
+
+	// NOTE: Status is sent at least once per Heartbeat even if node
+	// availability is not changed
+	Heartbeat time.Duration
 }
 
 func (c *GRPCHubbleClient) ServerStatusChecker(opts StatusCheckerOptions) (
@@ -64,6 +80,7 @@ func (c *GRPCHubbleClient) ServerStatusChecker(opts StatusCheckerOptions) (
 	statusChecker, err := statuschecker.New(
 		opts.Log.With(slog.String("component", "StatusChecker")),
 		opts.Delay,
+		opts.Heartbeat,
 		c.ConnectionPool(),
 		c.callPropsProvider,
 	)
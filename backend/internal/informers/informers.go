@@ -0,0 +1,62 @@
+package informers
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+// NOTE: Informers runs controllers of the informers a watcher keeps its
+// objects in. Watchers built on it are nil-safe: nil watcher is valid and
+// knows nothing, so it's used when k8s API is not available.
+type Informers struct {
+	log  *slog.Logger
+	name string
+
+	controllers []cache.Controller
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func New(log *slog.Logger, name string, controllers ...cache.Controller) *Informers {
+	return &Informers{
+		log:         log,
+		name:        name,
+		controllers: controllers,
+		stop:        make(chan struct{}),
+		stopOnce:    sync.Once{},
+	}
+}
+
+// NOTE: Blocks until the context is done or Stop is called
+func (i *Informers) Run(ctx context.Context) {
+	if i == nil {
+		return
+	}
+
+	for _, controller := range i.controllers {
+		go controller.Run(i.stop)
+	}
+
+	i.log.Info(i.name + " watcher is running")
+
+	select {
+	case <-ctx.Done():
+		i.Stop()
+	case <-i.stop:
+	}
+}
+
+func (i *Informers) Stop() {
+	if i == nil {
+		return
+	}
+
+	i.stopOnce.Do(func() {
+		close(i.stop)
+		i.log.Info(i.name + " watcher is stopped")
+	})
+}
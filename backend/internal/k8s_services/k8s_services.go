@@ -0,0 +1,244 @@
+package k8s_services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/hubble-ui/backend/internal/informers"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const serviceIndex = "service"
+
+// NOTE: Watcher keeps k8s Services and EndpointSlices in memory, so that
+// every flow to a Service can be annotated without calls to k8s API.
+type Watcher struct {
+	services       cache.Store
+	endpointSlices cache.Indexer
+
+	informers *informers.Informers
+}
+
+func New(log *slog.Logger, k8s kubernetes.Interface) *Watcher {
+	services, servicesController := cache.NewInformerWithOptions(
+		cache.InformerOptions{
+			ListerWatcher: cache.NewListWatchFromClient(
+				k8s.CoreV1().RESTClient(),
+				"services",
+				corev1.NamespaceAll,
+				fields.Everything(),
+			),
+			ObjectType: &corev1.Service{},
+			Handler:    cache.ResourceEventHandlerFuncs{},
+		},
+	)
+
+	epSlices, epSlicesController := cache.NewInformerWithOptions(
+		cache.InformerOptions{
+			ListerWatcher: cache.NewListWatchFromClient(
+				k8s.DiscoveryV1().RESTClient(),
+				"endpointslices",
+				corev1.NamespaceAll,
+				fields.Everything(),
+			),
+			ObjectType: &discoveryv1.EndpointSlice{},
+			Handler:    cache.ResourceEventHandlerFuncs{},
+			Indexers: cache.Indexers{
+				serviceIndex: endpointSliceServiceKeys,
+			},
+		},
+	)
+
+	return &Watcher{
+		services:       services,
+		endpointSlices: epSlices.(cache.Indexer),
+		informers:      informers.New(log, "k8s services", servicesController, epSlicesController),
+	}
+}
+
+func (w *Watcher) Run(ctx context.Context) {
+	if w != nil {
+		w.informers.Run(ctx)
+	}
+}
+
+func (w *Watcher) Stop() {
+	if w != nil {
+		w.informers.Stop()
+	}
+}
+
+// NOTE: Returns nil if there is no such Service or k8s API is not available
+func (w *Watcher) Service(namespace, name string) *corev1.Service {
+	if w == nil {
+		return nil
+	}
+
+	obj, exists, err := w.services.GetByKey(namespace + "/" + name)
+	if err != nil || !exists {
+		return nil
+	}
+
+	svc, _ := obj.(*corev1.Service)
+	return svc
+}
+
+func (w *Watcher) EndpointSlices(namespace, name string) []*discoveryv1.EndpointSlice {
+	if w == nil {
+		return nil
+	}
+
+	objs, err := w.endpointSlices.ByIndex(serviceIndex, namespace+"/"+name)
+	if err != nil {
+		return nil
+	}
+
+	epSlices := make([]*discoveryv1.EndpointSlice, 0, len(objs))
+	for _, obj := range objs {
+		if epSlice, ok := obj.(*discoveryv1.EndpointSlice); ok {
+			epSlices = append(epSlices, epSlice)
+		}
+	}
+
+	return epSlices
+}
+
+// NOTE: destPort is the port the flows were sent to. Cilium reports flows
+// after the Service is translated, so it's the port of backends. The ref has
+// only the names when the objects are not known.
+func (w *Watcher) Ref(namespace, name string, destPort uint32) *ui.K8SServiceRef {
+	svc := w.Service(namespace, name)
+	epSlices := w.EndpointSlices(namespace, name)
+
+	ref := &ui.K8SServiceRef{
+		Name:      name,
+		Namespace: namespace,
+		PortName:  portName(svc, epSlices, destPort),
+	}
+
+	if svc != nil {
+		ref.Type = string(svc.Spec.Type)
+	}
+
+	ref.ReadyBackends, ref.Backends = countBackends(epSlices)
+	return ref
+}
+
+// NOTE: Matches service.ReadyBackendsCounter, Service is not known if k8s
+// API is not available
+func (w *Watcher) ReadyBackends(namespace, name string) (uint32, bool) {
+	if w.Service(namespace, name) == nil {
+		return 0, false
+	}
+
+	ready, _ := countBackends(w.EndpointSlices(namespace, name))
+	return ready, true
+}
+
+func portName(
+	svc *corev1.Service, epSlices []*discoveryv1.EndpointSlice, destPort uint32,
+) string {
+	if destPort == 0 {
+		return ""
+	}
+
+	for _, epSlice := range epSlices {
+		for _, port := range epSlice.Ports {
+			if port.Port == nil || uint32(*port.Port) != destPort {
+				continue
+			}
+
+			if port.Name != nil && len(*port.Name) > 0 {
+				return *port.Name
+			}
+		}
+	}
+
+	if svc == nil {
+		return ""
+	}
+
+	for _, port := range svc.Spec.Ports {
+		if uint32(port.Port) == destPort || uint32(port.TargetPort.IntValue()) == destPort {
+			return port.Name
+		}
+	}
+
+	return ""
+}
+
+// NOTE: The same backend can be listed in several slices while they are
+// updated, so backends are deduplicated. Returns numbers of ready and all
+// the backends.
+func countBackends(epSlices []*discoveryv1.EndpointSlice) (uint32, uint32) {
+	backends := make(map[string]bool)
+
+	for _, epSlice := range epSlices {
+		for i := range epSlice.Endpoints {
+			ep := &epSlice.Endpoints[i]
+
+			key := backendKey(ep)
+			if len(key) == 0 {
+				continue
+			}
+
+			// NOTE: Unknown readiness should be interpreted as ready
+			isReady := ep.Conditions.Ready == nil || *ep.Conditions.Ready
+			backends[key] = backends[key] || isReady
+		}
+	}
+
+	ready := uint32(0)
+	for _, isReady := range backends {
+		if isReady {
+			ready += 1
+		}
+	}
+
+	return ready, uint32(len(backends))
+}
+
+func backendKey(ep *discoveryv1.Endpoint) string {
+	if ref := ep.TargetRef; ref != nil && len(ref.Name) > 0 {
+		return ref.Namespace + "/" + ref.Name
+	}
+
+	if len(ep.Addresses) > 0 {
+		return ep.Addresses[0]
+	}
+
+	return ""
+}
+
+func endpointSliceServiceKeys(obj any) ([]string, error) {
+	epSlice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object of type %T", obj)
+	}
+
+	name := epSlice.Labels[discoveryv1.LabelServiceName]
+	if len(name) == 0 {
+		return nil, nil
+	}
+
+	return []string{epSlice.Namespace + "/" + name}, nil
+}
+
+// NOTE: Labels are formatted the same way cilium reports labels of pods
+func Labels(svc *corev1.Service) []string {
+	lbls := make([]string, 0, len(svc.GetLabels()))
+	for k, v := range svc.GetLabels() {
+		lbls = append(lbls, fmt.Sprintf("k8s:%s=%s", k, v))
+	}
+
+	slices.Sort(lbls)
+	return lbls
+}
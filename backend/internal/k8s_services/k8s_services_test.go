@@ -0,0 +1,53 @@
+package k8s_services
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestBackendsAndPorts(t *testing.T) {
+	pod := func(name string) *corev1.ObjectReference {
+		return &corev1.ObjectReference{Kind: "Pod", Namespace: "shop", Name: name}
+	}
+
+	epSlices := []*discoveryv1.EndpointSlice{
+		{
+			Ports: []discoveryv1.EndpointPort{{Name: ptr("http"), Port: ptr(int32(8080))}},
+			Endpoints: []discoveryv1.Endpoint{
+				{TargetRef: pod("api-1"), Conditions: discoveryv1.EndpointConditions{Ready: ptr(true)}},
+				{TargetRef: pod("api-2"), Conditions: discoveryv1.EndpointConditions{Ready: ptr(false)}},
+				{Addresses: []string{"10.0.0.3"}},
+			},
+		},
+		{
+			Endpoints: []discoveryv1.Endpoint{
+				{TargetRef: pod("api-1"), Conditions: discoveryv1.EndpointConditions{Ready: ptr(false)}},
+			},
+		},
+	}
+
+	if ready, all := countBackends(epSlices); ready != 2 || all != 3 {
+		t.Fatalf("expected 2 of 3 backends to be ready, got %d of %d", ready, all)
+	}
+
+	svc := &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "metrics", Port: 80, TargetPort: intstr.FromInt32(9090)},
+			},
+		},
+	}
+
+	for port, expected := range map[uint32]string{8080: "http", 9090: "metrics", 80: "metrics", 53: ""} {
+		if name := portName(svc, epSlices, port); name != expected {
+			t.Fatalf("expected port %d to be named '%s', got '%s'", port, expected, name)
+		}
+	}
+}
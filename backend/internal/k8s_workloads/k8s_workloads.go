@@ -0,0 +1,166 @@
+package k8s_workloads
+
+import (
+	"context"
+	"log/slog"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/hubble-ui/backend/internal/informers"
+)
+
+// NOTE: Watcher keeps owner references of pods, ReplicaSets and Jobs, so that
+// the workload of any pod can be found by its name and namespace. Cilium
+// doesn't send workloads with every flow, this fills the gaps.
+type Watcher struct {
+	pods        cache.Store
+	replicaSets cache.Store
+	jobs        cache.Store
+
+	informers *informers.Informers
+}
+
+func New(log *slog.Logger, k8s kubernetes.Interface) *Watcher {
+	pods, podsController := watch(k8s.CoreV1().RESTClient(), "pods", &corev1.Pod{})
+	replicaSets, replicaSetsController := watch(
+		k8s.AppsV1().RESTClient(), "replicasets", &appsv1.ReplicaSet{},
+	)
+	jobs, jobsController := watch(k8s.BatchV1().RESTClient(), "jobs", &batchv1.Job{})
+
+	return &Watcher{
+		pods:        pods,
+		replicaSets: replicaSets,
+		jobs:        jobs,
+		informers: informers.New(
+			log, "k8s workloads",
+			podsController, replicaSetsController, jobsController,
+		),
+	}
+}
+
+func watch(
+	client rest.Interface, resource string, objType runtime.Object,
+) (cache.Store, cache.Controller) {
+	return cache.NewInformerWithOptions(cache.InformerOptions{
+		ListerWatcher: cache.NewListWatchFromClient(
+			client,
+			resource,
+			corev1.NamespaceAll,
+			fields.Everything(),
+		),
+		ObjectType: objType,
+		Handler:    cache.ResourceEventHandlerFuncs{},
+		Transform:  ownersOnly,
+	})
+}
+
+func (w *Watcher) Run(ctx context.Context) {
+	if w != nil {
+		w.informers.Run(ctx)
+	}
+}
+
+func (w *Watcher) Stop() {
+	if w != nil {
+		w.informers.Stop()
+	}
+}
+
+// NOTE: Follows controller references of the pod up to the top-level
+// workload, e.g. Pod -> ReplicaSet -> Deployment. Returns nil for pods that
+// are not known or not controlled by anything.
+func (w *Watcher) Workload(namespace, podName string) *pbFlow.Workload {
+	if w == nil || len(namespace) == 0 || len(podName) == 0 {
+		return nil
+	}
+
+	owner := controllerOf(w.pods, namespace, podName)
+	if owner == nil {
+		return nil
+	}
+
+	// NOTE: ReplicaSets and Jobs are usually created by Deployments and
+	// CronJobs, the intermediate objects are reported only when they have no
+	// controller
+	switch owner.Kind {
+	case "ReplicaSet":
+		if top := controllerOf(w.replicaSets, namespace, owner.Name); top != nil {
+			owner = top
+		}
+	case "Job":
+		if top := controllerOf(w.jobs, namespace, owner.Name); top != nil {
+			owner = top
+		}
+	}
+
+	return &pbFlow.Workload{
+		Name: owner.Name,
+		Kind: owner.Kind,
+	}
+}
+
+// NOTE: Fills workloads of flow endpoints that came without them
+func (w *Watcher) EnrichFlows(flows []*pbFlow.Flow) {
+	if w == nil {
+		return
+	}
+
+	for _, f := range flows {
+		for _, ep := range []*pbFlow.Endpoint{f.GetSource(), f.GetDestination()} {
+			if ep == nil || len(ep.GetWorkloads()) > 0 {
+				continue
+			}
+
+			if wl := w.Workload(ep.GetNamespace(), ep.GetPodName()); wl != nil {
+				ep.Workloads = []*pbFlow.Workload{wl}
+			}
+		}
+	}
+}
+
+func controllerOf(store cache.Store, namespace, name string) *metav1.OwnerReference {
+	obj, exists, err := store.GetByKey(namespace + "/" + name)
+	if err != nil || !exists {
+		return nil
+	}
+
+	metaObj, ok := obj.(metav1.Object)
+	if !ok {
+		return nil
+	}
+
+	return metav1.GetControllerOfNoCopy(metaObj)
+}
+
+// NOTE: Only owner references are needed, so the rest of the objects is
+// dropped to not keep every pod spec of the cluster in memory
+func ownersOnly(obj any) (any, error) {
+	meta := func(om *metav1.ObjectMeta) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:            om.Name,
+			Namespace:       om.Namespace,
+			ResourceVersion: om.ResourceVersion,
+			OwnerReferences: om.OwnerReferences,
+		}
+	}
+
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		return &corev1.Pod{ObjectMeta: meta(&o.ObjectMeta)}, nil
+	case *appsv1.ReplicaSet:
+		return &appsv1.ReplicaSet{ObjectMeta: meta(&o.ObjectMeta)}, nil
+	case *batchv1.Job:
+		return &batchv1.Job{ObjectMeta: meta(&o.ObjectMeta)}, nil
+	}
+
+	return obj, nil
+}
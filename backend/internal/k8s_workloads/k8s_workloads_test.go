@@ -0,0 +1,91 @@
+package k8s_workloads
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func objMeta(name, ownerKind, ownerName string) metav1.ObjectMeta {
+	om := metav1.ObjectMeta{Name: name, Namespace: "shop"}
+	if len(ownerKind) == 0 {
+		return om
+	}
+
+	isController := true
+	om.OwnerReferences = []metav1.OwnerReference{
+		{Kind: ownerKind, Name: ownerName, Controller: &isController},
+	}
+
+	return om
+}
+
+func newStore(t *testing.T, objs ...any) cache.Store {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, obj := range objs {
+		trimmed, _ := ownersOnly(obj)
+		if err := store.Add(trimmed); err != nil {
+			t.Fatalf("failed to add object: %v", err)
+		}
+	}
+
+	return store
+}
+
+func TestWorkload(t *testing.T) {
+	w := &Watcher{
+		pods: newStore(t,
+			&corev1.Pod{ObjectMeta: objMeta("api-7d9-x1", "ReplicaSet", "api-7d9")},
+			&corev1.Pod{ObjectMeta: objMeta("db-0", "StatefulSet", "db")},
+			&corev1.Pod{ObjectMeta: objMeta("backup-28-x1", "Job", "backup-28")},
+			&corev1.Pod{ObjectMeta: objMeta("orphan-x1", "ReplicaSet", "orphan")},
+			&corev1.Pod{ObjectMeta: objMeta("static", "", "")},
+		),
+		replicaSets: newStore(t,
+			&appsv1.ReplicaSet{ObjectMeta: objMeta("api-7d9", "Deployment", "api")},
+			&appsv1.ReplicaSet{ObjectMeta: objMeta("orphan", "", "")},
+		),
+		jobs: newStore(t,
+			&batchv1.Job{ObjectMeta: objMeta("backup-28", "CronJob", "backup")},
+		),
+	}
+
+	for pod, expected := range map[string]string{
+		"api-7d9-x1":   "Deployment/api",
+		"db-0":         "StatefulSet/db",
+		"backup-28-x1": "CronJob/backup",
+		"orphan-x1":    "ReplicaSet/orphan",
+	} {
+		wl := w.Workload("shop", pod)
+		if wl == nil || wl.GetKind()+"/"+wl.GetName() != expected {
+			t.Fatalf("expected pod %s to belong to %s, got %v", pod, expected, wl)
+		}
+	}
+
+	if wl := w.Workload("shop", "static"); wl != nil {
+		t.Fatalf("expected pod without owner to have no workload, got %v", wl)
+	}
+
+	known := &pbFlow.Workload{Kind: "Deployment", Name: "known"}
+	f := &pbFlow.Flow{
+		Source:      &pbFlow.Endpoint{Namespace: "shop", PodName: "api-7d9-x1"},
+		Destination: &pbFlow.Endpoint{Namespace: "shop", PodName: "db-0", Workloads: []*pbFlow.Workload{known}},
+	}
+
+	w.EnrichFlows([]*pbFlow.Flow{f})
+	if wls := f.GetSource().GetWorkloads(); len(wls) != 1 || wls[0].GetName() != "api" {
+		t.Fatalf("expected source to be enriched, got %v", wls)
+	}
+
+	if wls := f.GetDestination().GetWorkloads(); len(wls) != 1 || wls[0] != known {
+		t.Fatalf("expected workloads from the flow to be kept, got %v", wls)
+	}
+
+	var nilWatcher *Watcher
+	nilWatcher.EnrichFlows([]*pbFlow.Flow{f})
+}
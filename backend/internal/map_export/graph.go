@@ -0,0 +1,229 @@
+package map_export
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/domain/service"
+)
+
+// NOTE: Graph is the documented JSON format of exported map:
+//
+//	{
+//	  "namespace": "jobs-app",
+//	  "nodes": [{
+//	    "id": "<opaque service id>",
+//	    "name": "crawler",
+//	    "namespace": "jobs-app",
+//	    "kind": "workload" | "world" | "host" | "remote-node" | "kube-apiserver" | ...,
+//	    "identity": 12345,
+//	    "labels": ["k8s:app=crawler", ...],
+//	    "dnsNames": ["api.github.com"]
+//	  }],
+//	  "edges": [{
+//	    "id": "<opaque link id>",
+//	    "source": "<node id>",
+//	    "target": "<node id>",
+//	    "port": 80,
+//	    "protocol": "TCP",
+//	    "verdict": "FORWARDED" | "DROPPED" | "MIXED" | ...,
+//	    "verdicts": {"FORWARDED": 10, "DROPPED": 2},
+//	    "dropReasons": {"POLICY_DENIED": 2},
+//	    "flows": 12,
+//	    "bytes": 4096,
+//	    "isEncrypted": false,
+//	    "authType": "DISABLED"
+//	  }]
+//	}
+//
+// Nodes are sorted by namespace and name, edges by source, target and port,
+// so that exports of the same traffic can be diffed.
+type Graph struct {
+	Namespace string  `json:"namespace,omitempty"`
+	Nodes     []*Node `json:"nodes"`
+	Edges     []*Edge `json:"edges"`
+}
+
+type Node struct {
+	Id        string   `json:"id"`
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace,omitempty"`
+	Kind      string   `json:"kind"`
+	Identity  uint32   `json:"identity,omitempty"`
+	Labels    []string `json:"labels"`
+	DNSNames  []string `json:"dnsNames,omitempty"`
+}
+
+type Edge struct {
+	Id          string            `json:"id"`
+	Source      string            `json:"source"`
+	Target      string            `json:"target"`
+	Port        uint32            `json:"port"`
+	Protocol    string            `json:"protocol"`
+	Verdict     string            `json:"verdict"`
+	Verdicts    map[string]uint64 `json:"verdicts"`
+	DropReasons map[string]uint64 `json:"dropReasons,omitempty"`
+	Flows       uint64            `json:"flows"`
+	Bytes       uint64            `json:"bytes"`
+	IsEncrypted bool              `json:"isEncrypted"`
+	AuthType    string            `json:"authType"`
+}
+
+const (
+	KindWorkload = "workload"
+
+	// NOTE: Edge verdict when the link has both forwarded and dropped flows
+	VerdictMixed = "MIXED"
+)
+
+func FromCache(dcache *cache.DataCache, namespace string) *Graph {
+	g := &Graph{
+		Namespace: namespace,
+		Nodes:     []*Node{},
+		Edges:     []*Edge{},
+	}
+
+	dcache.ForEachService(func(_ string, svc *service.Service) {
+		g.Nodes = append(g.Nodes, nodeFromService(svc))
+	})
+
+	dcache.ForEachLink(func(_ string, l *link.Link) {
+		g.Edges = append(g.Edges, edgeFromLink(l))
+	})
+
+	slices.SortFunc(g.Nodes, func(a, b *Node) int {
+		return compareKeys(
+			[]string{a.Namespace, a.Name, a.Id},
+			[]string{b.Namespace, b.Name, b.Id},
+		)
+	})
+
+	slices.SortFunc(g.Edges, func(a, b *Edge) int {
+		if c := compareKeys([]string{a.Source, a.Target}, []string{b.Source, b.Target}); c != 0 {
+			return c
+		}
+
+		if a.Port != b.Port {
+			return int(a.Port) - int(b.Port)
+		}
+
+		return strings.Compare(a.Protocol, b.Protocol)
+	})
+
+	return g
+}
+
+func (g *Graph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// NOTE: Nodes grouped by namespace, nodes without namespace go last
+func (g *Graph) byNamespace() ([]string, map[string][]*Node) {
+	groups := make(map[string][]*Node)
+	namespaces := []string{}
+
+	for _, n := range g.Nodes {
+		if _, exists := groups[n.Namespace]; !exists && len(n.Namespace) > 0 {
+			namespaces = append(namespaces, n.Namespace)
+		}
+
+		groups[n.Namespace] = append(groups[n.Namespace], n)
+	}
+
+	return namespaces, groups
+}
+
+func nodeFromService(svc *service.Service) *Node {
+	pb := svc.ToProto()
+
+	lbls := slices.Clone(pb.GetLabels())
+	slices.Sort(lbls)
+
+	return &Node{
+		Id:        pb.GetId(),
+		Name:      pb.GetName(),
+		Namespace: pb.GetNamespace(),
+		Kind:      kindFromProps(labels.Props(pb.GetLabels())),
+		Identity:  pb.GetIdentity(),
+		Labels:    lbls,
+		DNSNames:  pb.GetDnsNames(),
+	}
+}
+
+func kindFromProps(props *labels.LabelProps) string {
+	switch {
+	case props.IsKubeAPIServer:
+		return "kube-apiserver"
+	case props.IsHost:
+		return "host"
+	case props.IsRemoteNode:
+		return "remote-node"
+	case props.IsHealth:
+		return "health"
+	case props.IsInit:
+		return "init"
+	case props.IsPrometheus:
+		return "prometheus"
+	case props.IsKubeDNS:
+		return "kube-dns"
+	case props.IsWorld:
+		return "world"
+	}
+
+	return KindWorkload
+}
+
+func edgeFromLink(l *link.Link) *Edge {
+	verdicts := make(map[string]uint64, len(l.VerdictCounts))
+	for verdict, count := range l.VerdictCounts {
+		verdicts[verdict.String()] = count
+	}
+
+	var dropReasons map[string]uint64
+	if len(l.DropReasons) > 0 {
+		dropReasons = make(map[string]uint64, len(l.DropReasons))
+		for reason, count := range l.DropReasons {
+			dropReasons[reason.String()] = count
+		}
+	}
+
+	return &Edge{
+		Id:          l.Id,
+		Source:      l.SourceId,
+		Target:      l.DestinationId,
+		Port:        l.DestinationPort,
+		Protocol:    l.IPProtocol.String(),
+		Verdict:     edgeVerdict(l),
+		Verdicts:    verdicts,
+		DropReasons: dropReasons,
+		Flows:       l.FlowAmount,
+		Bytes:       l.BytesTransfered,
+		IsEncrypted: l.IsEncrypted,
+		AuthType:    l.AuthType.String(),
+	}
+}
+
+func edgeVerdict(l *link.Link) string {
+	if l.VerdictCounts[pbFlow.Verdict_DROPPED] > 0 &&
+		l.VerdictCounts[pbFlow.Verdict_FORWARDED] > 0 {
+		return VerdictMixed
+	}
+
+	return l.Verdict.String()
+}
+
+func compareKeys(a, b []string) int {
+	for i := range a {
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,114 @@
+package map_export
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+)
+
+func tcpFlow(src, dst *pbFlow.Endpoint, port uint32, verdict pbFlow.Verdict) *pbFlow.Flow {
+	return &pbFlow.Flow{
+		Source:      src,
+		Destination: dst,
+		Verdict:     verdict,
+		L4: &pbFlow.Layer4{
+			Protocol: &pbFlow.Layer4_TCP{
+				TCP: &pbFlow.TCP{DestinationPort: port},
+			},
+		},
+	}
+}
+
+func testGraph() *Graph {
+	frontend := &pbFlow.Endpoint{
+		Identity:  1001,
+		Namespace: "shop",
+		Labels:    []string{"k8s:app=frontend"},
+	}
+
+	backend := &pbFlow.Endpoint{
+		Identity:  1002,
+		Namespace: "shop",
+		Labels:    []string{"k8s:app=backend"},
+	}
+
+	world := &pbFlow.Endpoint{
+		Identity: 2,
+		Labels:   []string{"reserved:world"},
+	}
+
+	dcache := cache.New()
+	flows := flow.Wrap([]*pbFlow.Flow{
+		tcpFlow(frontend, backend, 8080, pbFlow.Verdict_FORWARDED),
+		tcpFlow(frontend, backend, 8080, pbFlow.Verdict_DROPPED),
+		tcpFlow(backend, world, 443, pbFlow.Verdict_DROPPED),
+	})
+
+	dcache.UpsertServicesFromFlows(flows)
+	dcache.UpsertLinksFromFlows(flows)
+
+	return FromCache(dcache, "shop")
+}
+
+func TestJSON(t *testing.T) {
+	raw, err := testGraph().JSON()
+	if err != nil {
+		t.Fatalf("failed to serialize graph: %v", err)
+	}
+
+	g := new(Graph)
+	if err := json.Unmarshal(raw, g); err != nil {
+		t.Fatalf("failed to deserialize graph: %v", err)
+	}
+
+	if len(g.Nodes) != 3 || len(g.Edges) != 2 {
+		t.Fatalf("expected 3 nodes and 2 edges, got %d and %d", len(g.Nodes), len(g.Edges))
+	}
+
+	verdicts := map[string]bool{}
+	for _, e := range g.Edges {
+		verdicts[e.Verdict] = true
+
+		if e.Protocol != "TCP" {
+			t.Fatalf("unexpected protocol: %s", e.Protocol)
+		}
+	}
+
+	if !verdicts[VerdictMixed] || !verdicts[pbFlow.Verdict_DROPPED.String()] {
+		t.Fatalf("unexpected edge verdicts: %v", verdicts)
+	}
+}
+
+func TestDOTAndMermaid(t *testing.T) {
+	g := testGraph()
+
+	dot := g.DOT()
+	for _, expected := range []string{
+		"digraph \"hubble-ui\" {",
+		"label=\"shop\";",
+		"label=\"TCP/8080, mixed\"",
+		"label=\"TCP/443, dropped\"",
+		"style=dashed",
+	} {
+		if !strings.Contains(dot, expected) {
+			t.Fatalf("DOT doesn't contain %q:\n%s", expected, dot)
+		}
+	}
+
+	mermaid := g.Mermaid()
+	for _, expected := range []string{
+		"flowchart LR\n",
+		"subgraph ns0[\"shop\"]",
+		"-.->|\"TCP/443, dropped\"|",
+		"linkStyle 1 stroke:" + colorDropped,
+	} {
+		if !strings.Contains(mermaid, expected) {
+			t.Fatalf("Mermaid doesn't contain %q:\n%s", expected, mermaid)
+		}
+	}
+}
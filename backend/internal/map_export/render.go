@@ -0,0 +1,197 @@
+package map_export
+
+import (
+	"fmt"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+)
+
+const (
+	colorForwarded = "#2e7d32"
+	colorDropped   = "#c62828"
+	colorMixed     = "#ef6c00"
+	colorOther     = "#616161"
+)
+
+// NOTE: Namespaces are rendered as clusters, so that the picture resembles
+// the one user sees in the UI
+func (g *Graph) DOT() string {
+	b := new(strings.Builder)
+
+	b.WriteString("digraph \"hubble-ui\" {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded, fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	namespaces, groups := g.byNamespace()
+	for i, ns := range namespaces {
+		fmt.Fprintf(b, "\n  subgraph cluster_%d {\n", i)
+		fmt.Fprintf(b, "    label=%s;\n", dotQuote(ns))
+		for _, n := range groups[ns] {
+			fmt.Fprintf(b, "    %s;\n", dotNode(n))
+		}
+		b.WriteString("  }\n")
+	}
+
+	if outer := groups[""]; len(outer) > 0 {
+		b.WriteString("\n")
+		for _, n := range outer {
+			fmt.Fprintf(b, "  %s;\n", dotNode(n))
+		}
+	}
+
+	if len(g.Edges) > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, e := range g.Edges {
+		attrs := []string{
+			"label=" + dotQuote(edgeLabel(e)),
+			"color=" + dotQuote(edgeColor(e)),
+		}
+
+		if e.Verdict == pbFlow.Verdict_DROPPED.String() {
+			attrs = append(attrs, "style=dashed")
+		}
+
+		if e.IsEncrypted {
+			attrs = append(attrs, "penwidth=2")
+		}
+
+		fmt.Fprintf(
+			b, "  %s -> %s [%s];\n",
+			dotQuote(e.Source), dotQuote(e.Target), strings.Join(attrs, ", "),
+		)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// NOTE: Mermaid doesn't allow arbitrary characters in node ids, so nodes get
+// sequential ids in the order they are sorted in the graph
+func (g *Graph) Mermaid() string {
+	b := new(strings.Builder)
+	b.WriteString("flowchart LR\n")
+
+	ids := make(map[string]string, len(g.Nodes))
+	for i, n := range g.Nodes {
+		ids[n.Id] = fmt.Sprintf("n%d", i)
+	}
+
+	namespaces, groups := g.byNamespace()
+	for i, ns := range namespaces {
+		fmt.Fprintf(b, "  subgraph ns%d[%s]\n", i, mermaidQuote(ns))
+		for _, n := range groups[ns] {
+			fmt.Fprintf(b, "    %s[%s]\n", ids[n.Id], mermaidQuote(nodeLabel(n)))
+		}
+		b.WriteString("  end\n")
+	}
+
+	for _, n := range groups[""] {
+		fmt.Fprintf(b, "  %s[%s]\n", ids[n.Id], mermaidQuote(nodeLabel(n)))
+	}
+
+	styles := []string{}
+	idx := 0
+	for _, e := range g.Edges {
+		src, srcExists := ids[e.Source]
+		dst, dstExists := ids[e.Target]
+		if !srcExists || !dstExists {
+			continue
+		}
+
+		arrow := "-->"
+		if e.Verdict == pbFlow.Verdict_DROPPED.String() {
+			arrow = "-.->"
+		}
+
+		fmt.Fprintf(b, "  %s %s|%s| %s\n", src, arrow, mermaidQuote(edgeLabel(e)), dst)
+
+		width := 1
+		if e.IsEncrypted {
+			width = 2
+		}
+
+		styles = append(styles, fmt.Sprintf(
+			"  linkStyle %d stroke:%s,stroke-width:%dpx",
+			idx, edgeColor(e), width,
+		))
+
+		idx += 1
+	}
+
+	for _, style := range styles {
+		b.WriteString(style + "\n")
+	}
+
+	return b.String()
+}
+
+func dotNode(n *Node) string {
+	attrs := []string{"label=" + dotQuote(nodeLabel(n))}
+	if n.Kind != KindWorkload {
+		attrs = append(attrs, "style=\"rounded,dashed\"")
+	}
+
+	return fmt.Sprintf("%s [%s]", dotQuote(n.Id), strings.Join(attrs, ", "))
+}
+
+func nodeLabel(n *Node) string {
+	if n.Kind == KindWorkload || n.Name == n.Kind {
+		return n.Name
+	}
+
+	return fmt.Sprintf("%s (%s)", n.Name, n.Kind)
+}
+
+func edgeLabel(e *Edge) string {
+	parts := []string{e.Protocol}
+	if e.Port > 0 {
+		parts[0] = fmt.Sprintf("%s/%d", e.Protocol, e.Port)
+	}
+
+	if e.Verdict != pbFlow.Verdict_FORWARDED.String() {
+		parts = append(parts, strings.ToLower(e.Verdict))
+	}
+
+	if e.IsEncrypted {
+		parts = append(parts, "encrypted")
+	}
+
+	if e.AuthType != pbFlow.AuthType_DISABLED.String() {
+		parts = append(parts, "auth: "+strings.ToLower(e.AuthType))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func edgeColor(e *Edge) string {
+	switch e.Verdict {
+	case pbFlow.Verdict_FORWARDED.String():
+		return colorForwarded
+	case pbFlow.Verdict_DROPPED.String():
+		return colorDropped
+	case VerdictMixed:
+		return colorMixed
+	}
+
+	return colorOther
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+
+	return `"` + s + `"`
+}
+
+// NOTE: Mermaid has no escaping inside quoted labels, html entity is used
+func mermaidQuote(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	s = strings.ReplaceAll(s, "\n", " ")
+
+	return `"` + s + `"`
+}
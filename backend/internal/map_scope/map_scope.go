@@ -0,0 +1,169 @@
+package map_scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/hubble/filters"
+	slimLabels "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/labels"
+	ciliumLabels "github.com/cilium/cilium/pkg/labels"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+var (
+	ErrNoK8s      = errors.New("namespace selector of the scope requires access to k8s API")
+	ErrEmptyScope = errors.New("scope doesn't match any namespace")
+)
+
+// NOTE: Scope is a set of namespaces and a selector of pods in them, either
+// of them can be missing. Pods are matched by the labels flows come with,
+// so that pods created after the scope is resolved are in it as well.
+type Scope struct {
+	namespaces map[string]struct{}
+
+	podSelector string
+	matchPod    filters.FilterFunc
+}
+
+func IsRequested(req *ui.ServiceMapScope) bool {
+	return len(req.GetNamespaces()) > 0 ||
+		len(strings.TrimSpace(req.GetNamespaceSelector())) > 0 ||
+		len(strings.TrimSpace(req.GetPodSelector())) > 0
+}
+
+func Resolve(
+	ctx context.Context, k8s kubernetes.Interface, req *ui.ServiceMapScope,
+) (*Scope, error) {
+	nsSelector := strings.TrimSpace(req.GetNamespaceSelector())
+	podSelector := strings.TrimSpace(req.GetPodSelector())
+
+	if err := validateSelector("namespace", nsSelector); err != nil {
+		return nil, err
+	}
+
+	if k8s == nil && len(nsSelector) > 0 {
+		return nil, ErrNoK8s
+	}
+
+	s := &Scope{
+		namespaces:  make(map[string]struct{}),
+		podSelector: podSelector,
+	}
+
+	if len(podSelector) > 0 {
+		matchPod, err := filters.FilterByLabelSelectors([]string{podSelector}, sourceLabels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pod selector '%s': %w", podSelector, err)
+		}
+
+		s.matchPod = matchPod
+	}
+
+	for _, ns := range req.GetNamespaces() {
+		if ns = strings.TrimSpace(ns); len(ns) > 0 {
+			s.namespaces[ns] = struct{}{}
+		}
+	}
+
+	if len(nsSelector) > 0 {
+		nss, err := k8s.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+			LabelSelector: nsSelector,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces of the scope: %w", err)
+		}
+
+		for _, ns := range nss.Items {
+			s.namespaces[ns.Name] = struct{}{}
+		}
+
+		// NOTE: Selector that matches nothing must not widen pod selector
+		// to the whole cluster
+		if len(s.namespaces) == 0 {
+			return nil, ErrEmptyScope
+		}
+	}
+
+	if len(s.namespaces) == 0 && s.matchPod == nil {
+		return nil, ErrEmptyScope
+	}
+
+	return s, nil
+}
+
+func sourceLabels(ev *v1.Event) slimLabels.Labels {
+	return ciliumLabels.ParseLabelArrayFromArray(ev.GetFlow().GetSource().GetLabels())
+}
+
+func validateSelector(what, selector string) error {
+	if len(selector) == 0 {
+		return nil
+	}
+
+	if _, err := k8sLabels.Parse(selector); err != nil {
+		return fmt.Errorf("invalid %s selector '%s': %w", what, selector, err)
+	}
+
+	return nil
+}
+
+func (s *Scope) Namespaces() []string {
+	nss := make([]string, 0, len(s.namespaces))
+	for ns := range s.namespaces {
+		nss = append(nss, ns)
+	}
+
+	slices.Sort(nss)
+	return nss
+}
+
+func (s *Scope) Contains(ep *pbFlow.Endpoint) bool {
+	if len(s.namespaces) > 0 {
+		if _, exists := s.namespaces[ep.GetNamespace()]; !exists {
+			return false
+		}
+	}
+
+	if s.matchPod == nil {
+		return true
+	}
+
+	return s.matchPod(&v1.Event{Event: &pbFlow.Flow{Source: ep}})
+}
+
+// NOTE: Pod selector is passed to relay as label filter, which is applied to
+// the labels of flow endpoints the same way Contains does it
+func (s *Scope) FlowFilters() []*pbFlow.FlowFilter {
+	src, dest := &pbFlow.FlowFilter{}, &pbFlow.FlowFilter{}
+
+	if len(s.namespaces) > 0 {
+		pods := make([]string, 0, len(s.namespaces))
+		for _, ns := range s.Namespaces() {
+			pods = append(pods, ns+"/")
+		}
+
+		src.SourcePod = pods
+		dest.DestinationPod = slices.Clone(pods)
+	}
+
+	if len(s.podSelector) > 0 {
+		src.SourceLabel = []string{s.podSelector}
+		dest.DestinationLabel = []string{s.podSelector}
+	}
+
+	return []*pbFlow.FlowFilter{src, dest}
+}
+
+func (s *Scope) ContainsFlow(f *pbFlow.Flow) bool {
+	return s.Contains(f.GetSource()) || s.Contains(f.GetDestination())
+}
@@ -0,0 +1,108 @@
+package map_scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func TestNamespacesScope(t *testing.T) {
+	req := &ui.ServiceMapScope{Namespaces: []string{"storage", "shop", " "}}
+	if !IsRequested(req) {
+		t.Fatalf("expected scope to be requested")
+	}
+
+	scope, err := Resolve(context.Background(), nil, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ffs := scope.FlowFilters()
+	expected := []*pbFlow.FlowFilter{
+		{SourcePod: []string{"shop/", "storage/"}},
+		{DestinationPod: []string{"shop/", "storage/"}},
+	}
+
+	for i := range expected {
+		if !proto.Equal(ffs[i], expected[i]) {
+			t.Fatalf("filter %d: expected %v, got %v", i, expected[i], ffs[i])
+		}
+	}
+
+	inside := &pbFlow.Endpoint{Namespace: "shop", PodName: "api"}
+	outside := &pbFlow.Endpoint{Namespace: "kube-system", PodName: "coredns"}
+
+	if !scope.Contains(inside) || scope.Contains(outside) {
+		t.Fatalf("unexpected scope membership")
+	}
+
+	if !scope.ContainsFlow(&pbFlow.Flow{Source: outside, Destination: inside}) {
+		t.Fatalf("expected flow crossing the scope to be contained")
+	}
+
+	if scope.ContainsFlow(&pbFlow.Flow{Source: outside, Destination: outside}) {
+		t.Fatalf("expected flow outside of the scope not to be contained")
+	}
+}
+
+func TestPodsScope(t *testing.T) {
+	scope, err := Resolve(context.Background(), nil, &ui.ServiceMapScope{
+		Namespaces:  []string{"shop"},
+		PodSelector: "app in (api, web),tier!=db",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ffs := scope.FlowFilters()
+	expected := []*pbFlow.FlowFilter{
+		{SourcePod: []string{"shop/"}, SourceLabel: []string{"app in (api, web),tier!=db"}},
+		{DestinationPod: []string{"shop/"}, DestinationLabel: []string{"app in (api, web),tier!=db"}},
+	}
+
+	for i := range expected {
+		if !proto.Equal(ffs[i], expected[i]) {
+			t.Fatalf("filter %d: expected %v, got %v", i, expected[i], ffs[i])
+		}
+	}
+
+	cases := []struct {
+		ep       *pbFlow.Endpoint
+		expected bool
+	}{
+		// NOTE: Pods created after the scope is resolved are in it as well
+		{&pbFlow.Endpoint{Namespace: "shop", PodName: "api-7d-x", Labels: []string{"k8s:app=api"}}, true},
+		{&pbFlow.Endpoint{Namespace: "shop", Labels: []string{"k8s:app=web", "k8s:tier=frontend"}}, true},
+		{&pbFlow.Endpoint{Namespace: "shop", Labels: []string{"k8s:app=api", "k8s:tier=db"}}, false},
+		{&pbFlow.Endpoint{Namespace: "storage", Labels: []string{"k8s:app=api"}}, false},
+		{&pbFlow.Endpoint{Namespace: "shop", Labels: []string{"k8s:app=db"}}, false},
+	}
+
+	for _, c := range cases {
+		if scope.Contains(c.ep) != c.expected {
+			t.Fatalf("expected membership of %v to be %v", c.ep, c.expected)
+		}
+	}
+}
+
+func TestResolveErrors(t *testing.T) {
+	ctx := context.Background()
+
+	if _, err := Resolve(ctx, nil, &ui.ServiceMapScope{NamespaceSelector: "team=shop"}); !errors.Is(err, ErrNoK8s) {
+		t.Fatalf("expected ErrNoK8s, got %v", err)
+	}
+
+	if _, err := Resolve(ctx, nil, &ui.ServiceMapScope{PodSelector: "app=(api"}); err == nil {
+		t.Fatalf("expected invalid selector error")
+	}
+
+	if IsRequested(&ui.ServiceMapScope{}) {
+		t.Fatalf("expected empty scope not to be requested")
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"log/slog"
 	"sync"
 
+	"k8s.io/client-go/kubernetes"
+
+	cilium "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
+
 	"github.com/cilium/hubble-ui/backend/internal/api_clients"
 	"github.com/cilium/hubble-ui/backend/internal/mock/sources"
 	"github.com/cilium/hubble-ui/backend/internal/mock/streams"
@@ -113,6 +117,15 @@ func (cl *Clients) NSWatcher(ctx context.Context, opts ns_watcher.NSWatcherOptio
 	return nsw, nil
 }
 
+// NOTE: There is no k8s in mocked environment
+func (cl *Clients) K8s() kubernetes.Interface {
+	return nil
+}
+
+func (cl *Clients) Cilium() cilium.Interface {
+	return nil
+}
+
 func (cl *Clients) duplicateSource() sources.MockedSource {
 	if cl.src == nil {
 		return nil
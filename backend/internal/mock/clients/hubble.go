@@ -54,6 +54,10 @@ func (hcl *HubbleClient) ServerStatus(ctx context.Context) (*observer.ServerStat
 	return nil, nil
 }
 
+func (hcl *HubbleClient) GetNamespaces(ctx context.Context) (*observer.GetNamespacesResponse, error) {
+	return &observer.GetNamespacesResponse{}, nil
+}
+
 func (hcl *HubbleClient) ServerStatusChecker(
 	opts hubble_client.StatusCheckerOptions,
 ) (statuschecker.ServerStatusCheckerInterface, error) {
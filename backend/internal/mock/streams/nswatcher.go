@@ -17,6 +17,7 @@ type NSWatcher struct {
 	stopCh   chan struct{}
 
 	eventsCh chan *ns_common.NSEvent
+	statesCh chan ns_common.K8sState
 	errCh    chan error
 }
 
@@ -27,6 +28,7 @@ func NewNSWatcher(log *slog.Logger, source sources.MockedSource) *NSWatcher {
 		stopOnce: sync.Once{},
 		stopCh:   make(chan struct{}),
 		eventsCh: make(chan *ns_common.NSEvent),
+		statesCh: make(chan ns_common.K8sState),
 		errCh:    make(chan error),
 	}
 }
@@ -71,6 +73,10 @@ func (nsw *NSWatcher) NSEvents() chan *ns_common.NSEvent {
 	return nsw.eventsCh
 }
 
+func (nsw *NSWatcher) K8sStates() chan ns_common.K8sState {
+	return nsw.statesCh
+}
+
 func (nsw *NSWatcher) Errors() chan error {
 	return nsw.errCh
 }
@@ -73,9 +73,13 @@ func (sc *StatusChecker) nextFullStatus() *statuschecker.FullStatus {
 	nodes := sc.genNodesResponse()
 	status := sc.genStatusResponse(nodes.GetNodes())
 
+	tracker := statuschecker.NewNodesTracker()
+	tracker.Update(nodes, status)
+
 	return &statuschecker.FullStatus{
-		Nodes:  nodes,
-		Status: status,
+		Nodes:        nodes,
+		Status:       status,
+		NodeStatuses: tracker.Statuses(),
 	}
 }
 
@@ -0,0 +1,19 @@
+package common
+
+type K8sState int
+
+const (
+	K8sConnected K8sState = iota
+	K8sUnavailable
+)
+
+func (s K8sState) String() string {
+	switch s {
+	case K8sConnected:
+		return "connected"
+	case K8sUnavailable:
+		return "unavailable"
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,187 @@
+package hubble
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/observer"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/internal/ns_watcher/common"
+	grpc_errors "github.com/cilium/hubble-ui/backend/pkg/grpc_utils/errors"
+)
+
+type NamespacesGetter interface {
+	GetNamespaces(context.Context) (*observer.GetNamespacesResponse, error)
+}
+
+// NOTE: This watcher is used when there is no access to k8s namespaces. It
+// polls hubble-relay for namespaces that have flows and turns the difference
+// between two consecutive responses into Added/Deleted NSEvents.
+type Watcher struct {
+	log    *slog.Logger
+	client NamespacesGetter
+	delay  time.Duration
+
+	known map[string]*v1.Namespace
+
+	nsEvents chan *common.NSEvent
+	errors   chan error
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func New(log *slog.Logger, client NamespacesGetter, delay time.Duration) *Watcher {
+	if delay <= 0 {
+		delay = 10 * time.Second
+	}
+
+	return &Watcher{
+		log:      log,
+		client:   client,
+		delay:    delay,
+		known:    make(map[string]*v1.Namespace),
+		nsEvents: make(chan *common.NSEvent),
+		errors:   make(chan error),
+		stop:     make(chan struct{}),
+		stopOnce: sync.Once{},
+	}
+}
+
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.delay)
+	defer ticker.Stop()
+
+	w.log.Info("watcher is running", "delay", w.delay)
+
+	for {
+		if err := w.poll(ctx); err != nil {
+			if !grpc_errors.IsRecoverable(err) {
+				w.sendError(ctx, err)
+			} else {
+				w.log.Warn("GetNamespaces failed, will retry", "error", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() {
+		if w.stop == nil {
+			return
+		}
+
+		close(w.stop)
+	})
+
+	w.log.Info("watcher is stopped")
+}
+
+func (w *Watcher) Errors() chan error {
+	return w.errors
+}
+
+func (w *Watcher) NSEvents() chan *common.NSEvent {
+	return w.nsEvents
+}
+
+func (w *Watcher) poll(ctx context.Context) error {
+	resp, err := w.client.GetNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	added, deleted := diffNamespaces(w.known, resp, time.Now())
+
+	for _, ns := range added {
+		w.known[ns.Name] = ns
+		w.sendNSEvent(ctx, common.EventFromNSObject(events.Added, ns))
+	}
+
+	for _, ns := range deleted {
+		delete(w.known, ns.Name)
+		w.sendNSEvent(ctx, common.EventFromNSObject(events.Deleted, ns))
+	}
+
+	return nil
+}
+
+func (w *Watcher) sendNSEvent(ctx context.Context, nse *common.NSEvent) {
+	select {
+	case <-ctx.Done():
+	case <-w.stop:
+	case w.nsEvents <- nse:
+	}
+}
+
+func (w *Watcher) sendError(ctx context.Context, err error) {
+	select {
+	case <-ctx.Done():
+	case <-w.stop:
+	case w.errors <- err:
+	}
+}
+
+// NOTE: Relay returns the same namespace once per cluster, so namespaces are
+// deduplicated by name here, the same way as they are shown in the UI
+func diffNamespaces(
+	known map[string]*v1.Namespace,
+	resp *observer.GetNamespacesResponse,
+	now time.Time,
+) ([]*v1.Namespace, []*v1.Namespace) {
+	current := make(map[string]struct{}, len(resp.GetNamespaces()))
+	added := []*v1.Namespace{}
+	deleted := []*v1.Namespace{}
+
+	for _, ns := range resp.GetNamespaces() {
+		name := ns.GetNamespace()
+		if len(name) == 0 {
+			continue
+		}
+
+		if _, exists := current[name]; exists {
+			continue
+		}
+
+		current[name] = struct{}{}
+		if _, exists := known[name]; exists {
+			continue
+		}
+
+		added = append(added, namespaceObject(name, now))
+	}
+
+	for name, ns := range known {
+		if _, exists := current[name]; !exists {
+			deleted = append(deleted, ns)
+		}
+	}
+
+	return added, deleted
+}
+
+// NOTE: There is no real k8s object behind namespace obtained from relay, so
+// the time when the namespace is seen first is used as creation time
+func namespaceObject(name string, seenAt time.Time) *v1.Namespace {
+	return &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(seenAt),
+		},
+		Status: v1.NamespaceStatus{
+			Phase: v1.NamespaceActive,
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package hubble
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/observer"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/internal/ns_watcher/common"
+)
+
+type fakeGetter struct {
+	mx        sync.Mutex
+	responses [][]string
+	idx       int
+}
+
+func (fg *fakeGetter) GetNamespaces(_ context.Context) (*observer.GetNamespacesResponse, error) {
+	fg.mx.Lock()
+	defer fg.mx.Unlock()
+
+	names := fg.responses[min(fg.idx, len(fg.responses)-1)]
+	fg.idx += 1
+
+	return nsResponse(names...), nil
+}
+
+func nsResponse(names ...string) *observer.GetNamespacesResponse {
+	resp := &observer.GetNamespacesResponse{}
+
+	for _, name := range names {
+		resp.Namespaces = append(resp.Namespaces, &observer.Namespace{
+			Namespace: name,
+			Cluster:   "default",
+		})
+	}
+
+	return resp
+}
+
+func TestDiffNamespaces(t *testing.T) {
+	now := time.Now()
+
+	added, deleted := diffNamespaces(
+		toKnown("kube-system", "default"),
+		nsResponse("default", "default", "tenant-jobs", ""),
+		now,
+	)
+
+	if names := namesOf(added); !equalNames(names, []string{"tenant-jobs"}) {
+		t.Fatalf("unexpected added namespaces: %v", names)
+	}
+
+	if names := namesOf(deleted); !equalNames(names, []string{"kube-system"}) {
+		t.Fatalf("unexpected deleted namespaces: %v", names)
+	}
+
+	if !added[0].CreationTimestamp.Time.Equal(now) {
+		t.Fatalf("unexpected creation timestamp: %v", added[0].CreationTimestamp)
+	}
+}
+
+func TestWatcherEvents(t *testing.T) {
+	getter := &fakeGetter{
+		responses: [][]string{
+			{"default", "kube-system"},
+			{"default", "tenant-jobs"},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 1*time.Second)
+	defer cancel()
+
+	w := New(slog.Default(), getter, 10*time.Millisecond)
+	go w.Run(ctx)
+	defer w.Stop()
+
+	expected := []string{
+		"added:default",
+		"added:kube-system",
+		"added:tenant-jobs",
+		"deleted:kube-system",
+	}
+
+	got := []string{}
+	for len(got) < len(expected) {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("not enough events received: %v", got)
+		case err := <-w.Errors():
+			t.Fatalf("unexpected error: %v", err)
+		case evt := <-w.NSEvents():
+			got = append(got, eventStr(evt))
+		}
+	}
+
+	sort.Strings(got)
+	if !equalNames(got, expected) {
+		t.Fatalf("unexpected events: %v, expected: %v", got, expected)
+	}
+}
+
+func TestNonPositiveDelay(t *testing.T) {
+	for _, delay := range []time.Duration{0, -time.Second} {
+		if w := New(slog.Default(), &fakeGetter{}, delay); w.delay <= 0 {
+			t.Fatalf("expected default delay for %v, got %v", delay, w.delay)
+		}
+	}
+}
+
+func toKnown(names ...string) map[string]*v1.Namespace {
+	known := make(map[string]*v1.Namespace)
+
+	for _, name := range names {
+		known[name] = namespaceObject(name, time.Now())
+	}
+
+	return known
+}
+
+func namesOf(nss []*v1.Namespace) []string {
+	names := make([]string, 0, len(nss))
+
+	for _, ns := range nss {
+		names = append(names, ns.Name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func eventStr(evt *common.NSEvent) string {
+	kind := "unknown"
+
+	switch evt.Event {
+	case events.Added:
+		kind = "added"
+	case events.Deleted:
+		kind = "deleted"
+	}
+
+	return kind + ":" + evt.GetNamespaceStr()
+}
+
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
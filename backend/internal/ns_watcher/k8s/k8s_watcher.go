@@ -6,19 +6,33 @@ import (
 	"sync"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/cilium/hubble-ui/backend/domain/events"
+	"github.com/cilium/hubble-ui/backend/internal/api_helpers"
 	"github.com/cilium/hubble-ui/backend/internal/ns_watcher/common"
 )
 
+const (
+	errKindNoPermission       = "no-permission"
+	errKindTimeout            = "timeout"
+	errKindServiceUnavailable = "service-unavailable"
+	errKindUnknown            = "unknown"
+)
+
 type Watcher struct {
 	log *slog.Logger
 	k8s kubernetes.Interface
 
+	isUnavailable bool
+
 	nsEvents chan *common.NSEvent
+	states   chan common.K8sState
 	errors   chan error
 	stop     chan struct{}
 	stopOnce sync.Once
@@ -76,12 +90,17 @@ func (w *Watcher) handleNSDeletion(ctx context.Context, obj interface{}) {
 
 func (w *Watcher) Run(ctx context.Context) {
 	restClient := w.k8s.CoreV1().RESTClient()
-	nsWatcher := cache.NewListWatchFromClient(
-		restClient,
-		"namespaces",
-		v1.NamespaceAll,
-		fields.Everything(),
-	)
+	nsWatcher := &listWatcher{
+		ListWatch: cache.NewListWatchFromClient(
+			restClient,
+			"namespaces",
+			v1.NamespaceAll,
+			fields.Everything(),
+		),
+		onSucceeded: func() {
+			w.handleSucceeded(ctx)
+		},
+	}
 
 	fifo := cache.NewDeltaFIFOWithOptions(cache.DeltaFIFOOptions{})
 	cfg := &cache.Config{
@@ -92,7 +111,7 @@ func (w *Watcher) Run(ctx context.Context) {
 			return w.processEvent(ctx, obj)
 		},
 		WatchErrorHandler: func(_ *cache.Reflector, err error) {
-			w.sendError(ctx, err)
+			w.handleWatchError(ctx, err)
 		},
 	}
 
@@ -120,6 +139,16 @@ func (w *Watcher) Errors() chan error {
 	return w.errors
 }
 
+// NOTE: Emits K8sUnavailable when k8s api cannot be reached and K8sConnected
+// when namespaces are listed successfully after that
+func (w *Watcher) States() chan common.K8sState {
+	if w.states == nil {
+		w.states = make(chan common.K8sState)
+	}
+
+	return w.states
+}
+
 func (w *Watcher) NSEvents() chan *common.NSEvent {
 	if w.nsEvents == nil {
 		w.nsEvents = make(chan *common.NSEvent)
@@ -128,6 +157,42 @@ func (w *Watcher) NSEvents() chan *common.NSEvent {
 	return w.nsEvents
 }
 
+// NOTE: This handler is called from the reflector goroutine, the reflector
+// backs off on its own before the next list/watch attempt, so it only reports
+// the state and returns
+func (w *Watcher) handleWatchError(ctx context.Context, err error) {
+	kind := classifyError(err)
+	w.log.Warn("namespaces watch failed", "kind", kind, "error", err)
+
+	switch kind {
+	case errKindNoPermission:
+		w.sendError(ctx, err)
+	default:
+		if !w.isUnavailable {
+			w.isUnavailable = true
+			w.sendState(ctx, common.K8sUnavailable)
+		}
+	}
+}
+
+func (w *Watcher) handleSucceeded(ctx context.Context) {
+	if !w.isUnavailable {
+		return
+	}
+
+	w.isUnavailable = false
+	w.log.Info("k8s api is available again")
+	w.sendState(ctx, common.K8sConnected)
+}
+
+func (w *Watcher) sendState(ctx context.Context, st common.K8sState) {
+	select {
+	case <-ctx.Done():
+	case <-w.stop:
+	case w.States() <- st:
+	}
+}
+
 func (w *Watcher) sendNSEvent(ctx context.Context, nse *common.NSEvent) {
 	select {
 	case <-ctx.Done():
@@ -143,3 +208,64 @@ func (w *Watcher) sendError(ctx context.Context, err error) {
 	case w.Errors() <- err:
 	}
 }
+
+func classifyError(err error) string {
+	switch {
+	case api_helpers.IsK8sResourcePermissionsError(err):
+		return errKindNoPermission
+	case api_helpers.IsTimeout(err):
+		return errKindTimeout
+	case api_helpers.IsServiceUnavailable(err):
+		return errKindServiceUnavailable
+	}
+
+	return errKindUnknown
+}
+
+// NOTE: Wraps ListWatch to find out when k8s api responds successfully again.
+// Watch is wrapped as well since reflector may use streaming list via watch.
+type listWatcher struct {
+	*cache.ListWatch
+
+	onSucceeded func()
+}
+
+func (lw *listWatcher) List(opts metav1.ListOptions) (runtime.Object, error) {
+	obj, err := lw.ListWatch.List(opts)
+	if err == nil {
+		lw.onSucceeded()
+	}
+
+	return obj, err
+}
+
+func (lw *listWatcher) ListWithContext(
+	ctx context.Context, opts metav1.ListOptions,
+) (runtime.Object, error) {
+	obj, err := lw.ListWatch.ListWithContext(ctx, opts)
+	if err == nil {
+		lw.onSucceeded()
+	}
+
+	return obj, err
+}
+
+func (lw *listWatcher) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	wi, err := lw.ListWatch.Watch(opts)
+	if err == nil {
+		lw.onSucceeded()
+	}
+
+	return wi, err
+}
+
+func (lw *listWatcher) WatchWithContext(
+	ctx context.Context, opts metav1.ListOptions,
+) (watch.Interface, error) {
+	wi, err := lw.ListWatch.WatchWithContext(ctx, opts)
+	if err == nil {
+		lw.onSucceeded()
+	}
+
+	return wi, err
+}
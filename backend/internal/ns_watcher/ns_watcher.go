@@ -5,18 +5,28 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/cilium/hubble-ui/backend/internal/api_helpers"
 	"github.com/cilium/hubble-ui/backend/internal/ns_watcher/common"
+	"github.com/cilium/hubble-ui/backend/internal/ns_watcher/hubble"
 	"github.com/cilium/hubble-ui/backend/internal/ns_watcher/k8s"
 )
 
 const (
 	NSWatcherK8sKind int = iota
+	NSWatcherHubbleKind
 )
 
 type NSEvent = common.NSEvent
+type K8sState = common.K8sState
+
+const (
+	K8sConnected   = common.K8sConnected
+	K8sUnavailable = common.K8sUnavailable
+)
 
 type NSWatcherOptions struct {
 	Log *slog.Logger
@@ -27,38 +37,59 @@ type NSWatcherInterface interface {
 	Stop()
 
 	NSEvents() chan *common.NSEvent
+	K8sStates() chan common.K8sState
 	Errors() chan error
 }
 
 type NSWatcher struct {
-	k8sWatcher *k8s.Watcher
-	log        *slog.Logger
+	k8sWatcher    *k8s.Watcher
+	hubbleWatcher *hubble.Watcher
+	log           *slog.Logger
 
 	errors chan error
 	events chan *common.NSEvent
+	states chan common.K8sState
 
 	stop     chan struct{}
 	stopOnce sync.Once
 }
 
+// NOTE: Either k8sHandle or nsGetter must be set. When both are set, k8s is
+// used as the primary source of namespaces and hubble-relay is used as a
+// fallback for the case when k8s RBAC forbids listing namespaces.
 func New(
 	log *slog.Logger,
 	k8sHandle kubernetes.Interface,
+	nsGetter hubble.NamespacesGetter,
+	pollDelay time.Duration,
 ) (*NSWatcher, error) {
 	if log == nil {
 		return nil, nerr("log is nil")
 	}
 
-	if k8sHandle == nil {
-		return nil, nerr("k8s is nil")
+	if k8sHandle == nil && nsGetter == nil {
+		return nil, nerr("both k8s and hubble namespaces getter are nil")
+	}
+
+	w := &NSWatcher{
+		log:      log,
+		stop:     make(chan struct{}),
+		stopOnce: sync.Once{},
+	}
+
+	if k8sHandle != nil {
+		w.k8sWatcher = k8s.New(log.With(slog.String("ns-kind", "k8s")), k8sHandle)
+	}
+
+	if nsGetter != nil {
+		w.hubbleWatcher = hubble.New(
+			log.With(slog.String("ns-kind", "hubble")),
+			nsGetter,
+			pollDelay,
+		)
 	}
 
-	return &NSWatcher{
-		log:        log,
-		k8sWatcher: k8s.New(log.With(slog.String("ns-kind", "k8s")), k8sHandle),
-		stop:       make(chan struct{}),
-		stopOnce:   sync.Once{},
-	}, nil
+	return w, nil
 }
 
 func NewDumb() *NSWatcher {
@@ -66,7 +97,14 @@ func NewDumb() *NSWatcher {
 }
 
 func (w *NSWatcher) Run(ctx context.Context) {
-	w.runK8sWatcher(ctx)
+	if w.k8sWatcher == nil {
+		w.runHubbleWatcher(ctx)
+		return
+	}
+
+	if isSwitchRequired := w.runK8sWatcher(ctx); isSwitchRequired {
+		w.runHubbleWatcher(ctx)
+	}
 }
 
 func (w *NSWatcher) Stop() {
@@ -74,6 +112,10 @@ func (w *NSWatcher) Stop() {
 		w.k8sWatcher.Stop()
 	}
 
+	if w.hubbleWatcher != nil {
+		w.hubbleWatcher.Stop()
+	}
+
 	w.stopOnce.Do(func() {
 		if w.stop == nil {
 			return
@@ -91,6 +133,14 @@ func (w *NSWatcher) NSEvents() chan *common.NSEvent {
 	return w.events
 }
 
+func (w *NSWatcher) K8sStates() chan common.K8sState {
+	if w.states == nil {
+		w.states = make(chan common.K8sState)
+	}
+
+	return w.states
+}
+
 func (w *NSWatcher) Errors() chan error {
 	if w.errors == nil {
 		w.errors = make(chan error)
@@ -99,19 +149,58 @@ func (w *NSWatcher) Errors() chan error {
 	return w.errors
 }
 
-func (w *NSWatcher) runK8sWatcher(ctx context.Context) {
+// NOTE: Returns true if k8s watcher is stopped since it has no permissions
+// to list namespaces and hubble watcher should be used instead
+func (w *NSWatcher) runK8sWatcher(ctx context.Context) bool {
 	go w.k8sWatcher.Run(ctx)
 
-F:
 	for {
 		select {
 		case <-ctx.Done():
-			break F
+			return false
 		case <-w.stop:
-			break F
+			return false
 		case evt := <-w.k8sWatcher.NSEvents():
 			w.sendEvent(NSWatcherK8sKind, ctx, evt)
+		case st := <-w.k8sWatcher.States():
+			w.sendState(ctx, st)
 		case err := <-w.k8sWatcher.Errors():
+			// NOTE: Permission error is forwarded anyway, so that user will be
+			// notified that namespaces are taken from hubble-relay
+			w.sendError(ctx, err)
+
+			if w.hubbleWatcher == nil || !api_helpers.IsK8sResourcePermissionsError(err) {
+				continue
+			}
+
+			w.log.Warn(
+				"no permissions to list k8s namespaces, switching to hubble-relay",
+				"error", err,
+			)
+
+			w.k8sWatcher.Stop()
+			return true
+		}
+	}
+}
+
+func (w *NSWatcher) runHubbleWatcher(ctx context.Context) {
+	if w.hubbleWatcher == nil {
+		return
+	}
+
+	go w.hubbleWatcher.Run(ctx)
+
+F:
+	for {
+		select {
+		case <-ctx.Done():
+			break F
+		case <-w.stop:
+			break F
+		case evt := <-w.hubbleWatcher.NSEvents():
+			w.sendEvent(NSWatcherHubbleKind, ctx, evt)
+		case err := <-w.hubbleWatcher.Errors():
 			w.sendError(ctx, err)
 		}
 	}
@@ -125,6 +214,14 @@ func (w *NSWatcher) sendError(ctx context.Context, err error) {
 	}
 }
 
+func (w *NSWatcher) sendState(ctx context.Context, st common.K8sState) {
+	select {
+	case <-ctx.Done():
+	case <-w.stop:
+	case w.K8sStates() <- st:
+	}
+}
+
 func (w *NSWatcher) sendEvent(_kind int, ctx context.Context, nse *common.NSEvent) {
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,539 @@
+package policies
+
+import (
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	slimMetav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+	policyApi "github.com/cilium/cilium/pkg/policy/api"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/domain/service"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const (
+	namespaceLabel = "k8s:io.kubernetes.pod.namespace"
+	k8sLabelPrefix = "k8s:"
+)
+
+var (
+	// NOTE: Labels that are set by cilium itself and are useless in selectors
+	ignoredLabelPrefixes = []string{
+		"io.kubernetes.pod.namespace",
+		"io.cilium.k8s.namespace.labels.",
+		"io.cilium.k8s.policy.",
+	}
+
+	// NOTE: When one of these labels is presented, it is the only one used
+	// in selector, in order of priority
+	appLabelKeys = []string{
+		"app.kubernetes.io/name",
+		"app",
+		"k8s-app",
+		"name",
+	}
+
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+	// NOTE: Drafts allow only the traffic that is allowed now, redirected
+	// flows are the ones allowed and sent to L7 proxy
+	allowedVerdicts = []pbFlow.Verdict{
+		pbFlow.Verdict_FORWARDED,
+		pbFlow.Verdict_REDIRECTED,
+		pbFlow.Verdict_AUDIT,
+	}
+)
+
+type Draft struct {
+	Name      string
+	Namespace string
+	ServiceId string
+	YAML      string
+}
+
+// NOTE: Only the fields that make sense for a draft are serialized, so that
+// the YAML doesn't contain empty status and creation timestamp
+type draftObject struct {
+	APIVersion string          `json:"apiVersion"`
+	Kind       string          `json:"kind"`
+	Metadata   draftMeta       `json:"metadata"`
+	Spec       *policyApi.Rule `json:"spec"`
+}
+
+type draftMeta struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type peerKind int
+
+const (
+	peerEndpoint peerKind = iota
+	peerEntity
+	peerFQDN
+)
+
+type peer struct {
+	key      string
+	kind     peerKind
+	selector map[string]string
+	entity   policyApi.Entity
+	fqdns    []string
+}
+
+type peerPorts struct {
+	peer *peer
+
+	// NOTE: ICMP traffic has no ports, so peer is allowed on L3 in that case
+	isL3Only bool
+	ports    map[string]policyApi.PortProtocol
+}
+
+type workloadRules struct {
+	svc     *ui.Service
+	name    string
+	ingress map[string]*peerPorts
+	egress  map[string]*peerPorts
+
+	// NOTE: Names of peers that can't be selected by labels
+	skippedPeers []string
+}
+
+// NOTE: Generates one CiliumNetworkPolicy per workload of the namespace that
+// allows exactly the traffic that is presented in the cache, links with
+// dropped flows only are not allowed
+func GenerateDrafts(dcache *cache.DataCache, namespace string, description string) ([]*Draft, error) {
+	svcs := make(map[string]*ui.Service)
+	dcache.ForEachService(func(_ string, svc *service.Service) {
+		svcs[svc.Id()] = svc.ToProto()
+	})
+
+	workloads := make(map[string]*workloadRules)
+	getWorkload := func(svc *ui.Service) *workloadRules {
+		wl, exists := workloads[svc.Id]
+		if !exists {
+			wl = &workloadRules{
+				svc:     svc,
+				ingress: make(map[string]*peerPorts),
+				egress:  make(map[string]*peerPorts),
+			}
+
+			workloads[svc.Id] = wl
+		}
+
+		return wl
+	}
+
+	dcache.ForEachLink(func(_ string, l *link.Link) {
+		src, dst := svcs[l.SourceId], svcs[l.DestinationId]
+		if src == nil || dst == nil || !hasAllowedFlows(l) {
+			return
+		}
+
+		if isWorkload(dst, namespace) {
+			getWorkload(dst).addPeer(src, false, l)
+		}
+
+		if isWorkload(src, namespace) {
+			getWorkload(src).addPeer(dst, true, l)
+		}
+	})
+
+	// NOTE: Empty selector would select every endpoint of the namespace
+	maps.DeleteFunc(workloads, func(_ string, wl *workloadRules) bool {
+		return len(selectorFromLabels(wl.svc.Labels, "")) == 0
+	})
+
+	assignDraftNames(workloads)
+
+	drafts := make([]*Draft, 0, len(workloads))
+	for _, wl := range workloads {
+		draft, err := buildDraft(wl, namespace, description)
+		if err != nil {
+			return nil, err
+		}
+
+		drafts = append(drafts, draft)
+	}
+
+	slices.SortFunc(drafts, func(a, b *Draft) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return drafts, nil
+}
+
+func hasAllowedFlows(l *link.Link) bool {
+	for _, verdict := range allowedVerdicts {
+		if l.VerdictCounts[verdict] > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func buildDraft(wl *workloadRules, namespace, description string) (*Draft, error) {
+	rule := &policyApi.Rule{
+		EndpointSelector: endpointSelector(selectorFromLabels(wl.svc.Labels, "")),
+		Description:      description,
+	}
+
+	for _, pp := range sortedPeers(wl.ingress) {
+		ingress := policyApi.IngressRule{ToPorts: pp.portRules()}
+
+		switch pp.peer.kind {
+		case peerEntity:
+			ingress.FromEntities = policyApi.EntitySlice{pp.peer.entity}
+		default:
+			ingress.FromEndpoints = []policyApi.EndpointSelector{
+				endpointSelector(pp.peer.selector),
+			}
+		}
+
+		rule.Ingress = append(rule.Ingress, ingress)
+	}
+
+	hasFQDNs := false
+	for _, pp := range sortedPeers(wl.egress) {
+		egress := policyApi.EgressRule{ToPorts: pp.portRules()}
+
+		switch pp.peer.kind {
+		case peerEntity:
+			egress.ToEntities = policyApi.EntitySlice{pp.peer.entity}
+		case peerFQDN:
+			hasFQDNs = true
+			for _, name := range pp.peer.fqdns {
+				egress.ToFQDNs = append(egress.ToFQDNs, policyApi.FQDNSelector{
+					MatchName: name,
+				})
+			}
+		default:
+			egress.ToEndpoints = []policyApi.EndpointSelector{
+				endpointSelector(pp.peer.selector),
+			}
+		}
+
+		rule.Egress = append(rule.Egress, egress)
+	}
+
+	// NOTE: toFQDNs rules work only when DNS traffic goes through DNS proxy
+	if hasFQDNs {
+		rule.Egress = append(rule.Egress, kubeDNSEgressRule())
+	}
+
+	name := wl.name
+	obj := &draftObject{
+		APIVersion: "cilium.io/v2",
+		Kind:       KindCiliumNetworkPolicy,
+		Metadata: draftMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rule,
+	}
+
+	raw, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize policy draft '%s': %w", name, err)
+	}
+
+	return &Draft{
+		Name:      name,
+		Namespace: namespace,
+		ServiceId: wl.svc.Id,
+		YAML:      skippedPeersComment(wl.skippedPeers) + string(raw),
+	}, nil
+}
+
+// NOTE: Peer without labels could only be selected by the whole namespace,
+// which would allow much more than the observed traffic, so such peers are
+// left out of the draft and listed in its comment
+func (wl *workloadRules) addPeer(svc *ui.Service, isReceiver bool, l *link.Link) {
+	p := peerFromService(svc, isReceiver)
+	if p.kind == peerEndpoint && !hasLabelsBesidesNamespace(p.selector) {
+		if !slices.Contains(wl.skippedPeers, svc.Name) {
+			wl.skippedPeers = append(wl.skippedPeers, svc.Name)
+		}
+
+		return
+	}
+
+	if isReceiver {
+		addPeerPort(wl.egress, p, l)
+	} else {
+		addPeerPort(wl.ingress, p, l)
+	}
+}
+
+func hasLabelsBesidesNamespace(selector map[string]string) bool {
+	for key := range selector {
+		if key != namespaceLabel {
+			return true
+		}
+	}
+
+	return false
+}
+
+func skippedPeersComment(peers []string) string {
+	if len(peers) == 0 {
+		return ""
+	}
+
+	sorted := slices.Clone(peers)
+	slices.Sort(sorted)
+
+	return fmt.Sprintf(
+		"# Traffic with peers that have no labels is not allowed: %s\n",
+		strings.Join(sorted, ", "),
+	)
+}
+
+func isWorkload(svc *ui.Service, namespace string) bool {
+	if svc.Namespace != namespace {
+		return false
+	}
+
+	return entityFromProps(labels.Props(svc.Labels)) == ""
+}
+
+func peerFromService(svc *ui.Service, isReceiver bool) *peer {
+	props := labels.Props(svc.Labels)
+
+	entity := entityFromProps(props)
+	if entity == policyApi.EntityWorld && isReceiver && len(svc.DnsNames) > 0 {
+		fqdns := slices.Clone(svc.DnsNames)
+		slices.Sort(fqdns)
+
+		return &peer{
+			key:   "fqdn:" + strings.Join(fqdns, ","),
+			kind:  peerFQDN,
+			fqdns: fqdns,
+		}
+	}
+
+	if entity != "" {
+		return &peer{
+			key:    "entity:" + string(entity),
+			kind:   peerEntity,
+			entity: entity,
+		}
+	}
+
+	selector := selectorFromLabels(svc.Labels, svc.Namespace)
+	return &peer{
+		key:      "endpoint:" + selectorKey(selector),
+		kind:     peerEndpoint,
+		selector: selector,
+	}
+}
+
+func entityFromProps(props *labels.LabelProps) policyApi.Entity {
+	switch {
+	case props.IsKubeAPIServer:
+		return policyApi.EntityKubeAPIServer
+	case props.IsHost:
+		return policyApi.EntityHost
+	case props.IsRemoteNode:
+		return policyApi.EntityRemoteNode
+	case props.IsHealth:
+		return policyApi.EntityHealth
+	case props.IsInit:
+		return policyApi.EntityInit
+	case props.IsWorld:
+		return policyApi.EntityWorld
+	}
+
+	return ""
+}
+
+// NOTE: Namespace is added to selector only for peers, since policy itself
+// selects endpoints of its own namespace
+func selectorFromLabels(lbls []string, namespace string) map[string]string {
+	kv := make(map[string]string)
+	for _, lbl := range lbls {
+		if !strings.HasPrefix(lbl, k8sLabelPrefix) {
+			continue
+		}
+
+		k, v := labels.LabelAsKeyValue(strings.TrimPrefix(lbl, k8sLabelPrefix), false)
+		if isIgnoredLabel(k) {
+			continue
+		}
+
+		kv[k] = v
+	}
+
+	selector := make(map[string]string)
+	for _, appKey := range appLabelKeys {
+		if v, exists := kv[appKey]; exists {
+			selector[appKey] = v
+			break
+		}
+	}
+
+	if len(selector) == 0 {
+		selector = kv
+	}
+
+	if len(namespace) > 0 {
+		selector[namespaceLabel] = namespace
+	}
+
+	return selector
+}
+
+func isIgnoredLabel(key string) bool {
+	for _, prefix := range ignoredLabelPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func selectorKey(selector map[string]string) string {
+	parts := make([]string, 0, len(selector))
+	for k, v := range selector {
+		parts = append(parts, k+"="+v)
+	}
+
+	slices.Sort(parts)
+	return strings.Join(parts, ",")
+}
+
+func addPeerPort(peers map[string]*peerPorts, p *peer, l *link.Link) {
+	pp, exists := peers[p.key]
+	if !exists {
+		pp = &peerPorts{
+			peer:  p,
+			ports: make(map[string]policyApi.PortProtocol),
+		}
+
+		peers[p.key] = pp
+	}
+
+	var proto policyApi.L4Proto
+	switch l.IPProtocol {
+	case ui.IPProtocol_TCP:
+		proto = policyApi.ProtoTCP
+	case ui.IPProtocol_UDP:
+		proto = policyApi.ProtoUDP
+	default:
+		pp.isL3Only = true
+		return
+	}
+
+	port := strconv.FormatUint(uint64(l.DestinationPort), 10)
+	pp.ports[port+"/"+string(proto)] = policyApi.PortProtocol{
+		Port:     port,
+		Protocol: proto,
+	}
+}
+
+func (pp *peerPorts) portRules() policyApi.PortRules {
+	if pp.isL3Only || len(pp.ports) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(pp.ports))
+	for key := range pp.ports {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	ports := make([]policyApi.PortProtocol, 0, len(keys))
+	for _, key := range keys {
+		ports = append(ports, pp.ports[key])
+	}
+
+	return policyApi.PortRules{{Ports: ports}}
+}
+
+func sortedPeers(peers map[string]*peerPorts) []*peerPorts {
+	sorted := make([]*peerPorts, 0, len(peers))
+	for _, pp := range peers {
+		sorted = append(sorted, pp)
+	}
+
+	slices.SortFunc(sorted, func(a, b *peerPorts) int {
+		return strings.Compare(a.peer.key, b.peer.key)
+	})
+
+	return sorted
+}
+
+// NOTE: Selector is not sanitized on purpose, so that label keys are kept in
+// the form user writes them in manifests
+func endpointSelector(matchLabels map[string]string) policyApi.EndpointSelector {
+	return policyApi.EndpointSelector{
+		LabelSelector: &slimMetav1.LabelSelector{
+			MatchLabels: matchLabels,
+		},
+	}
+}
+
+func kubeDNSEgressRule() policyApi.EgressRule {
+	return policyApi.EgressRule{
+		EgressCommonRule: policyApi.EgressCommonRule{
+			ToEndpoints: []policyApi.EndpointSelector{
+				endpointSelector(map[string]string{
+					namespaceLabel: "kube-system",
+					"k8s:k8s-app":  "kube-dns",
+				}),
+			},
+		},
+		ToPorts: policyApi.PortRules{{
+			Ports: []policyApi.PortProtocol{{
+				Port:     "53",
+				Protocol: policyApi.ProtoAny,
+			}},
+			Rules: &policyApi.L7Rules{
+				DNS: []policyApi.PortRuleDNS{{MatchPattern: "*"}},
+			},
+		}},
+	}
+}
+
+// NOTE: Workloads of different kinds can share the name, drafts of such
+// workloads get a suffix derived from the service id to keep names unique
+func assignDraftNames(workloads map[string]*workloadRules) {
+	counts := make(map[string]int)
+	for _, wl := range workloads {
+		wl.name = draftName(wl.svc.Name)
+		counts[wl.name] += 1
+	}
+
+	for _, wl := range workloads {
+		if counts[wl.name] < 2 {
+			continue
+		}
+
+		h := fnv.New32a()
+		h.Write([]byte(wl.svc.Id))
+		wl.name = draftName(fmt.Sprintf("%s-%08x", wl.svc.Name, h.Sum32()))
+	}
+}
+
+func draftName(svcName string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(svcName), "-")
+	name = strings.Trim(name, "-.")
+	if len(name) == 0 {
+		name = "workload"
+	}
+
+	return name + "-from-traffic"
+}
@@ -0,0 +1,155 @@
+package policies
+
+import (
+	"strings"
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+)
+
+func tcpFlow(src, dst *pbFlow.Endpoint, port uint32, dstNames []string) *pbFlow.Flow {
+	return &pbFlow.Flow{
+		Source:           src,
+		Destination:      dst,
+		DestinationNames: dstNames,
+		Verdict:          pbFlow.Verdict_FORWARDED,
+		L4: &pbFlow.Layer4{
+			Protocol: &pbFlow.Layer4_TCP{
+				TCP: &pbFlow.TCP{DestinationPort: port},
+			},
+		},
+	}
+}
+
+func dropped(f *pbFlow.Flow) *pbFlow.Flow {
+	f.Verdict = pbFlow.Verdict_DROPPED
+	return f
+}
+
+func TestGenerateDrafts(t *testing.T) {
+	frontend := &pbFlow.Endpoint{
+		Identity:  1001,
+		Namespace: "shop",
+		Labels: []string{
+			"k8s:app=frontend",
+			"k8s:io.kubernetes.pod.namespace=shop",
+		},
+	}
+
+	backend := &pbFlow.Endpoint{
+		Identity:  1002,
+		Namespace: "shop",
+		Labels: []string{
+			"k8s:app=backend",
+			"k8s:io.kubernetes.pod.namespace=shop",
+		},
+	}
+
+	world := &pbFlow.Endpoint{
+		Identity: 2,
+		Labels:   []string{"reserved:world"},
+	}
+
+	dcache := cache.New()
+	flows := flow.Wrap([]*pbFlow.Flow{
+		tcpFlow(frontend, backend, 8080, nil),
+		tcpFlow(frontend, backend, 8080, nil),
+		tcpFlow(backend, world, 443, []string{"api.example.com"}),
+		dropped(tcpFlow(world, backend, 9000, nil)),
+	})
+
+	dcache.UpsertServicesFromFlows(flows)
+	dcache.UpsertLinksFromFlows(flows)
+
+	drafts, err := GenerateDrafts(dcache, "shop", "test")
+	if err != nil {
+		t.Fatalf("failed to generate drafts: %v", err)
+	}
+
+	if len(drafts) != 2 {
+		t.Fatalf("expected 2 drafts, got %d", len(drafts))
+	}
+
+	if drafts[0].Name != "backend-from-traffic" || drafts[1].Name != "frontend-from-traffic" {
+		t.Fatalf("unexpected drafts: %s, %s", drafts[0].Name, drafts[1].Name)
+	}
+
+	backendYAML := drafts[0].YAML
+	for _, expected := range []string{
+		"kind: CiliumNetworkPolicy",
+		"namespace: shop",
+		"fromEndpoints:",
+		"app: frontend",
+		"port: \"8080\"",
+		"matchName: api.example.com",
+		"k8s:k8s-app: kube-dns",
+		"k8s:io.kubernetes.pod.namespace: shop",
+	} {
+		if !strings.Contains(backendYAML, expected) {
+			t.Fatalf("'%s' is not found in draft:\n%s", expected, backendYAML)
+		}
+	}
+
+	// NOTE: Dropped traffic from world is not allowed
+	for _, unexpected := range []string{"fromEntities:", "port: \"9000\""} {
+		if strings.Contains(backendYAML, unexpected) {
+			t.Fatalf("'%s' of dropped flows is found in draft:\n%s", unexpected, backendYAML)
+		}
+	}
+}
+
+func TestDraftsOfSameNamedWorkloads(t *testing.T) {
+	api := func(identity uint32, kind, tier string) *pbFlow.Endpoint {
+		return &pbFlow.Endpoint{
+			Identity:  identity,
+			Namespace: "shop",
+			Labels:    []string{"k8s:app=api", "k8s:tier=" + tier},
+			Workloads: []*pbFlow.Workload{{Kind: kind, Name: "api"}},
+		}
+	}
+
+	unlabeled := &pbFlow.Endpoint{
+		Identity:  1005,
+		Namespace: "shop",
+		Labels:    []string{"k8s:io.kubernetes.pod.namespace=shop"},
+	}
+
+	dcache := cache.New()
+	flows := flow.Wrap([]*pbFlow.Flow{
+		tcpFlow(unlabeled, api(1001, "Deployment", "public"), 8080, nil),
+		tcpFlow(api(1001, "Deployment", "public"), api(1002, "StatefulSet", "internal"), 9090, nil),
+	})
+
+	dcache.UpsertServicesFromFlows(flows)
+	dcache.UpsertLinksFromFlows(flows)
+
+	drafts, err := GenerateDrafts(dcache, "shop", "test")
+	if err != nil {
+		t.Fatalf("failed to generate drafts: %v", err)
+	}
+
+	if len(drafts) != 2 || drafts[0].Name == drafts[1].Name {
+		t.Fatalf("expected 2 drafts with different names, got %v", drafts)
+	}
+
+	for _, d := range drafts {
+		if !strings.HasPrefix(d.Name, "api-") || !strings.HasSuffix(d.Name, "-from-traffic") {
+			t.Fatalf("unexpected draft name: %s", d.Name)
+		}
+
+		if d.ServiceId != "1001" {
+			continue
+		}
+
+		if !strings.HasPrefix(d.YAML, "# Traffic with peers that have no labels is not allowed: 1005") {
+			t.Fatalf("expected unlabeled peer to be listed in draft:\n%s", d.YAML)
+		}
+
+		if strings.Contains(d.YAML, "port: \"8080\"") {
+			t.Fatalf("expected unlabeled peer to not be allowed:\n%s", d.YAML)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	monitorApi "github.com/cilium/cilium/pkg/monitor/api"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+type Explainer struct {
+	resolver *Resolver
+}
+
+// NOTE: Resolver without clientsets leaves policies unresolved
+func NewExplainer(resolver *Resolver) *Explainer {
+	return &Explainer{
+		resolver: resolver,
+	}
+}
+
+func (e *Explainer) Explain(ctx context.Context, f *pbFlow.Flow) *ui.PolicyVerdictExplanation {
+	expl := &ui.PolicyVerdictExplanation{
+		FlowUuid:         f.GetUuid(),
+		Verdict:          f.GetVerdict(),
+		TrafficDirection: f.GetTrafficDirection(),
+		MatchKind:        MatchKindFromFlow(f),
+		IsAudit:          f.GetVerdict() == pbFlow.Verdict_AUDIT,
+	}
+
+	allowedBy, deniedBy := policiesByDirection(f)
+	for _, p := range allowedBy {
+		expl.AllowedBy = append(expl.AllowedBy, e.resolver.Resolve(ctx, p))
+	}
+
+	for _, p := range deniedBy {
+		expl.DeniedBy = append(expl.DeniedBy, e.resolver.Resolve(ctx, p))
+	}
+
+	expl.IsDefaultDeny = len(expl.DeniedBy) == 0 &&
+		(expl.IsAudit || isDroppedByPolicy(f))
+
+	expl.Summary = summary(f, expl)
+	expl.Details = details(f, expl)
+
+	return expl
+}
+
+func MatchKindFromFlow(f *pbFlow.Flow) ui.PolicyMatchKind {
+	if f.GetL7() != nil {
+		return ui.PolicyMatchKind_L7
+	}
+
+	switch f.GetPolicyMatchType() {
+	case monitorApi.PolicyMatchNone:
+		return ui.PolicyMatchKind_NO_POLICY_MATCH
+	case monitorApi.PolicyMatchL3Only:
+		return ui.PolicyMatchKind_L3_ONLY
+	case monitorApi.PolicyMatchL3L4:
+		return ui.PolicyMatchKind_L3_L4
+	case monitorApi.PolicyMatchL4Only:
+		return ui.PolicyMatchKind_L4_ONLY
+	case monitorApi.PolicyMatchAll:
+		return ui.PolicyMatchKind_ALLOW_ALL
+	case monitorApi.PolicyMatchL3Proto:
+		return ui.PolicyMatchKind_L3_PROTO
+	case monitorApi.PolicyMatchProtoOnly:
+		return ui.PolicyMatchKind_PROTO_ONLY
+	}
+
+	return ui.PolicyMatchKind_UNKNOWN_POLICY_MATCH
+}
+
+func policiesByDirection(f *pbFlow.Flow) ([]*pbFlow.Policy, []*pbFlow.Policy) {
+	switch f.GetTrafficDirection() {
+	case pbFlow.TrafficDirection_INGRESS:
+		return f.GetIngressAllowedBy(), f.GetIngressDeniedBy()
+	case pbFlow.TrafficDirection_EGRESS:
+		return f.GetEgressAllowedBy(), f.GetEgressDeniedBy()
+	}
+
+	allowedBy := append(
+		append([]*pbFlow.Policy{}, f.GetIngressAllowedBy()...),
+		f.GetEgressAllowedBy()...,
+	)
+
+	deniedBy := append(
+		append([]*pbFlow.Policy{}, f.GetIngressDeniedBy()...),
+		f.GetEgressDeniedBy()...,
+	)
+
+	return allowedBy, deniedBy
+}
+
+func isDroppedByPolicy(f *pbFlow.Flow) bool {
+	if f.GetVerdict() != pbFlow.Verdict_DROPPED {
+		return false
+	}
+
+	switch f.GetDropReasonDesc() {
+	case pbFlow.DropReason_POLICY_DENIED, pbFlow.DropReason_POLICY_DENY:
+		return true
+	}
+
+	return false
+}
+
+func summary(f *pbFlow.Flow, expl *ui.PolicyVerdictExplanation) string {
+	dir := directionString(expl.TrafficDirection)
+
+	switch expl.Verdict {
+	case pbFlow.Verdict_DROPPED:
+		if len(expl.DeniedBy) > 0 {
+			return fmt.Sprintf("Dropped%s by deny policy %s", dir, refsString(expl.DeniedBy))
+		}
+
+		if expl.IsDefaultDeny {
+			return fmt.Sprintf("Dropped%s: no policy allows this traffic (default deny)", dir)
+		}
+
+		return fmt.Sprintf(
+			"Dropped%s for a reason not related to policies: %s",
+			dir, f.GetDropReasonDesc().String(),
+		)
+	case pbFlow.Verdict_AUDIT:
+		if len(expl.DeniedBy) > 0 {
+			return fmt.Sprintf(
+				"Would be dropped%s by deny policy %s, but policy enforcement is in audit mode",
+				dir, refsString(expl.DeniedBy),
+			)
+		}
+
+		return fmt.Sprintf(
+			"Would be dropped%s since no policy allows it, but policy enforcement is in audit mode",
+			dir,
+		)
+	case pbFlow.Verdict_FORWARDED, pbFlow.Verdict_REDIRECTED:
+		if len(expl.AllowedBy) > 0 {
+			return fmt.Sprintf(
+				"Allowed%s by policy %s with %s match",
+				dir, refsString(expl.AllowedBy), matchKindString(expl.MatchKind),
+			)
+		}
+
+		if expl.MatchKind == ui.PolicyMatchKind_NO_POLICY_MATCH {
+			return fmt.Sprintf("Forwarded%s: no policy is applied to this traffic", dir)
+		}
+
+		return fmt.Sprintf("Forwarded%s with %s match", dir, matchKindString(expl.MatchKind))
+	}
+
+	return fmt.Sprintf("Verdict is %s, policy decision is unknown", expl.Verdict.String())
+}
+
+func details(f *pbFlow.Flow, expl *ui.PolicyVerdictExplanation) []string {
+	d := []string{}
+
+	switch expl.MatchKind {
+	case ui.PolicyMatchKind_L3_ONLY:
+		d = append(d, "Policy matched on peer identity only, any port is allowed")
+	case ui.PolicyMatchKind_L3_L4:
+		d = append(d, "Policy matched on both peer identity and port/protocol")
+	case ui.PolicyMatchKind_L4_ONLY:
+		d = append(d, "Policy matched on port/protocol, any peer is allowed")
+	case ui.PolicyMatchKind_ALLOW_ALL:
+		d = append(d, "Policy allows all traffic")
+	case ui.PolicyMatchKind_L3_PROTO:
+		d = append(d, "Policy matched on peer identity and protocol")
+	case ui.PolicyMatchKind_PROTO_ONLY:
+		d = append(d, "Policy matched on protocol only")
+	case ui.PolicyMatchKind_L7:
+		d = append(d, "Traffic was processed by L7 proxy and matched on L7 rules")
+	}
+
+	if expl.Verdict == pbFlow.Verdict_DROPPED {
+		d = append(d, fmt.Sprintf("Drop reason: %s", f.GetDropReasonDesc().String()))
+	}
+
+	for _, ref := range expl.AllowedBy {
+		d = append(d, "Allowed by "+refDetails(ref))
+	}
+
+	for _, ref := range expl.DeniedBy {
+		d = append(d, "Denied by "+refDetails(ref))
+	}
+
+	return d
+}
+
+func refDetails(ref *ui.PolicyReference) string {
+	s := fmt.Sprintf("%s %s (revision %d)", kindString(ref.Kind), refName(ref), ref.Revision)
+
+	switch {
+	case !ref.IsResolved:
+		s += fmt.Sprintf(", policy was not fetched: %s", ref.ResolveError)
+	case ref.HasL7Rules:
+		s += ", policy has L7 rules"
+	}
+
+	return s
+}
+
+func refsString(refs []*ui.PolicyReference) string {
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		names = append(names, refName(ref))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func refName(ref *ui.PolicyReference) string {
+	if len(ref.Namespace) == 0 {
+		return ref.Name
+	}
+
+	return ref.Namespace + "/" + ref.Name
+}
+
+func kindString(kind string) string {
+	if len(kind) == 0 {
+		return "policy"
+	}
+
+	return kind
+}
+
+func directionString(dir pbFlow.TrafficDirection) string {
+	switch dir {
+	case pbFlow.TrafficDirection_INGRESS:
+		return " on ingress"
+	case pbFlow.TrafficDirection_EGRESS:
+		return " on egress"
+	}
+
+	return ""
+}
+
+func matchKindString(kind ui.PolicyMatchKind) string {
+	switch kind {
+	case ui.PolicyMatchKind_L3_ONLY:
+		return "L3"
+	case ui.PolicyMatchKind_L3_L4:
+		return "L3/L4"
+	case ui.PolicyMatchKind_L4_ONLY:
+		return "L4"
+	case ui.PolicyMatchKind_ALLOW_ALL:
+		return "allow-all"
+	case ui.PolicyMatchKind_L3_PROTO:
+		return "L3/protocol"
+	case ui.PolicyMatchKind_PROTO_ONLY:
+		return "protocol"
+	case ui.PolicyMatchKind_L7:
+		return "L7"
+	}
+
+	return "unknown"
+}
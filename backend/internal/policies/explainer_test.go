@@ -0,0 +1,136 @@
+package policies
+
+import (
+	"context"
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	monitorApi "github.com/cilium/cilium/pkg/monitor/api"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func TestExplain(t *testing.T) {
+	allowApi := &pbFlow.Policy{Name: "allow-api", Namespace: "shop", Kind: KindCiliumNetworkPolicy}
+	denyAll := &pbFlow.Policy{Name: "deny-all", Namespace: "shop", Kind: KindCiliumNetworkPolicy}
+
+	cases := []struct {
+		name          string
+		flow          *pbFlow.Flow
+		summary       string
+		matchKind     ui.PolicyMatchKind
+		allowedBy     int
+		deniedBy      int
+		isDefaultDeny bool
+	}{
+		{
+			name: "allowed by policy",
+			flow: &pbFlow.Flow{
+				Verdict:          pbFlow.Verdict_FORWARDED,
+				TrafficDirection: pbFlow.TrafficDirection_INGRESS,
+				PolicyMatchType:  monitorApi.PolicyMatchL3L4,
+				IngressAllowedBy: []*pbFlow.Policy{allowApi},
+				EgressAllowedBy:  []*pbFlow.Policy{denyAll},
+			},
+			summary:   "Allowed on ingress by policy shop/allow-api with L3/L4 match",
+			matchKind: ui.PolicyMatchKind_L3_L4,
+			allowedBy: 1,
+		},
+		{
+			name: "forwarded without policies",
+			flow: &pbFlow.Flow{
+				Verdict:          pbFlow.Verdict_FORWARDED,
+				TrafficDirection: pbFlow.TrafficDirection_EGRESS,
+			},
+			summary:   "Forwarded on egress: no policy is applied to this traffic",
+			matchKind: ui.PolicyMatchKind_NO_POLICY_MATCH,
+		},
+		{
+			name: "processed by proxy",
+			flow: &pbFlow.Flow{
+				Verdict:          pbFlow.Verdict_FORWARDED,
+				TrafficDirection: pbFlow.TrafficDirection_INGRESS,
+				PolicyMatchType:  monitorApi.PolicyMatchL3L4,
+				L7:               &pbFlow.Layer7{},
+			},
+			summary:   "Forwarded on ingress with L7 match",
+			matchKind: ui.PolicyMatchKind_L7,
+		},
+		{
+			name: "dropped by deny policy",
+			flow: &pbFlow.Flow{
+				Verdict:          pbFlow.Verdict_DROPPED,
+				DropReasonDesc:   pbFlow.DropReason_POLICY_DENY,
+				TrafficDirection: pbFlow.TrafficDirection_EGRESS,
+				EgressDeniedBy:   []*pbFlow.Policy{denyAll},
+			},
+			summary:   "Dropped on egress by deny policy shop/deny-all",
+			matchKind: ui.PolicyMatchKind_NO_POLICY_MATCH,
+			deniedBy:  1,
+		},
+		{
+			name: "dropped by default deny",
+			flow: &pbFlow.Flow{
+				Verdict:          pbFlow.Verdict_DROPPED,
+				DropReasonDesc:   pbFlow.DropReason_POLICY_DENIED,
+				TrafficDirection: pbFlow.TrafficDirection_INGRESS,
+			},
+			summary:       "Dropped on ingress: no policy allows this traffic (default deny)",
+			matchKind:     ui.PolicyMatchKind_NO_POLICY_MATCH,
+			isDefaultDeny: true,
+		},
+		{
+			name: "dropped not by policy",
+			flow: &pbFlow.Flow{
+				Verdict:        pbFlow.Verdict_DROPPED,
+				DropReasonDesc: pbFlow.DropReason_CT_MAP_INSERTION_FAILED,
+			},
+			summary:   "Dropped for a reason not related to policies: CT_MAP_INSERTION_FAILED",
+			matchKind: ui.PolicyMatchKind_NO_POLICY_MATCH,
+		},
+		{
+			name: "audited",
+			flow: &pbFlow.Flow{
+				Verdict:          pbFlow.Verdict_AUDIT,
+				TrafficDirection: pbFlow.TrafficDirection_INGRESS,
+			},
+			summary:       "Would be dropped on ingress since no policy allows it, but policy enforcement is in audit mode",
+			matchKind:     ui.PolicyMatchKind_NO_POLICY_MATCH,
+			isDefaultDeny: true,
+		},
+		{
+			name: "unknown direction takes policies of both",
+			flow: &pbFlow.Flow{
+				Verdict:          pbFlow.Verdict_FORWARDED,
+				PolicyMatchType:  monitorApi.PolicyMatchL3Only,
+				IngressAllowedBy: []*pbFlow.Policy{allowApi},
+				EgressAllowedBy:  []*pbFlow.Policy{allowApi},
+			},
+			summary:   "Allowed by policy shop/allow-api, shop/allow-api with L3 match",
+			matchKind: ui.PolicyMatchKind_L3_ONLY,
+			allowedBy: 2,
+		},
+	}
+
+	explainer := NewExplainer(NewResolver(nil, nil))
+
+	for _, c := range cases {
+		expl := explainer.Explain(context.Background(), c.flow)
+
+		if expl.GetSummary() != c.summary {
+			t.Fatalf("%s: unexpected summary: %q", c.name, expl.GetSummary())
+		}
+
+		if expl.GetMatchKind() != c.matchKind {
+			t.Fatalf("%s: unexpected match kind: %v", c.name, expl.GetMatchKind())
+		}
+
+		if len(expl.GetAllowedBy()) != c.allowedBy || len(expl.GetDeniedBy()) != c.deniedBy {
+			t.Fatalf("%s: unexpected policies: %v", c.name, expl)
+		}
+
+		if expl.GetIsDefaultDeny() != c.isDefaultDeny {
+			t.Fatalf("%s: unexpected default deny: %v", c.name, expl.GetIsDefaultDeny())
+		}
+	}
+}
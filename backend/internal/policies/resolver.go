@@ -0,0 +1,170 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	ciliumUtils "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/utils"
+	cilium "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
+	policyApi "github.com/cilium/cilium/pkg/policy/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const (
+	KindCiliumNetworkPolicy            = ciliumUtils.ResourceTypeCiliumNetworkPolicy
+	KindCiliumClusterwideNetworkPolicy = ciliumUtils.ResourceTypeCiliumClusterwideNetworkPolicy
+	KindNetworkPolicy                  = "NetworkPolicy"
+)
+
+// NOTE: Resolver fetches policy objects that are referenced by flows, both
+// clientsets are optional and the references are left unresolved if
+// corresponding clientset is missing
+type Resolver struct {
+	k8s    kubernetes.Interface
+	cilium cilium.Interface
+}
+
+func NewResolver(k8s kubernetes.Interface, ciliumClientset cilium.Interface) *Resolver {
+	return &Resolver{
+		k8s:    k8s,
+		cilium: ciliumClientset,
+	}
+}
+
+func (r *Resolver) Resolve(ctx context.Context, p *pbFlow.Policy) *ui.PolicyReference {
+	ref := referenceFromFlowPolicy(p)
+
+	hasL7, err := r.fetchHasL7Rules(ctx, ref)
+	if err != nil {
+		ref.ResolveError = err.Error()
+		return ref
+	}
+
+	ref.IsResolved = true
+	ref.HasL7Rules = hasL7
+
+	return ref
+}
+
+func (r *Resolver) fetchHasL7Rules(ctx context.Context, ref *ui.PolicyReference) (bool, error) {
+	if len(ref.Name) == 0 {
+		return false, fmt.Errorf("policy name is unknown")
+	}
+
+	switch ref.Kind {
+	case KindCiliumNetworkPolicy:
+		if r.cilium == nil {
+			return false, fmt.Errorf("cilium clientset is not available")
+		}
+
+		cnp, err := r.cilium.CiliumV2().
+			CiliumNetworkPolicies(ref.Namespace).
+			Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		return rulesHaveL7(cnp.Spec, cnp.Specs), nil
+	case KindCiliumClusterwideNetworkPolicy:
+		if r.cilium == nil {
+			return false, fmt.Errorf("cilium clientset is not available")
+		}
+
+		ccnp, err := r.cilium.CiliumV2().
+			CiliumClusterwideNetworkPolicies().
+			Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		return rulesHaveL7(ccnp.Spec, ccnp.Specs), nil
+	case KindNetworkPolicy:
+		if r.k8s == nil {
+			return false, fmt.Errorf("k8s clientset is not available")
+		}
+
+		// NOTE: k8s NetworkPolicy cannot have L7 rules at all
+		_, err := r.k8s.NetworkingV1().
+			NetworkPolicies(ref.Namespace).
+			Get(ctx, ref.Name, metav1.GetOptions{})
+
+		return false, err
+	}
+
+	return false, fmt.Errorf("unsupported policy kind '%s'", ref.Kind)
+}
+
+func referenceFromFlowPolicy(p *pbFlow.Policy) *ui.PolicyReference {
+	// NOTE: Older hubble versions don't fill name/kind of the policy, but
+	// they still can be derived from policy labels
+	derived := ciliumUtils.GetPolicyFromLabels(p.GetLabels(), p.GetRevision())
+
+	ref := &ui.PolicyReference{
+		Name:      p.GetName(),
+		Namespace: p.GetNamespace(),
+		Kind:      p.GetKind(),
+		Revision:  p.GetRevision(),
+	}
+
+	if len(ref.Name) == 0 {
+		ref.Name = derived.GetName()
+	}
+
+	if len(ref.Namespace) == 0 {
+		ref.Namespace = derived.GetNamespace()
+	}
+
+	if len(ref.Kind) == 0 {
+		ref.Kind = derived.GetKind()
+	}
+
+	return ref
+}
+
+func rulesHaveL7(spec *policyApi.Rule, specs policyApi.Rules) bool {
+	if ruleHasL7(spec) {
+		return true
+	}
+
+	for _, rule := range specs {
+		if ruleHasL7(rule) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ruleHasL7(rule *policyApi.Rule) bool {
+	if rule == nil {
+		return false
+	}
+
+	for _, ingress := range rule.Ingress {
+		if portRulesHaveL7(ingress.ToPorts) {
+			return true
+		}
+	}
+
+	for _, egress := range rule.Egress {
+		if portRulesHaveL7(egress.ToPorts) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func portRulesHaveL7(portRules policyApi.PortRules) bool {
+	for _, pr := range portRules {
+		if !pr.Rules.IsEmpty() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package policies
+
+import (
+	"context"
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	policyApi "github.com/cilium/cilium/pkg/policy/api"
+)
+
+func TestResolveWithoutClientsets(t *testing.T) {
+	cases := []struct {
+		name      string
+		policy    *pbFlow.Policy
+		kind      string
+		namespace string
+		err       string
+	}{
+		{
+			name:      "cilium policy",
+			policy:    &pbFlow.Policy{Name: "allow-api", Namespace: "shop", Kind: KindCiliumNetworkPolicy},
+			kind:      KindCiliumNetworkPolicy,
+			namespace: "shop",
+			err:       "cilium clientset is not available",
+		},
+		{
+			name:   "clusterwide policy",
+			policy: &pbFlow.Policy{Name: "allow-dns", Kind: KindCiliumClusterwideNetworkPolicy},
+			kind:   KindCiliumClusterwideNetworkPolicy,
+			err:    "cilium clientset is not available",
+		},
+		{
+			name: "k8s policy derived from labels",
+			policy: &pbFlow.Policy{Labels: []string{
+				"k8s:io.cilium.k8s.policy.derived-from=NetworkPolicy",
+				"k8s:io.cilium.k8s.policy.name=db",
+				"k8s:io.cilium.k8s.policy.namespace=shop",
+			}},
+			kind:      KindNetworkPolicy,
+			namespace: "shop",
+			err:       "k8s clientset is not available",
+		},
+		{
+			name:   "policy without name",
+			policy: &pbFlow.Policy{Kind: KindCiliumNetworkPolicy},
+			kind:   KindCiliumNetworkPolicy,
+			err:    "policy name is unknown",
+		},
+		{
+			name:   "unsupported kind",
+			policy: &pbFlow.Policy{Name: "x", Kind: "AdminNetworkPolicy"},
+			kind:   "AdminNetworkPolicy",
+			err:    "unsupported policy kind 'AdminNetworkPolicy'",
+		},
+	}
+
+	resolver := NewResolver(nil, nil)
+
+	for _, c := range cases {
+		ref := resolver.Resolve(context.Background(), c.policy)
+
+		if ref.GetIsResolved() || ref.GetResolveError() != c.err {
+			t.Fatalf("%s: unexpected resolution: %v", c.name, ref)
+		}
+
+		if ref.GetKind() != c.kind || ref.GetNamespace() != c.namespace {
+			t.Fatalf("%s: unexpected reference: %v", c.name, ref)
+		}
+	}
+}
+
+func TestRulesHaveL7(t *testing.T) {
+	l4Only := &policyApi.Rule{
+		Ingress: []policyApi.IngressRule{{
+			ToPorts: policyApi.PortRules{{
+				Ports: []policyApi.PortProtocol{{Port: "8080", Protocol: policyApi.ProtoTCP}},
+			}},
+		}},
+	}
+
+	withHTTP := &policyApi.Rule{
+		Egress: []policyApi.EgressRule{{
+			ToPorts: policyApi.PortRules{{
+				Ports: []policyApi.PortProtocol{{Port: "80", Protocol: policyApi.ProtoTCP}},
+				Rules: &policyApi.L7Rules{HTTP: []policyApi.PortRuleHTTP{{Method: "GET"}}},
+			}},
+		}},
+	}
+
+	if rulesHaveL7(l4Only, nil) {
+		t.Fatalf("expected rule without L7 section to have no L7 rules")
+	}
+
+	if !rulesHaveL7(nil, policyApi.Rules{l4Only, withHTTP}) {
+		t.Fatalf("expected L7 rules to be found in specs")
+	}
+}
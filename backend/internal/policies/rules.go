@@ -0,0 +1,583 @@
+package policies
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ciliumV2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	policyApi "github.com/cilium/cilium/pkg/policy/api"
+	networkingV1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+)
+
+// NOTE: Rule is a simplified form of policy rule that is enough to decide
+// if a flow between two endpoints is allowed. L7 rules are not evaluated,
+// a flow that matches L3/L4 part of the rule is considered allowed.
+type Rule struct {
+	PolicyName string
+	Kind       string
+
+	// NOTE: Empty namespace means that the rule is clusterwide
+	namespace string
+	subject   *selector
+
+	enforcesIngress bool
+	enforcesEgress  bool
+
+	ingress     []*peerRule
+	egress      []*peerRule
+	ingressDeny []*peerRule
+	egressDeny  []*peerRule
+}
+
+type peerRule struct {
+	anyPeer bool
+	peers   []peerMatcher
+
+	// NOTE: Empty ports match any port
+	ports []portMatcher
+}
+
+// NOTE: Cilium rule without peers matches any peer only if it has ports,
+// completely empty rule matches nothing
+func ciliumPeerRule(peers []peerMatcher, ports []portMatcher) *peerRule {
+	return &peerRule{
+		anyPeer: len(peers) == 0 && len(ports) > 0,
+		peers:   peers,
+		ports:   ports,
+	}
+}
+
+type peerMatcher func(ep *Endpoint) bool
+
+type portMatcher struct {
+	port    uint32
+	endPort uint32
+	proto   string
+}
+
+type typeMeta struct {
+	Kind string `json:"kind"`
+}
+
+// NOTE: Parses multi document YAML with CiliumNetworkPolicy,
+// CiliumClusterwideNetworkPolicy and NetworkPolicy objects, namespace of
+// namespaced policies defaults to `defaultNs`
+func ParseRules(raw string, defaultNs string) ([]*Rule, error) {
+	reader := yamlutil.NewYAMLReader(bufio.NewReader(strings.NewReader(raw)))
+	rules := []*Rule{}
+
+	for docIdx := 0; ; docIdx++ {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", docIdx, err)
+		}
+
+		if len(strings.TrimSpace(string(doc))) == 0 {
+			continue
+		}
+
+		docRules, err := parseDocument(doc, defaultNs)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", docIdx, err)
+		}
+
+		rules = append(rules, docRules...)
+	}
+
+	return rules, nil
+}
+
+func parseDocument(doc []byte, defaultNs string) ([]*Rule, error) {
+	tm := typeMeta{}
+	if err := yaml.Unmarshal(doc, &tm); err != nil {
+		return nil, err
+	}
+
+	switch tm.Kind {
+	case KindCiliumNetworkPolicy:
+		cnp := ciliumV2.CiliumNetworkPolicy{}
+		if err := yaml.UnmarshalStrict(doc, &cnp); err != nil {
+			return nil, err
+		}
+
+		ns := cnp.Namespace
+		if len(ns) == 0 {
+			ns = defaultNs
+		}
+
+		return rulesFromCilium(cnp.Name, tm.Kind, ns, cnp.Spec, cnp.Specs), nil
+	case KindCiliumClusterwideNetworkPolicy:
+		ccnp := ciliumV2.CiliumClusterwideNetworkPolicy{}
+		if err := yaml.UnmarshalStrict(doc, &ccnp); err != nil {
+			return nil, err
+		}
+
+		return rulesFromCilium(ccnp.Name, tm.Kind, "", ccnp.Spec, ccnp.Specs), nil
+	case KindNetworkPolicy:
+		np := networkingV1.NetworkPolicy{}
+		if err := yaml.UnmarshalStrict(doc, &np); err != nil {
+			return nil, err
+		}
+
+		ns := np.Namespace
+		if len(ns) == 0 {
+			ns = defaultNs
+		}
+
+		return []*Rule{ruleFromNetworkPolicy(&np, ns)}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported kind '%s'", tm.Kind)
+}
+
+func rulesFromCilium(
+	name, kind, ns string, spec *policyApi.Rule, specs policyApi.Rules,
+) []*Rule {
+	apiRules := specs
+	if spec != nil {
+		apiRules = append(policyApi.Rules{spec}, specs...)
+	}
+
+	rules := make([]*Rule, 0, len(apiRules))
+	for _, ar := range apiRules {
+		// NOTE: Host policies select nodes, not endpoints seen in flows
+		if ar == nil || ar.NodeSelector.LabelSelector != nil {
+			continue
+		}
+
+		r := &Rule{
+			PolicyName:      name,
+			Kind:            kind,
+			namespace:       ns,
+			subject:         selectorFromSlim(ar.EndpointSelector.LabelSelector),
+			enforcesIngress: ar.Ingress != nil || ar.IngressDeny != nil,
+			enforcesEgress:  ar.Egress != nil || ar.EgressDeny != nil,
+		}
+
+		if dd := ar.EnableDefaultDeny.Ingress; dd != nil && !*dd {
+			r.enforcesIngress = false
+		}
+
+		if dd := ar.EnableDefaultDeny.Egress; dd != nil && !*dd {
+			r.enforcesEgress = false
+		}
+
+		for i := range ar.Ingress {
+			ir := &ar.Ingress[i]
+			r.ingress = append(r.ingress, ciliumPeerRule(
+				ingressPeers(&ir.IngressCommonRule, ns),
+				portsFrom(ir.ToPorts),
+			))
+		}
+
+		for i := range ar.IngressDeny {
+			ir := &ar.IngressDeny[i]
+			r.ingressDeny = append(r.ingressDeny, ciliumPeerRule(
+				ingressPeers(&ir.IngressCommonRule, ns),
+				portsFrom(ir.ToPorts),
+			))
+		}
+
+		for i := range ar.Egress {
+			er := &ar.Egress[i]
+			peers := egressPeers(&er.EgressCommonRule, ns)
+
+			for _, fqdn := range er.ToFQDNs {
+				peers = append(peers, fqdnPeer(fqdn))
+			}
+
+			r.egress = append(r.egress, ciliumPeerRule(peers, portsFrom(er.ToPorts)))
+		}
+
+		for i := range ar.EgressDeny {
+			er := &ar.EgressDeny[i]
+			r.egressDeny = append(r.egressDeny, ciliumPeerRule(
+				egressPeers(&er.EgressCommonRule, ns),
+				portsFrom(er.ToPorts),
+			))
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+func ingressPeers(cr *policyApi.IngressCommonRule, ns string) []peerMatcher {
+	peers := endpointPeers(cr.FromEndpoints, ns)
+	peers = append(peers, entityPeers(cr.FromEntities)...)
+	peers = append(peers, cidrPeers(cr.FromCIDR, cr.FromCIDRSet)...)
+
+	return peers
+}
+
+func egressPeers(cr *policyApi.EgressCommonRule, ns string) []peerMatcher {
+	peers := endpointPeers(cr.ToEndpoints, ns)
+	peers = append(peers, entityPeers(cr.ToEntities)...)
+	peers = append(peers, cidrPeers(cr.ToCIDR, cr.ToCIDRSet)...)
+
+	return peers
+}
+
+func endpointPeers(selectors []policyApi.EndpointSelector, ns string) []peerMatcher {
+	peers := []peerMatcher{}
+
+	for _, es := range selectors {
+		sel := selectorFromSlim(es.LabelSelector)
+
+		// NOTE: Selectors of namespaced policy are limited to policy
+		// namespace unless namespace is set explicitly
+		restrictNs := len(ns) > 0 && !sel.hasKey(podNamespaceKey)
+
+		peers = append(peers, func(ep *Endpoint) bool {
+			if restrictNs && ep.Namespace != ns {
+				return false
+			}
+
+			return sel.matches(ep.labels)
+		})
+	}
+
+	return peers
+}
+
+func entityPeers(entities policyApi.EntitySlice) []peerMatcher {
+	peers := []peerMatcher{}
+
+	for _, entity := range entities {
+		peers = append(peers, func(ep *Endpoint) bool {
+			return entityMatches(entity, ep.props)
+		})
+	}
+
+	return peers
+}
+
+func entityMatches(entity policyApi.Entity, props *labels.LabelProps) bool {
+	switch entity {
+	case policyApi.EntityAll:
+		return true
+	case policyApi.EntityWorld, policyApi.EntityWorldIPv4, policyApi.EntityWorldIPv6:
+		return props.IsWorld
+	case policyApi.EntityCluster:
+		return !props.IsWorld
+	case policyApi.EntityHost:
+		return props.IsHost
+	case policyApi.EntityRemoteNode:
+		return props.IsRemoteNode
+	case policyApi.EntityKubeAPIServer:
+		return props.IsKubeAPIServer
+	case policyApi.EntityHealth:
+		return props.IsHealth
+	case policyApi.EntityInit:
+		return props.IsInit
+	}
+
+	return false
+}
+
+func cidrPeers(cidrs policyApi.CIDRSlice, cidrSet policyApi.CIDRRuleSlice) []peerMatcher {
+	peers := []peerMatcher{}
+
+	for _, cidr := range cidrs {
+		if m := cidrPeer(string(cidr), nil); m != nil {
+			peers = append(peers, m)
+		}
+	}
+
+	for _, cr := range cidrSet {
+		except := make([]string, 0, len(cr.ExceptCIDRs))
+		for _, e := range cr.ExceptCIDRs {
+			except = append(except, string(e))
+		}
+
+		if m := cidrPeer(string(cr.Cidr), except); m != nil {
+			peers = append(peers, m)
+		}
+	}
+
+	return peers
+}
+
+func cidrPeer(cidr string, except []string) peerMatcher {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil
+	}
+
+	excluded := []netip.Prefix{}
+	for _, e := range except {
+		if p, err := netip.ParsePrefix(e); err == nil {
+			excluded = append(excluded, p)
+		}
+	}
+
+	return func(ep *Endpoint) bool {
+		// NOTE: CIDR rules only select endpoints outside of the cluster
+		if !ep.props.IsWorld || !ep.IP.IsValid() || !prefix.Contains(ep.IP) {
+			return false
+		}
+
+		for _, p := range excluded {
+			if p.Contains(ep.IP) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+func fqdnPeer(fqdn policyApi.FQDNSelector) peerMatcher {
+	var re *regexp.Regexp
+	if len(fqdn.MatchPattern) > 0 {
+		re = fqdnPatternRegexp(fqdn.MatchPattern)
+	}
+
+	name := strings.TrimSuffix(strings.ToLower(fqdn.MatchName), ".")
+
+	return func(ep *Endpoint) bool {
+		for _, dnsName := range ep.DNSNames {
+			dnsName = strings.TrimSuffix(strings.ToLower(dnsName), ".")
+
+			if len(name) > 0 && dnsName == name {
+				return true
+			}
+
+			if re != nil && re.MatchString(dnsName) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// NOTE: Wildcard matches any valid DNS characters except dots, standalone
+// wildcard matches every name
+func fqdnPatternRegexp(pattern string) *regexp.Regexp {
+	pattern = strings.TrimSuffix(strings.ToLower(pattern), ".")
+	if pattern == "*" {
+		return regexp.MustCompile(`^.*$`)
+	}
+
+	parts := strings.Split(pattern, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+
+	return regexp.MustCompile("^" + strings.Join(parts, `[-a-z0-9_]*`) + "$")
+}
+
+type portsIterator interface {
+	Iterate(func(pr policyApi.Ports) error) error
+}
+
+func portsFrom(portRules portsIterator) []portMatcher {
+	var ports []portMatcher
+
+	_ = portRules.Iterate(func(pr policyApi.Ports) error {
+		for _, pp := range pr.GetPortProtocols() {
+			ports = append(ports, portMatcherFrom(pp.Port, pp.EndPort, string(pp.Protocol)))
+		}
+
+		return nil
+	})
+
+	return ports
+}
+
+// NOTE: Named ports cannot be resolved from flows, so they match any port
+func portMatcherFrom(port string, endPort int32, proto string) portMatcher {
+	pm := portMatcher{proto: strings.ToUpper(proto)}
+	if len(pm.proto) == 0 {
+		pm.proto = string(policyApi.ProtoAny)
+	}
+
+	if num, err := strconv.ParseUint(port, 10, 16); err == nil {
+		pm.port = uint32(num)
+	}
+
+	if endPort > 0 {
+		pm.endPort = uint32(endPort)
+	}
+
+	return pm
+}
+
+func (pm portMatcher) matches(port uint32, proto string) bool {
+	if pm.proto != string(policyApi.ProtoAny) && pm.proto != proto {
+		return false
+	}
+
+	if pm.port == 0 {
+		return true
+	}
+
+	if pm.endPort > pm.port {
+		return port >= pm.port && port <= pm.endPort
+	}
+
+	return port == pm.port
+}
+
+func ruleFromNetworkPolicy(np *networkingV1.NetworkPolicy, ns string) *Rule {
+	r := &Rule{
+		PolicyName: np.Name,
+		Kind:       KindNetworkPolicy,
+		namespace:  ns,
+		subject:    selectorFromK8s(&np.Spec.PodSelector).withSource(sourceK8s),
+	}
+
+	// NOTE: Ingress is enforced when policyTypes are not set at all
+	if len(np.Spec.PolicyTypes) == 0 {
+		r.enforcesIngress = true
+		r.enforcesEgress = len(np.Spec.Egress) > 0
+	}
+
+	for _, pt := range np.Spec.PolicyTypes {
+		switch pt {
+		case networkingV1.PolicyTypeIngress:
+			r.enforcesIngress = true
+		case networkingV1.PolicyTypeEgress:
+			r.enforcesEgress = true
+		}
+	}
+
+	for _, ir := range np.Spec.Ingress {
+		// NOTE: NetworkPolicy rule without peers allows any peer
+		r.ingress = append(r.ingress, &peerRule{
+			anyPeer: len(ir.From) == 0,
+			peers:   networkPolicyPeers(ir.From, ns),
+			ports:   networkPolicyPorts(ir.Ports),
+		})
+	}
+
+	for _, er := range np.Spec.Egress {
+		r.egress = append(r.egress, &peerRule{
+			anyPeer: len(er.To) == 0,
+			peers:   networkPolicyPeers(er.To, ns),
+			ports:   networkPolicyPorts(er.Ports),
+		})
+	}
+
+	return r
+}
+
+func networkPolicyPeers(npPeers []networkingV1.NetworkPolicyPeer, ns string) []peerMatcher {
+	peers := []peerMatcher{}
+
+	for _, npp := range npPeers {
+		if npp.IPBlock != nil {
+			if m := cidrPeer(npp.IPBlock.CIDR, npp.IPBlock.Except); m != nil {
+				peers = append(peers, m)
+			}
+
+			continue
+		}
+
+		peers = append(peers, networkPolicyPodPeer(npp.PodSelector, npp.NamespaceSelector, ns))
+	}
+
+	return peers
+}
+
+func networkPolicyPodPeer(podSel, nsSel *metav1.LabelSelector, ns string) peerMatcher {
+	pods := selectorFromK8s(podSel).withSource(sourceK8s)
+
+	var namespaces *selector
+	if nsSel != nil {
+		namespaces = selectorFromK8s(nsSel)
+	}
+
+	return func(ep *Endpoint) bool {
+		if len(ep.Namespace) == 0 {
+			return false
+		}
+
+		if namespaces == nil && ep.Namespace != ns {
+			return false
+		}
+
+		if namespaces != nil && !namespaces.matches(ep.namespaceLabels()) {
+			return false
+		}
+
+		return pods.matches(ep.labels)
+	}
+}
+
+func networkPolicyPorts(npPorts []networkingV1.NetworkPolicyPort) []portMatcher {
+	var ports []portMatcher
+
+	for _, npp := range npPorts {
+		proto := string(policyApi.ProtoTCP)
+		if npp.Protocol != nil {
+			proto = string(*npp.Protocol)
+		}
+
+		port := ""
+		if npp.Port != nil && npp.Port.Type == intstr.Int {
+			port = strconv.Itoa(int(npp.Port.IntVal))
+		}
+
+		endPort := int32(0)
+		if npp.EndPort != nil {
+			endPort = *npp.EndPort
+		}
+
+		ports = append(ports, portMatcherFrom(port, endPort, proto))
+	}
+
+	return ports
+}
+
+func (pr *peerRule) matches(peer *Endpoint, port uint32, proto string) bool {
+	peerMatched := pr.anyPeer
+	for _, m := range pr.peers {
+		if m(peer) {
+			peerMatched = true
+			break
+		}
+	}
+
+	if !peerMatched {
+		return false
+	}
+
+	if len(pr.ports) == 0 {
+		return true
+	}
+
+	for _, pm := range pr.ports {
+		if pm.matches(port, proto) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Rule) selects(ep *Endpoint) bool {
+	if len(r.namespace) > 0 && ep.Namespace != r.namespace {
+		return false
+	}
+
+	return r.subject.matches(ep.labels)
+}
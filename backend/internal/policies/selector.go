@@ -0,0 +1,190 @@
+package policies
+
+import (
+	"slices"
+	"strings"
+
+	slimMetav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+)
+
+const (
+	sourceAny = "any"
+	sourceK8s = "k8s"
+
+	podNamespaceKey     = "io.kubernetes.pod.namespace"
+	namespaceLabelsKey  = "io.cilium.k8s.namespace.labels."
+	namespaceNameKey    = "kubernetes.io/metadata.name"
+	selectorOpIn        = "In"
+	selectorOpNotIn     = "NotIn"
+	selectorOpExists    = "Exists"
+	selectorOpNotExists = "DoesNotExist"
+)
+
+var labelSources = []string{
+	sourceAny, sourceK8s, "reserved", "container", "unspec", "cidr", "fqdn",
+	"cilium-generated", "kvstore",
+}
+
+type labelValue struct {
+	source string
+	value  string
+}
+
+// NOTE: Endpoint labels indexed by key without source prefix
+type endpointLabels map[string][]labelValue
+
+func parseEndpointLabels(lbls []string) endpointLabels {
+	el := make(endpointLabels)
+
+	for _, lbl := range lbls {
+		source, key := splitLabelSource(lbl)
+		k, v := labels.LabelAsKeyValue(key, false)
+
+		el[k] = append(el[k], labelValue{source: source, value: v})
+	}
+
+	return el
+}
+
+func (el endpointLabels) lookup(key string) (string, bool) {
+	source, k := splitLabelSource(key)
+
+	for _, lv := range el[k] {
+		if source == sourceAny || source == lv.source {
+			return lv.value, true
+		}
+	}
+
+	return "", false
+}
+
+func splitLabelSource(lbl string) (string, string) {
+	source, rest, found := strings.Cut(lbl, ":")
+	if !found || !slices.Contains(labelSources, source) {
+		return sourceAny, lbl
+	}
+
+	return source, rest
+}
+
+type requirement struct {
+	key      string
+	operator string
+	values   []string
+}
+
+type selector struct {
+	matchLabels map[string]string
+	exprs       []requirement
+}
+
+func selectorFromSlim(ls *slimMetav1.LabelSelector) *selector {
+	if ls == nil {
+		return &selector{}
+	}
+
+	s := &selector{matchLabels: make(map[string]string)}
+	for k, v := range ls.MatchLabels {
+		s.matchLabels[k] = v
+	}
+
+	for _, expr := range ls.MatchExpressions {
+		s.exprs = append(s.exprs, requirement{
+			key:      expr.Key,
+			operator: string(expr.Operator),
+			values:   expr.Values,
+		})
+	}
+
+	return s
+}
+
+func selectorFromK8s(ls *metav1.LabelSelector) *selector {
+	if ls == nil {
+		return &selector{}
+	}
+
+	s := &selector{matchLabels: make(map[string]string)}
+	for k, v := range ls.MatchLabels {
+		s.matchLabels[k] = v
+	}
+
+	for _, expr := range ls.MatchExpressions {
+		s.exprs = append(s.exprs, requirement{
+			key:      expr.Key,
+			operator: string(expr.Operator),
+			values:   expr.Values,
+		})
+	}
+
+	return s
+}
+
+// NOTE: k8s selectors refer only to k8s labels, so the keys get the source
+func (s *selector) withSource(source string) *selector {
+	sourced := &selector{matchLabels: make(map[string]string)}
+	for k, v := range s.matchLabels {
+		sourced.matchLabels[source+":"+k] = v
+	}
+
+	for _, expr := range s.exprs {
+		expr.key = source + ":" + expr.key
+		sourced.exprs = append(sourced.exprs, expr)
+	}
+
+	return sourced
+}
+
+func (s *selector) hasKey(key string) bool {
+	for k := range s.matchLabels {
+		if _, sk := splitLabelSource(k); sk == key {
+			return true
+		}
+	}
+
+	for _, expr := range s.exprs {
+		if _, sk := splitLabelSource(expr.key); sk == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *selector) matches(el endpointLabels) bool {
+	for k, v := range s.matchLabels {
+		if actual, exists := el.lookup(k); !exists || actual != v {
+			return false
+		}
+	}
+
+	for _, expr := range s.exprs {
+		actual, exists := el.lookup(expr.key)
+
+		switch expr.operator {
+		case selectorOpIn:
+			if !exists || !slices.Contains(expr.values, actual) {
+				return false
+			}
+		case selectorOpNotIn:
+			if exists && slices.Contains(expr.values, actual) {
+				return false
+			}
+		case selectorOpExists:
+			if !exists {
+				return false
+			}
+		case selectorOpNotExists:
+			if exists {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
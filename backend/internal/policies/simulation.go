@@ -0,0 +1,283 @@
+package policies
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+	policyApi "github.com/cilium/cilium/pkg/policy/api"
+
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+type Endpoint struct {
+	Namespace string
+	IP        netip.Addr
+	DNSNames  []string
+
+	labels endpointLabels
+	props  *labels.LabelProps
+	raw    []string
+}
+
+func EndpointFromFlow(f *pbFlow.Flow, isSource bool) *Endpoint {
+	ep, ip, names := f.GetDestination(), f.GetIP().GetDestination(), f.GetDestinationNames()
+	if isSource {
+		ep, ip, names = f.GetSource(), f.GetIP().GetSource(), f.GetSourceNames()
+	}
+
+	addr, _ := netip.ParseAddr(ip)
+
+	return &Endpoint{
+		Namespace: ep.GetNamespace(),
+		IP:        addr,
+		DNSNames:  names,
+		labels:    parseEndpointLabels(ep.GetLabels()),
+		props:     labels.Props(ep.GetLabels()),
+		raw:       ep.GetLabels(),
+	}
+}
+
+// NOTE: Namespace labels are propagated by cilium to every endpoint of the
+// namespace with special prefix
+func (ep *Endpoint) namespaceLabels() endpointLabels {
+	nsLabels := make(endpointLabels)
+	nsLabels[namespaceNameKey] = []labelValue{{source: sourceK8s, value: ep.Namespace}}
+
+	for _, lbl := range ep.raw {
+		source, key := splitLabelSource(lbl)
+		if !strings.HasPrefix(key, namespaceLabelsKey) {
+			continue
+		}
+
+		k, v := labels.LabelAsKeyValue(strings.TrimPrefix(key, namespaceLabelsKey), false)
+		nsLabels[k] = append(nsLabels[k], labelValue{source: source, value: v})
+	}
+
+	return nsLabels
+}
+
+type decisionKind int
+
+const (
+	notSelected decisionKind = iota
+	allowed
+	denied
+	defaultDenied
+)
+
+type decision struct {
+	kind     decisionKind
+	policies []string
+}
+
+type SimulationResult struct {
+	Impact           ui.PolicyImpact
+	SimulatedVerdict pbFlow.Verdict
+	Policies         []string
+	Reason           string
+}
+
+// NOTE: Simulator evaluates draft rules on top of the policies that were in
+// effect when flow was observed. Allow rules are additive, so a draft can
+// only drop traffic if it puts previously unselected endpoint into default
+// deny mode or explicitly denies it, and it can only allow traffic that was
+// dropped because nothing allowed it.
+type Simulator struct {
+	rules []*Rule
+}
+
+func NewSimulator(rules []*Rule) *Simulator {
+	return &Simulator{
+		rules: rules,
+	}
+}
+
+func (s *Simulator) Simulate(f *pbFlow.Flow) *SimulationResult {
+	src, dst := EndpointFromFlow(f, true), EndpointFromFlow(f, false)
+	port, proto := portProtocol(f)
+
+	egress := s.decide(src, dst, port, proto, false)
+	ingress := s.decide(dst, src, port, proto, true)
+
+	result := &SimulationResult{
+		Impact:           ui.PolicyImpact_UNCHANGED_IMPACT,
+		SimulatedVerdict: f.GetVerdict(),
+	}
+
+	switch {
+	case isForwarded(f.GetVerdict()):
+		s.simulateForwarded(f, egress, ingress, result)
+	case f.GetVerdict() == pbFlow.Verdict_AUDIT || isDroppedByPolicy(f):
+		s.simulateDropped(f, egress, ingress, result)
+	}
+
+	return result
+}
+
+func (s *Simulator) simulateForwarded(
+	f *pbFlow.Flow, egress, ingress *decision, result *SimulationResult,
+) {
+	dirs := []struct {
+		name            string
+		d               *decision
+		isAllowedBefore bool
+	}{
+		{"egress", egress, isAllowedBefore(f, pbFlow.TrafficDirection_EGRESS)},
+		{"ingress", ingress, isAllowedBefore(f, pbFlow.TrafficDirection_INGRESS)},
+	}
+
+	for _, dir := range dirs {
+		switch {
+		case dir.d.kind == denied:
+			result.Reason = fmt.Sprintf("denied on %s", dir.name)
+		case dir.d.kind == defaultDenied && !dir.isAllowedBefore:
+			result.Reason = fmt.Sprintf(
+				"endpoint becomes subject to default deny on %s and no rule allows this traffic",
+				dir.name,
+			)
+		default:
+			continue
+		}
+
+		result.Impact = ui.PolicyImpact_WOULD_BE_DROPPED
+		result.SimulatedVerdict = pbFlow.Verdict_DROPPED
+		result.Policies = dir.d.policies
+		return
+	}
+}
+
+func (s *Simulator) simulateDropped(
+	f *pbFlow.Flow, egress, ingress *decision, result *SimulationResult,
+) {
+	// NOTE: Explicit deny always wins over any allow rule
+	if f.GetDropReasonDesc() == pbFlow.DropReason_POLICY_DENY {
+		return
+	}
+
+	if egress.kind == denied || ingress.kind == denied {
+		return
+	}
+
+	var dropped, other *decision
+	switch f.GetTrafficDirection() {
+	case pbFlow.TrafficDirection_EGRESS:
+		dropped, other = egress, ingress
+	case pbFlow.TrafficDirection_INGRESS:
+		dropped, other = ingress, egress
+	default:
+		if egress.kind == allowed {
+			dropped, other = egress, ingress
+		} else {
+			dropped, other = ingress, egress
+		}
+	}
+
+	if dropped.kind != allowed || other.kind == defaultDenied {
+		return
+	}
+
+	result.Impact = ui.PolicyImpact_WOULD_BE_ALLOWED
+	result.SimulatedVerdict = pbFlow.Verdict_FORWARDED
+	result.Policies = dropped.policies
+	result.Reason = "allowed by draft rules"
+}
+
+func (s *Simulator) decide(
+	subject, peer *Endpoint, port uint32, proto string, isIngress bool,
+) *decision {
+	d := &decision{kind: notSelected}
+	allowedBy, enforcedBy := []string{}, []string{}
+
+	for _, r := range s.rules {
+		if !r.selects(subject) {
+			continue
+		}
+
+		allowRules, denyRules, enforces := r.egress, r.egressDeny, r.enforcesEgress
+		if isIngress {
+			allowRules, denyRules, enforces = r.ingress, r.ingressDeny, r.enforcesIngress
+		}
+
+		for _, pr := range denyRules {
+			if pr.matches(peer, port, proto) {
+				return &decision{kind: denied, policies: []string{r.PolicyName}}
+			}
+		}
+
+		if enforces {
+			enforcedBy = appendUnique(enforcedBy, r.PolicyName)
+		}
+
+		for _, pr := range allowRules {
+			if pr.matches(peer, port, proto) {
+				allowedBy = appendUnique(allowedBy, r.PolicyName)
+				break
+			}
+		}
+	}
+
+	switch {
+	case len(allowedBy) > 0:
+		d.kind, d.policies = allowed, allowedBy
+	case len(enforcedBy) > 0:
+		d.kind, d.policies = defaultDenied, enforcedBy
+	}
+
+	return d
+}
+
+func isAllowedBefore(f *pbFlow.Flow, dir pbFlow.TrafficDirection) bool {
+	allowedBy := f.GetEgressAllowedBy()
+	if dir == pbFlow.TrafficDirection_INGRESS {
+		allowedBy = f.GetIngressAllowedBy()
+	}
+
+	if len(allowedBy) > 0 {
+		return true
+	}
+
+	return f.GetTrafficDirection() == dir &&
+		MatchKindFromFlow(f) != ui.PolicyMatchKind_NO_POLICY_MATCH
+}
+
+func isForwarded(v pbFlow.Verdict) bool {
+	switch v {
+	case pbFlow.Verdict_FORWARDED, pbFlow.Verdict_REDIRECTED,
+		pbFlow.Verdict_TRACED, pbFlow.Verdict_TRANSLATED:
+		return true
+	}
+
+	return false
+}
+
+func portProtocol(f *pbFlow.Flow) (uint32, string) {
+	l4 := f.GetL4()
+
+	switch {
+	case l4.GetTCP() != nil:
+		return l4.GetTCP().GetDestinationPort(), string(policyApi.ProtoTCP)
+	case l4.GetUDP() != nil:
+		return l4.GetUDP().GetDestinationPort(), string(policyApi.ProtoUDP)
+	case l4.GetSCTP() != nil:
+		return l4.GetSCTP().GetDestinationPort(), string(policyApi.ProtoSCTP)
+	case l4.GetICMPv4() != nil:
+		return 0, string(policyApi.ProtoICMP)
+	case l4.GetICMPv6() != nil:
+		return 0, string(policyApi.ProtoICMPv6)
+	}
+
+	return 0, ""
+}
+
+func appendUnique(names []string, name string) []string {
+	for _, n := range names {
+		if n == name {
+			return names
+		}
+	}
+
+	return append(names, name)
+}
@@ -0,0 +1,127 @@
+package policies
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const draftYAML = `
+apiVersion: cilium.io/v2
+kind: CiliumNetworkPolicy
+metadata:
+  name: backend
+spec:
+  endpointSelector:
+    matchLabels:
+      app: backend
+  ingress:
+  - fromEndpoints:
+    - matchLabels:
+        app: frontend
+    toPorts:
+    - ports:
+      - port: "8080"
+        protocol: TCP
+---
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: db
+spec:
+  podSelector:
+    matchLabels:
+      app: db
+  ingress:
+  - from:
+    - podSelector:
+        matchLabels:
+          app: backend
+`
+
+func shopEndpoint(app string) *pbFlow.Endpoint {
+	return &pbFlow.Endpoint{
+		Namespace: "shop",
+		Labels: []string{
+			"k8s:app=" + app,
+			"k8s:io.kubernetes.pod.namespace=shop",
+		},
+	}
+}
+
+func simulatedFlow(src, dst string, port uint32, verdict pbFlow.Verdict) *pbFlow.Flow {
+	f := tcpFlow(shopEndpoint(src), shopEndpoint(dst), port, nil)
+	f.Verdict = verdict
+	f.TrafficDirection = pbFlow.TrafficDirection_INGRESS
+
+	if verdict == pbFlow.Verdict_DROPPED {
+		f.DropReasonDesc = pbFlow.DropReason_POLICY_DENIED
+	}
+
+	return f
+}
+
+func TestSimulation(t *testing.T) {
+	rules, err := ParseRules(draftYAML, "shop")
+	if err != nil {
+		t.Fatalf("failed to parse rules: %v", err)
+	}
+
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+
+	sim := NewSimulator(rules)
+	cases := []struct {
+		name   string
+		flow   *pbFlow.Flow
+		impact ui.PolicyImpact
+	}{
+		{
+			"allowed port",
+			simulatedFlow("frontend", "backend", 8080, pbFlow.Verdict_FORWARDED),
+			ui.PolicyImpact_UNCHANGED_IMPACT,
+		},
+		{
+			"not allowed port",
+			simulatedFlow("frontend", "backend", 9090, pbFlow.Verdict_FORWARDED),
+			ui.PolicyImpact_WOULD_BE_DROPPED,
+		},
+		{
+			"not allowed peer",
+			simulatedFlow("admin", "db", 5432, pbFlow.Verdict_FORWARDED),
+			ui.PolicyImpact_WOULD_BE_DROPPED,
+		},
+		{
+			"allowed after being dropped",
+			simulatedFlow("backend", "db", 5432, pbFlow.Verdict_DROPPED),
+			ui.PolicyImpact_WOULD_BE_ALLOWED,
+		},
+		{
+			"not selected",
+			simulatedFlow("frontend", "cache", 6379, pbFlow.Verdict_FORWARDED),
+			ui.PolicyImpact_UNCHANGED_IMPACT,
+		},
+	}
+
+	for _, c := range cases {
+		result := sim.Simulate(c.flow)
+		if result.Impact != c.impact {
+			t.Errorf("%s: expected %v, got %v (%s)", c.name, c.impact, result.Impact, result.Reason)
+		}
+	}
+}
+
+func TestParseRulesErrors(t *testing.T) {
+	_, err := ParseRules("kind: Deployment\n", "shop")
+	if err == nil {
+		t.Fatalf("unsupported kind must be an error")
+	}
+
+	_, err = ParseRules("kind: CiliumNetworkPolicy\nspec:\n  unknownField: 1\n", "shop")
+	if err == nil {
+		t.Fatalf("unknown field must be an error")
+	}
+}
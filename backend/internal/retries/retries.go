@@ -2,6 +2,7 @@ package retries
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	cilium_backoff "github.com/cilium/cilium/pkg/backoff"
@@ -19,6 +20,7 @@ func New() *Retries {
 		Max:    7.0 * time.Second,
 		Factor: 1.6,
 		Jitter: true,
+		Logger: slog.Default(),
 	}
 
 	return &Retries{cr}
@@ -30,6 +32,7 @@ func NewFromGRPC(grpcBackoff *backoff.Config) *Retries {
 		Max:    grpcBackoff.MaxDelay,
 		Factor: grpcBackoff.Multiplier,
 		Jitter: grpcBackoff.Jitter > 1e-6,
+		Logger: slog.Default(),
 	}
 
 	return &Retries{cr}
@@ -44,6 +47,7 @@ func (r *Retries) Clone() *Retries {
 			Jitter:      r.ciliumRetries.Jitter,
 			NodeManager: nil,
 			Name:        "",
+			Logger:      r.ciliumRetries.Logger,
 		},
 	}
 }
@@ -0,0 +1,258 @@
+package saved_views
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const (
+	IdLength = 8
+
+	idAlphabet    = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	maxIdAttempts = 16
+)
+
+var (
+	ErrNotFound = errors.New("saved view not found")
+	ErrNoName   = errors.New("saved view name is empty")
+)
+
+// NOTE: Views are kept in memory and the whole set is written to the file
+// on every change, there are only a few of them, so no real DB is needed.
+// Empty path makes the store memory only.
+type Store struct {
+	mx sync.RWMutex
+
+	path  string
+	views map[string]*ui.SavedView
+	now   func() time.Time
+}
+
+func New(path string) (*Store, error) {
+	s := &Store{
+		path:  path,
+		views: make(map[string]*ui.SavedView),
+		now:   time.Now,
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Store) Create(v *ui.SavedView) (*ui.SavedView, error) {
+	if len(strings.TrimSpace(v.GetName())) == 0 {
+		return nil, ErrNoName
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	id, err := s.generateId()
+	if err != nil {
+		return nil, err
+	}
+
+	created := proto.Clone(v).(*ui.SavedView)
+	created.Id = id
+	created.CreatedAt = timestamppb.New(s.now())
+	created.UpdatedAt = created.CreatedAt
+
+	s.views[id] = created
+	if err := s.persist(); err != nil {
+		delete(s.views, id)
+		return nil, err
+	}
+
+	return proto.Clone(created).(*ui.SavedView), nil
+}
+
+func (s *Store) Get(id string) (*ui.SavedView, error) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	v, exists := s.views[id]
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	return proto.Clone(v).(*ui.SavedView), nil
+}
+
+// NOTE: Views are sorted by name, the most recently created goes first
+// among the ones with the same name
+func (s *Store) List() []*ui.SavedView {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	views := make([]*ui.SavedView, 0, len(s.views))
+	for _, v := range s.views {
+		views = append(views, proto.Clone(v).(*ui.SavedView))
+	}
+
+	slices.SortFunc(views, func(a, b *ui.SavedView) int {
+		if c := strings.Compare(a.GetName(), b.GetName()); c != 0 {
+			return c
+		}
+
+		return b.GetCreatedAt().AsTime().Compare(a.GetCreatedAt().AsTime())
+	})
+
+	return views
+}
+
+func (s *Store) Update(v *ui.SavedView) (*ui.SavedView, error) {
+	if len(strings.TrimSpace(v.GetName())) == 0 {
+		return nil, ErrNoName
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	existing, exists := s.views[v.GetId()]
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	updated := proto.Clone(v).(*ui.SavedView)
+	updated.CreatedAt = existing.GetCreatedAt()
+	updated.UpdatedAt = timestamppb.New(s.now())
+
+	s.views[v.GetId()] = updated
+	if err := s.persist(); err != nil {
+		s.views[v.GetId()] = existing
+		return nil, err
+	}
+
+	return proto.Clone(updated).(*ui.SavedView), nil
+}
+
+func (s *Store) Delete(id string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	existing, exists := s.views[id]
+	if !exists {
+		return ErrNotFound
+	}
+
+	delete(s.views, id)
+	if err := s.persist(); err != nil {
+		s.views[id] = existing
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) load() error {
+	if len(s.path) == 0 {
+		return nil
+	}
+
+	raw, err := os.ReadFile(s.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to read saved views from '%s': %w", s.path, err)
+	}
+
+	stored := new(ui.SavedViews)
+	if err := protojson.Unmarshal(raw, stored); err != nil {
+		return fmt.Errorf("failed to parse saved views from '%s': %w", s.path, err)
+	}
+
+	for _, v := range stored.GetViews() {
+		s.views[v.GetId()] = v
+	}
+
+	return nil
+}
+
+// NOTE: File is replaced atomically, so that crash while writing doesn't
+// leave broken views behind
+func (s *Store) persist() error {
+	if len(s.path) == 0 {
+		return nil
+	}
+
+	stored := &ui.SavedViews{
+		Views: make([]*ui.SavedView, 0, len(s.views)),
+	}
+
+	for _, v := range s.views {
+		stored.Views = append(stored.Views, v)
+	}
+
+	slices.SortFunc(stored.Views, func(a, b *ui.SavedView) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+
+	raw, err := protojson.MarshalOptions{Multiline: true}.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to serialize saved views: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file for saved views: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write saved views: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write saved views: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace saved views file '%s': %w", s.path, err)
+	}
+
+	return nil
+}
+
+// NOTE: Ambiguous characters like 0/O and 1/l are not used, so that the id
+// can be dictated or typed from a screenshot
+func (s *Store) generateId() (string, error) {
+	alphabetLen := big.NewInt(int64(len(idAlphabet)))
+
+	for range maxIdAttempts {
+		b := make([]byte, IdLength)
+		for i := range b {
+			n, err := rand.Int(rand.Reader, alphabetLen)
+			if err != nil {
+				return "", fmt.Errorf("failed to generate saved view id: %w", err)
+			}
+
+			b[i] = idAlphabet[n.Int64()]
+		}
+
+		if _, exists := s.views[string(b)]; !exists {
+			return string(b), nil
+		}
+	}
+
+	return "", errors.New("failed to generate unique saved view id")
+}
@@ -0,0 +1,68 @@
+package saved_views
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+func TestStorePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views.json")
+
+	store, err := New(path)
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	created, err := store.Create(&ui.SavedView{
+		Name:             "dropped dns",
+		Namespace:        "kube-system",
+		FilterExpression: "verdict=DROPPED and port=53",
+		EventTypes:       []ui.EventType{ui.EventType_FLOW},
+	})
+
+	if err != nil {
+		t.Fatalf("failed to create view: %v", err)
+	}
+
+	if len(created.GetId()) != IdLength || created.GetCreatedAt() == nil {
+		t.Fatalf("unexpected created view: %v", created)
+	}
+
+	created.Name = "dropped dns queries"
+	if _, err := store.Update(created); err != nil {
+		t.Fatalf("failed to update view: %v", err)
+	}
+
+	reopened, err := New(path)
+	if err != nil {
+		t.Fatalf("failed to reopen store: %v", err)
+	}
+
+	view, err := reopened.Get(created.GetId())
+	if err != nil {
+		t.Fatalf("view is not found after reopening: %v", err)
+	}
+
+	if view.GetName() != "dropped dns queries" || view.GetNamespace() != "kube-system" {
+		t.Fatalf("unexpected view after reopening: %v", view)
+	}
+
+	if err := reopened.Delete(created.GetId()); err != nil {
+		t.Fatalf("failed to delete view: %v", err)
+	}
+
+	if _, err := reopened.Get(created.GetId()); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	if _, err := reopened.Update(created); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound on update, got %v", err)
+	}
+
+	if _, err := reopened.Create(&ui.SavedView{}); !errors.Is(err, ErrNoName) {
+		t.Fatalf("expected ErrNoName, got %v", err)
+	}
+}
@@ -0,0 +1,352 @@
+package service_details
+
+import (
+	"slices"
+	"strings"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+
+	"github.com/cilium/hubble-ui/backend/domain/cache"
+	"github.com/cilium/hubble-ui/backend/domain/flow"
+	"github.com/cilium/hubble-ui/backend/domain/l7_summary"
+	"github.com/cilium/hubble-ui/backend/domain/link"
+	"github.com/cilium/hubble-ui/backend/domain/service"
+	"github.com/cilium/hubble-ui/backend/proto/ui"
+)
+
+const (
+	DefaultFlowsLimit = 20
+
+	protocolDNS   = "dns"
+	protocolKafka = "kafka"
+)
+
+type portKey struct {
+	port  uint32
+	proto ui.IPProtocol
+}
+
+type endpointKey struct {
+	protocol string
+	method   string
+	path     string
+}
+
+// NOTE: Details are computed from the given flows in the same way the map
+// is built, nil is returned if there is no such service among them.
+// readyBackends is nil when k8s Services are not known.
+func Build(
+	svcId string,
+	flows []*pbFlow.Flow,
+	flowsLimit int,
+	readyBackends service.ReadyBackendsCounter,
+) *ui.ServiceDetailsResponse {
+	if flowsLimit <= 0 {
+		flowsLimit = DefaultFlowsLimit
+	}
+
+	// NOTE: Services of all the flows are cached, so that ids of relabeled
+	// workloads are merged into the same cards as on the map
+	wflows := flow.WrapWithReadyBackends(flows, readyBackends)
+	dcache := cache.New()
+	dcache.UpsertServicesFromFlows(wflows)
+	svcId = dcache.CardId(svcId)
+
+	related := make([]*flow.Flow, 0)
+	for _, f := range wflows {
+		srcId, dstId := f.ServiceIds()
+		if dcache.CardId(srcId) == svcId || dcache.CardId(dstId) == svcId {
+			related = append(related, f)
+		}
+	}
+
+	dcache.UpsertLinksFromFlows(related)
+
+	svcs := make(map[string]*ui.Service)
+	dcache.ForEachService(func(_ string, svc *service.Service) {
+		svcs[svc.Id()] = svc.ToProto()
+	})
+
+	target, exists := svcs[svcId]
+	if !exists {
+		return nil
+	}
+
+	resp := &ui.ServiceDetailsResponse{
+		Service:     target,
+		FlowsNumber: uint32(len(related)),
+	}
+
+	inbound := make(map[string]*ui.ServicePeer)
+	outbound := make(map[string]*ui.ServicePeer)
+	ports := make(map[portKey]*ui.ServicePort)
+
+	dcache.ForEachLink(func(_ string, l *link.Link) {
+		if l.DestinationId == svcId {
+			addPeerLink(inbound, svcs[l.SourceId], l)
+
+			key := portKey{port: l.DestinationPort, proto: l.IPProtocol}
+			port, exists := ports[key]
+			if !exists {
+				port = &ui.ServicePort{Port: l.DestinationPort, Protocol: l.IPProtocol}
+				ports[key] = port
+			}
+
+			port.FlowAmount += l.FlowAmount
+		}
+
+		if l.SourceId == svcId {
+			addPeerLink(outbound, svcs[l.DestinationId], l)
+		}
+	})
+
+	resp.Inbound = sortedPeers(inbound)
+	resp.Outbound = sortedPeers(outbound)
+	resp.Ports = sortedPorts(ports)
+	resp.Workloads, resp.Pods = workloadsAndPods(svcId, related, dcache.CardId)
+	resp.L7Endpoints = l7Endpoints(svcId, related, dcache.CardId)
+	resp.HttpEndpoints = httpEndpoints(svcId, related, dcache.CardId)
+	resp.RecentFlows = recentFlows(flow.Unwrap(related), flowsLimit)
+
+	return resp
+}
+
+func addPeerLink(peers map[string]*ui.ServicePeer, svc *ui.Service, l *link.Link) {
+	if svc == nil {
+		return
+	}
+
+	peer, exists := peers[svc.Id]
+	if !exists {
+		peer = &ui.ServicePeer{Service: svc}
+		peers[svc.Id] = peer
+	}
+
+	peer.Links = append(peer.Links, l.ToProto())
+}
+
+// NOTE: The busiest peers go first
+func sortedPeers(peers map[string]*ui.ServicePeer) []*ui.ServicePeer {
+	sorted := make([]*ui.ServicePeer, 0, len(peers))
+	for _, peer := range peers {
+		slices.SortFunc(peer.Links, func(a, b *ui.ServiceLink) int {
+			if a.GetDestinationPort() != b.GetDestinationPort() {
+				return int(a.GetDestinationPort()) - int(b.GetDestinationPort())
+			}
+
+			return int(a.GetIpProtocol()) - int(b.GetIpProtocol())
+		})
+
+		sorted = append(sorted, peer)
+	}
+
+	slices.SortFunc(sorted, func(a, b *ui.ServicePeer) int {
+		if lhs, rhs := flowAmount(a), flowAmount(b); lhs != rhs {
+			return compareDesc(lhs, rhs)
+		}
+
+		return strings.Compare(a.GetService().GetName(), b.GetService().GetName())
+	})
+
+	return sorted
+}
+
+func flowAmount(peer *ui.ServicePeer) uint64 {
+	total := uint64(0)
+	for _, l := range peer.GetLinks() {
+		total += l.GetFlowAmount()
+	}
+
+	return total
+}
+
+func sortedPorts(ports map[portKey]*ui.ServicePort) []*ui.ServicePort {
+	sorted := make([]*ui.ServicePort, 0, len(ports))
+	for _, port := range ports {
+		sorted = append(sorted, port)
+	}
+
+	slices.SortFunc(sorted, func(a, b *ui.ServicePort) int {
+		if a.GetPort() != b.GetPort() {
+			return int(a.GetPort()) - int(b.GetPort())
+		}
+
+		return int(a.GetProtocol()) - int(b.GetProtocol())
+	})
+
+	return sorted
+}
+
+func workloadsAndPods(
+	svcId string, flows []*flow.Flow, cardId func(string) string,
+) ([]*pbFlow.Workload, []string) {
+	workloads := []*pbFlow.Workload{}
+	seenWorkloads := make(map[string]struct{})
+	pods := []string{}
+	seenPods := make(map[string]struct{})
+
+	for _, f := range flows {
+		srcId, dstId := f.ServiceIds()
+
+		eps := []*pbFlow.Endpoint{}
+		if cardId(srcId) == svcId {
+			eps = append(eps, f.Ref().GetSource())
+		}
+
+		if cardId(dstId) == svcId {
+			eps = append(eps, f.Ref().GetDestination())
+		}
+
+		for _, ep := range eps {
+			for _, w := range ep.GetWorkloads() {
+				key := w.GetKind() + "/" + w.GetName()
+				if _, seen := seenWorkloads[key]; !seen {
+					seenWorkloads[key] = struct{}{}
+					workloads = append(workloads, w)
+				}
+			}
+
+			if len(ep.GetPodName()) == 0 {
+				continue
+			}
+
+			pod := ep.GetNamespace() + "/" + ep.GetPodName()
+			if _, seen := seenPods[pod]; !seen {
+				seenPods[pod] = struct{}{}
+				pods = append(pods, pod)
+			}
+		}
+	}
+
+	slices.SortFunc(workloads, func(a, b *pbFlow.Workload) int {
+		if c := strings.Compare(a.GetKind(), b.GetKind()); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	slices.Sort(pods)
+	return workloads, pods
+}
+
+// NOTE: HTTP endpoints are templated and counted by the same aggregator as
+// L7 summaries of the links, so the numbers on both are the same
+func httpEndpoints(
+	svcId string, flows []*flow.Flow, cardId func(string) string,
+) []*ui.HttpEndpointStats {
+	l7 := l7_summary.NewAggregator()
+	l7.ObserveFlows(flows, cardId)
+
+	return l7.ServedEndpoints(svcId)
+}
+
+// NOTE: Only DNS and Kafka endpoints served by the service are collected,
+// i.e. requests sent to it and responses sent by it
+func l7Endpoints(
+	svcId string, flows []*flow.Flow, cardId func(string) string,
+) []*ui.L7Endpoint {
+	endpoints := make(map[endpointKey]*ui.L7Endpoint)
+
+	for _, f := range flows {
+		l7 := f.Ref().GetL7()
+		if l7 == nil {
+			continue
+		}
+
+		srcId, dstId := f.ServiceIds()
+		isRequest := l7.GetType() != pbFlow.L7FlowType_RESPONSE
+		if (isRequest && cardId(dstId) != svcId) || (!isRequest && cardId(srcId) != svcId) {
+			continue
+		}
+
+		key, isError, ok := endpointFromL7(l7)
+		if !ok {
+			continue
+		}
+
+		ep, exists := endpoints[key]
+		if !exists {
+			ep = &ui.L7Endpoint{
+				Protocol: key.protocol,
+				Method:   key.method,
+				Path:     key.path,
+			}
+
+			endpoints[key] = ep
+		}
+
+		if isRequest {
+			ep.Requests += 1
+		} else if isError {
+			ep.Errors += 1
+		}
+	}
+
+	sorted := make([]*ui.L7Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		sorted = append(sorted, ep)
+	}
+
+	slices.SortFunc(sorted, func(a, b *ui.L7Endpoint) int {
+		if a.GetRequests() != b.GetRequests() {
+			return compareDesc(a.GetRequests(), b.GetRequests())
+		}
+
+		for _, c := range []int{
+			strings.Compare(a.GetProtocol(), b.GetProtocol()),
+			strings.Compare(a.GetPath(), b.GetPath()),
+		} {
+			if c != 0 {
+				return c
+			}
+		}
+
+		return strings.Compare(a.GetMethod(), b.GetMethod())
+	})
+
+	return sorted
+}
+
+func endpointFromL7(l7 *pbFlow.Layer7) (endpointKey, bool, bool) {
+	switch {
+	case l7.GetDns() != nil:
+		dns := l7.GetDns()
+
+		return endpointKey{
+			protocol: protocolDNS,
+			method:   strings.Join(dns.GetQtypes(), ","),
+			path:     dns.GetQuery(),
+		}, dns.GetRcode() != 0, true
+	case l7.GetKafka() != nil:
+		kafka := l7.GetKafka()
+
+		return endpointKey{
+			protocol: protocolKafka,
+			method:   kafka.GetApiKey(),
+			path:     kafka.GetTopic(),
+		}, kafka.GetErrorCode() != 0, true
+	}
+
+	return endpointKey{}, false, false
+}
+
+func recentFlows(flows []*pbFlow.Flow, limit int) []*pbFlow.Flow {
+	recent := make([]*pbFlow.Flow, 0, min(limit, len(flows)))
+	for i := len(flows) - 1; i >= 0 && len(recent) < limit; i-- {
+		recent = append(recent, flows[i])
+	}
+
+	return recent
+}
+
+func compareDesc(lhs, rhs uint64) int {
+	switch {
+	case lhs > rhs:
+		return -1
+	case lhs < rhs:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,139 @@
+package service_details
+
+import (
+	"testing"
+
+	pbFlow "github.com/cilium/cilium/api/v1/flow"
+)
+
+func httpFlow(
+	src, dst *pbFlow.Endpoint, typ pbFlow.L7FlowType, method, url string, code uint32,
+) *pbFlow.Flow {
+	return &pbFlow.Flow{
+		Source:      src,
+		Destination: dst,
+		Verdict:     pbFlow.Verdict_FORWARDED,
+		L4: &pbFlow.Layer4{
+			Protocol: &pbFlow.Layer4_TCP{
+				TCP: &pbFlow.TCP{DestinationPort: 8080},
+			},
+		},
+		L7: &pbFlow.Layer7{
+			Type: typ,
+			Record: &pbFlow.Layer7_Http{
+				Http: &pbFlow.HTTP{Method: method, Url: url, Code: code},
+			},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	frontend := &pbFlow.Endpoint{
+		Identity:  1001,
+		Namespace: "shop",
+		PodName:   "frontend-1",
+		Labels:    []string{"k8s:app=frontend"},
+	}
+
+	backend := &pbFlow.Endpoint{
+		Identity:  1002,
+		Namespace: "shop",
+		PodName:   "backend-1",
+		Labels:    []string{"k8s:app=backend"},
+		Workloads: []*pbFlow.Workload{{Kind: "Deployment", Name: "backend"}},
+	}
+
+	flows := []*pbFlow.Flow{
+		httpFlow(frontend, backend, pbFlow.L7FlowType_REQUEST, "GET", "http://backend/items?id=1", 0),
+		httpFlow(backend, frontend, pbFlow.L7FlowType_RESPONSE, "GET", "http://backend/items?id=1", 500),
+		httpFlow(frontend, backend, pbFlow.L7FlowType_REQUEST, "GET", "http://backend/items?id=2", 0),
+		httpFlow(frontend, backend, pbFlow.L7FlowType_REQUEST, "GET", "http://backend/items/42", 0),
+	}
+
+	if Build("404", flows, 0, nil) != nil {
+		t.Fatalf("expected no details for unknown service")
+	}
+
+	details := Build("1002", flows, 2, nil)
+	if details == nil {
+		t.Fatalf("expected details for backend service")
+	}
+
+	if len(details.GetInbound()) != 1 || details.GetInbound()[0].GetService().GetId() != "1001" {
+		t.Fatalf("unexpected inbound peers: %v", details.GetInbound())
+	}
+
+	if len(details.GetPorts()) != 1 || details.GetPorts()[0].GetPort() != 8080 {
+		t.Fatalf("unexpected ports: %v", details.GetPorts())
+	}
+
+	if len(details.GetWorkloads()) != 1 || details.GetWorkloads()[0].GetName() != "backend" {
+		t.Fatalf("unexpected workloads: %v", details.GetWorkloads())
+	}
+
+	if len(details.GetPods()) != 1 || details.GetPods()[0] != "shop/backend-1" {
+		t.Fatalf("unexpected pods: %v", details.GetPods())
+	}
+
+	if len(details.GetL7Endpoints()) != 0 {
+		t.Fatalf("expected HTTP endpoints to be reported separately: %v", details.GetL7Endpoints())
+	}
+
+	eps := details.GetHttpEndpoints()
+	if len(eps) != 2 || eps[0].GetPath() != "/items" || eps[1].GetPath() != "/items/{id}" {
+		t.Fatalf("unexpected http endpoints: %v", eps)
+	}
+
+	classes := eps[0].GetStatusClasses()
+	if eps[0].GetRequests() != 2 || len(classes) != 1 || classes[0].GetStatusClass() != "5xx" {
+		t.Fatalf("expected 2 requests and one 5xx response, got %v", eps[0])
+	}
+
+	if len(details.GetRecentFlows()) != 2 || details.GetRecentFlows()[0] != flows[3] {
+		t.Fatalf("expected 2 most recent flows, newest first")
+	}
+}
+
+func TestBuildOfRelabeledWorkload(t *testing.T) {
+	frontend := &pbFlow.Endpoint{
+		Identity:  1001,
+		Namespace: "shop",
+		Labels:    []string{"k8s:app=frontend"},
+	}
+
+	backend := func(identity uint32, pod string, labels ...string) *pbFlow.Endpoint {
+		return &pbFlow.Endpoint{
+			Identity:  identity,
+			Namespace: "shop",
+			PodName:   pod,
+			Labels:    append([]string{"k8s:app=backend"}, labels...),
+			Workloads: []*pbFlow.Workload{{Kind: "Deployment", Name: "backend"}},
+		}
+	}
+
+	flows := []*pbFlow.Flow{
+		httpFlow(frontend, backend(1002, "backend-1"), pbFlow.L7FlowType_REQUEST, "GET", "/items", 0),
+		httpFlow(frontend, backend(1003, "backend-2", "k8s:version=v2"), pbFlow.L7FlowType_REQUEST, "GET", "/items", 0),
+	}
+
+	// NOTE: Both the card id and the id merged into it lead to the card
+	for _, id := range []string{"1002", "1003"} {
+		details := Build(id, flows, 0, nil)
+		if details == nil || details.GetService().GetId() != "1002" {
+			t.Fatalf("expected details of card 1002 for %s, got %v", id, details)
+		}
+
+		if details.GetFlowsNumber() != 2 || len(details.GetPods()) != 2 {
+			t.Fatalf("expected flows of both identities, got %v", details)
+		}
+
+		eps := details.GetHttpEndpoints()
+		if len(eps) != 1 || eps[0].GetRequests() != 2 {
+			t.Fatalf("unexpected http endpoints: %v", eps)
+		}
+
+		if len(details.GetInbound()) != 1 || len(details.GetInbound()[0].GetLinks()) != 1 {
+			t.Fatalf("expected links to be merged into one: %v", details.GetInbound())
+		}
+	}
+}
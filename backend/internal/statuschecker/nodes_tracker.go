@@ -0,0 +1,111 @@
+package statuschecker
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/observer"
+	"github.com/cilium/cilium/api/v1/relay"
+)
+
+type NodeStatus struct {
+	Name        string
+	IsAvailable bool
+}
+
+// NOTE: Node that is missing in this many consecutive checks is considered
+// removed from the cluster and is forgotten
+const MissingChecksToEvict = 20
+
+// NOTE: Tracks availability of hubble nodes between consecutive status checks.
+// Node that was seen before but is absent in the latest GetNodes response is
+// considered as missing and is reported as unavailable until it's evicted.
+type NodesTracker struct {
+	nodes   map[string]bool
+	missing map[string]int
+}
+
+func NewNodesTracker() *NodesTracker {
+	return &NodesTracker{
+		nodes:   make(map[string]bool),
+		missing: make(map[string]int),
+	}
+}
+
+// NOTE: Returns statuses of nodes whose availability has changed
+func (t *NodesTracker) Update(
+	hn *observer.GetNodesResponse,
+	ss *observer.ServerStatusResponse,
+) []*NodeStatus {
+	unavailable := make(map[string]struct{}, len(ss.GetUnavailableNodes()))
+	for _, name := range ss.GetUnavailableNodes() {
+		unavailable[name] = struct{}{}
+	}
+
+	current := make(map[string]bool, len(hn.GetNodes()))
+	for _, node := range hn.GetNodes() {
+		_, isUnavailable := unavailable[node.GetName()]
+		isConnected := node.GetState() == relay.NodeState_NODE_CONNECTED
+
+		current[node.GetName()] = isConnected && !isUnavailable
+	}
+
+	for name := range unavailable {
+		if _, exists := current[name]; !exists {
+			current[name] = false
+		}
+	}
+
+	for name := range t.nodes {
+		if _, exists := current[name]; exists {
+			delete(t.missing, name)
+			continue
+		}
+
+		t.missing[name] += 1
+		if t.missing[name] >= MissingChecksToEvict {
+			delete(t.nodes, name)
+			delete(t.missing, name)
+			continue
+		}
+
+		current[name] = false
+	}
+
+	changes := []*NodeStatus{}
+	for name, isAvailable := range current {
+		wasAvailable, exists := t.nodes[name]
+		if exists && wasAvailable == isAvailable {
+			continue
+		}
+
+		t.nodes[name] = isAvailable
+		changes = append(changes, &NodeStatus{
+			Name:        name,
+			IsAvailable: isAvailable,
+		})
+	}
+
+	sortNodeStatuses(changes)
+	return changes
+}
+
+func (t *NodesTracker) Statuses() []*NodeStatus {
+	statuses := make([]*NodeStatus, 0, len(t.nodes))
+
+	for name, isAvailable := range t.nodes {
+		statuses = append(statuses, &NodeStatus{
+			Name:        name,
+			IsAvailable: isAvailable,
+		})
+	}
+
+	sortNodeStatuses(statuses)
+	return statuses
+}
+
+func sortNodeStatuses(statuses []*NodeStatus) {
+	slices.SortFunc(statuses, func(a, b *NodeStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
@@ -0,0 +1,115 @@
+package statuschecker
+
+import (
+	"testing"
+
+	"github.com/cilium/cilium/api/v1/observer"
+	"github.com/cilium/cilium/api/v1/relay"
+)
+
+func nodesResponse(states map[string]relay.NodeState) *observer.GetNodesResponse {
+	resp := &observer.GetNodesResponse{}
+
+	for name, state := range states {
+		resp.Nodes = append(resp.Nodes, &observer.Node{
+			Name:  name,
+			State: state,
+		})
+	}
+
+	return resp
+}
+
+func statusesStr(statuses []*NodeStatus) string {
+	str := ""
+
+	for _, st := range statuses {
+		if st.IsAvailable {
+			str += "+" + st.Name
+		} else {
+			str += "-" + st.Name
+		}
+	}
+
+	return str
+}
+
+func TestNodesTrackerTransitions(t *testing.T) {
+	tracker := NewNodesTracker()
+
+	changes := tracker.Update(nodesResponse(map[string]relay.NodeState{
+		"node-a": relay.NodeState_NODE_CONNECTED,
+		"node-b": relay.NodeState_NODE_CONNECTED,
+		"node-c": relay.NodeState_NODE_UNAVAILABLE,
+	}), &observer.ServerStatusResponse{})
+
+	if str := statusesStr(changes); str != "+node-a+node-b-node-c" {
+		t.Fatalf("unexpected initial changes: %s", str)
+	}
+
+	changes = tracker.Update(nodesResponse(map[string]relay.NodeState{
+		"node-a": relay.NodeState_NODE_CONNECTED,
+		"node-b": relay.NodeState_NODE_CONNECTED,
+		"node-c": relay.NodeState_NODE_UNAVAILABLE,
+	}), &observer.ServerStatusResponse{})
+
+	if len(changes) != 0 {
+		t.Fatalf("unexpected changes: %s", statusesStr(changes))
+	}
+
+	changes = tracker.Update(nodesResponse(map[string]relay.NodeState{
+		"node-a": relay.NodeState_NODE_CONNECTED,
+		"node-c": relay.NodeState_NODE_CONNECTED,
+	}), &observer.ServerStatusResponse{})
+
+	if str := statusesStr(changes); str != "-node-b+node-c" {
+		t.Fatalf("unexpected changes: %s", str)
+	}
+
+	if str := statusesStr(tracker.Statuses()); str != "+node-a-node-b+node-c" {
+		t.Fatalf("unexpected statuses: %s", str)
+	}
+}
+
+func TestNodesTrackerUnavailableFromServerStatus(t *testing.T) {
+	tracker := NewNodesTracker()
+
+	changes := tracker.Update(nodesResponse(map[string]relay.NodeState{
+		"node-a": relay.NodeState_NODE_CONNECTED,
+	}), &observer.ServerStatusResponse{
+		UnavailableNodes: []string{"node-a", "node-b"},
+	})
+
+	if str := statusesStr(changes); str != "-node-a-node-b" {
+		t.Fatalf("unexpected changes: %s", str)
+	}
+}
+
+func TestNodesTrackerEvictsMissingNodes(t *testing.T) {
+	tracker := NewNodesTracker()
+
+	tracker.Update(nodesResponse(map[string]relay.NodeState{
+		"node-a": relay.NodeState_NODE_CONNECTED,
+		"node-b": relay.NodeState_NODE_CONNECTED,
+	}), &observer.ServerStatusResponse{})
+
+	onlyA := nodesResponse(map[string]relay.NodeState{
+		"node-a": relay.NodeState_NODE_CONNECTED,
+	})
+
+	for i := 1; i < MissingChecksToEvict; i++ {
+		tracker.Update(onlyA, &observer.ServerStatusResponse{})
+	}
+
+	if str := statusesStr(tracker.Statuses()); str != "+node-a-node-b" {
+		t.Fatalf("expected missing node to be unavailable, got: %s", str)
+	}
+
+	if changes := tracker.Update(onlyA, &observer.ServerStatusResponse{}); len(changes) != 0 {
+		t.Fatalf("unexpected changes on eviction: %s", statusesStr(changes))
+	}
+
+	if str := statusesStr(tracker.Statuses()); str != "+node-a" {
+		t.Fatalf("expected missing node to be evicted, got: %s", str)
+	}
+}
@@ -30,6 +30,7 @@ type ServerStatusCheckerInterface interface {
 type Handle struct {
 	log            *slog.Logger
 	delay          time.Duration
+	heartbeat      time.Duration
 	connectionPool grpc_client.ConnectionPool
 	callProps      grpc_client.CallPropertiesProvider
 
@@ -37,6 +38,9 @@ type Handle struct {
 	connectionMutex *sync.Mutex
 	observerClient  observer.ObserverClient
 
+	nodes        *NodesTracker
+	lastSentTime time.Time
+
 	errors   chan error
 	statuses chan *FullStatus
 	stop     chan struct{}
@@ -47,6 +51,7 @@ type Handle struct {
 func New(
 	log *slog.Logger,
 	delay time.Duration,
+	heartbeat time.Duration,
 	connPool grpc_client.ConnectionPool,
 	callProps grpc_client.CallPropertiesProvider,
 ) (*Handle, error) {
@@ -66,11 +71,17 @@ func New(
 		delay = 3 * time.Second
 	}
 
+	if heartbeat == 0 {
+		heartbeat = 30 * time.Second
+	}
+
 	return &Handle{
 		log:             log,
 		connectionPool:  connPool,
 		callProps:       callProps,
 		delay:           delay,
+		heartbeat:       heartbeat,
+		nodes:           NewNodesTracker(),
 		connectionMutex: new(sync.Mutex),
 		stop:            make(chan struct{}),
 		stopOnce:        sync.Once{},
@@ -109,6 +120,11 @@ func (h *Handle) Run(ctx context.Context) {
 			return err
 		}
 
+		changes := h.nodes.Update(hn, ss)
+		if len(changes) == 0 && !h.isHeartbeatRequired() {
+			return ctx.Err()
+		}
+
 		h.sendStatus(ctx, ss, hn)
 		return ctx.Err()
 	})
@@ -254,17 +270,27 @@ func (h *Handle) sendStatus(
 	hn *observer.GetNodesResponse,
 ) {
 	resp := &FullStatus{
-		Nodes:  hn,
-		Status: ss,
+		Nodes:        hn,
+		Status:       ss,
+		NodeStatuses: h.nodes.Statuses(),
 	}
 
 	select {
 	case <-ctx.Done():
 	case <-h.stop:
 	case h.Statuses() <- resp:
+		h.lastSentTime = time.Now()
 	}
 }
 
+// NOTE: Status is sent periodically even if nothing has changed, so that
+// flows stats are refreshed and client knows that the checker is alive.
+// Heartbeat that is not longer than delay means that every check is sent.
+func (h *Handle) isHeartbeatRequired() bool {
+	return h.heartbeat <= h.delay || h.lastSentTime.IsZero() ||
+		time.Since(h.lastSentTime) >= h.heartbeat
+}
+
 func (h *Handle) sendError(ctx context.Context, err error) {
 	select {
 	case <-ctx.Done():
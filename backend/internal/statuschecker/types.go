@@ -5,4 +5,6 @@ import "github.com/cilium/cilium/api/v1/observer"
 type FullStatus struct {
 	Nodes  *observer.GetNodesResponse
 	Status *observer.ServerStatusResponse
+
+	NodeStatuses []*NodeStatus
 }
@@ -2,8 +2,11 @@ package main
 
 import (
 	"os"
+	"strings"
 	"time"
 
+	"github.com/cilium/hubble-ui/backend/domain/labels"
+	"github.com/cilium/hubble-ui/backend/domain/service"
 	"github.com/cilium/hubble-ui/backend/internal/application"
 	"github.com/cilium/hubble-ui/backend/internal/config"
 	"github.com/cilium/hubble-ui/backend/pkg/logger"
@@ -18,6 +21,14 @@ func main() {
 		CorsEnabled:              config.BoolOr("CORS_ENABLED", false),
 		DebugLogs:                config.BoolOr("DEBUG_LOGS", false),
 		UIServerPort:             config.Uint16Or("EVENTS_SERVER_PORT", 8090),
+		NamespacesPollInterval:   config.DurationOr("NAMESPACES_POLL_INTERVAL", 10*time.Second),
+		NoActivityPeriod:         config.DurationOr("NO_ACTIVITY_PERIOD", 1*time.Minute),
+		FlowHistorySize:          config.Uint32Or("FLOW_HISTORY_SIZE", 10000),
+		SavedViewsFile:           config.StrOr("SAVED_VIEWS_FILE", ""),
+		ServiceGrouping:          config.StrOr("SERVICE_GROUPING", string(service.GroupByIdentity)),
+		ServiceGroupingLabel:     config.StrOr("SERVICE_GROUPING_LABEL", ""),
+		ServiceNameLabels:        config.StrOr("SERVICE_NAME_LABELS", strings.Join(labels.DefaultAppKeys, ",")),
+		LocalClusterName:         config.StrOr("CLUSTER_NAME", ""),
 		ClientPollDelays:         []time.Duration{200 * time.Millisecond, 5 * time.Second},
 		RelayAddr:                config.StrOr("FLOWS_API_ADDR", "localhost:50051"),
 		TLSToRelayEnabled:        config.BoolOr("TLS_TO_RELAY_ENABLED", false),
@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v4.25.2
+// source: ui/map_export.proto
+
+package ui
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MapExportFormat int32
+
+const (
+	// Node/edge graph, the format is documented in backend/internal/map_export
+	MapExportFormat_MAP_EXPORT_JSON    MapExportFormat = 0
+	MapExportFormat_MAP_EXPORT_DOT     MapExportFormat = 1
+	MapExportFormat_MAP_EXPORT_MERMAID MapExportFormat = 2
+)
+
+// Enum value maps for MapExportFormat.
+var (
+	MapExportFormat_name = map[int32]string{
+		0: "MAP_EXPORT_JSON",
+		1: "MAP_EXPORT_DOT",
+		2: "MAP_EXPORT_MERMAID",
+	}
+	MapExportFormat_value = map[string]int32{
+		"MAP_EXPORT_JSON":    0,
+		"MAP_EXPORT_DOT":     1,
+		"MAP_EXPORT_MERMAID": 2,
+	}
+)
+
+func (x MapExportFormat) Enum() *MapExportFormat {
+	p := new(MapExportFormat)
+	*p = x
+	return p
+}
+
+func (x MapExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MapExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_ui_map_export_proto_enumTypes[0].Descriptor()
+}
+
+func (MapExportFormat) Type() protoreflect.EnumType {
+	return &file_ui_map_export_proto_enumTypes[0]
+}
+
+func (x MapExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MapExportFormat.Descriptor instead.
+func (MapExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_ui_map_export_proto_rawDescGZIP(), []int{0}
+}
+
+type MapExportRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format MapExportFormat        `protobuf:"varint,1,opt,name=format,proto3,enum=ui.MapExportFormat" json:"format,omitempty"`
+	// Only flows from/to the namespace are exported if set
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Filters are applied in the same way as in GetEventsRequest
+	Blacklist     []*EventFilter         `protobuf:"bytes,3,rep,name=blacklist,proto3" json:"blacklist,omitempty"`
+	Whitelist     []*EventFilter         `protobuf:"bytes,4,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapExportRequest) Reset() {
+	*x = MapExportRequest{}
+	mi := &file_ui_map_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapExportRequest) ProtoMessage() {}
+
+func (x *MapExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_map_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapExportRequest.ProtoReflect.Descriptor instead.
+func (*MapExportRequest) Descriptor() ([]byte, []int) {
+	return file_ui_map_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MapExportRequest) GetFormat() MapExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return MapExportFormat_MAP_EXPORT_JSON
+}
+
+func (x *MapExportRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *MapExportRequest) GetBlacklist() []*EventFilter {
+	if x != nil {
+		return x.Blacklist
+	}
+	return nil
+}
+
+func (x *MapExportRequest) GetWhitelist() []*EventFilter {
+	if x != nil {
+		return x.Whitelist
+	}
+	return nil
+}
+
+func (x *MapExportRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *MapExportRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type MapExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        MapExportFormat        `protobuf:"varint,1,opt,name=format,proto3,enum=ui.MapExportFormat" json:"format,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	NodesNumber   uint32                 `protobuf:"varint,4,opt,name=nodes_number,json=nodesNumber,proto3" json:"nodes_number,omitempty"`
+	EdgesNumber   uint32                 `protobuf:"varint,5,opt,name=edges_number,json=edgesNumber,proto3" json:"edges_number,omitempty"`
+	FlowsNumber   uint32                 `protobuf:"varint,6,opt,name=flows_number,json=flowsNumber,proto3" json:"flows_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapExportResponse) Reset() {
+	*x = MapExportResponse{}
+	mi := &file_ui_map_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapExportResponse) ProtoMessage() {}
+
+func (x *MapExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_map_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapExportResponse.ProtoReflect.Descriptor instead.
+func (*MapExportResponse) Descriptor() ([]byte, []int) {
+	return file_ui_map_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MapExportResponse) GetFormat() MapExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return MapExportFormat_MAP_EXPORT_JSON
+}
+
+func (x *MapExportResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MapExportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MapExportResponse) GetNodesNumber() uint32 {
+	if x != nil {
+		return x.NodesNumber
+	}
+	return 0
+}
+
+func (x *MapExportResponse) GetEdgesNumber() uint32 {
+	if x != nil {
+		return x.EdgesNumber
+	}
+	return 0
+}
+
+func (x *MapExportResponse) GetFlowsNumber() uint32 {
+	if x != nil {
+		return x.FlowsNumber
+	}
+	return 0
+}
+
+var File_ui_map_export_proto protoreflect.FileDescriptor
+
+const file_ui_map_export_proto_rawDesc = "" +
+	"\n" +
+	"\x13ui/map_export.proto\x12\x02ui\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vui/ui.proto\"\x9f\x02\n" +
+	"\x10MapExportRequest\x12+\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x13.ui.MapExportFormatR\x06format\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12-\n" +
+	"\tblacklist\x18\x03 \x03(\v2\x0f.ui.EventFilterR\tblacklist\x12-\n" +
+	"\twhitelist\x18\x04 \x03(\v2\x0f.ui.EventFilterR\twhitelist\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\xe6\x01\n" +
+	"\x11MapExportResponse\x12+\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x13.ui.MapExportFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12!\n" +
+	"\fnodes_number\x18\x04 \x01(\rR\vnodesNumber\x12!\n" +
+	"\fedges_number\x18\x05 \x01(\rR\vedgesNumber\x12!\n" +
+	"\fflows_number\x18\x06 \x01(\rR\vflowsNumber*R\n" +
+	"\x0fMapExportFormat\x12\x13\n" +
+	"\x0fMAP_EXPORT_JSON\x10\x00\x12\x12\n" +
+	"\x0eMAP_EXPORT_DOT\x10\x01\x12\x16\n" +
+	"\x12MAP_EXPORT_MERMAID\x10\x02b\x06proto3"
+
+var (
+	file_ui_map_export_proto_rawDescOnce sync.Once
+	file_ui_map_export_proto_rawDescData []byte
+)
+
+func file_ui_map_export_proto_rawDescGZIP() []byte {
+	file_ui_map_export_proto_rawDescOnce.Do(func() {
+		file_ui_map_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ui_map_export_proto_rawDesc), len(file_ui_map_export_proto_rawDesc)))
+	})
+	return file_ui_map_export_proto_rawDescData
+}
+
+var file_ui_map_export_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ui_map_export_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ui_map_export_proto_goTypes = []any{
+	(MapExportFormat)(0),          // 0: ui.MapExportFormat
+	(*MapExportRequest)(nil),      // 1: ui.MapExportRequest
+	(*MapExportResponse)(nil),     // 2: ui.MapExportResponse
+	(*EventFilter)(nil),           // 3: ui.EventFilter
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_ui_map_export_proto_depIdxs = []int32{
+	0, // 0: ui.MapExportRequest.format:type_name -> ui.MapExportFormat
+	3, // 1: ui.MapExportRequest.blacklist:type_name -> ui.EventFilter
+	3, // 2: ui.MapExportRequest.whitelist:type_name -> ui.EventFilter
+	4, // 3: ui.MapExportRequest.since:type_name -> google.protobuf.Timestamp
+	4, // 4: ui.MapExportRequest.until:type_name -> google.protobuf.Timestamp
+	0, // 5: ui.MapExportResponse.format:type_name -> ui.MapExportFormat
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_ui_map_export_proto_init() }
+func file_ui_map_export_proto_init() {
+	if File_ui_map_export_proto != nil {
+		return
+	}
+	file_ui_ui_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ui_map_export_proto_rawDesc), len(file_ui_map_export_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ui_map_export_proto_goTypes,
+		DependencyIndexes: file_ui_map_export_proto_depIdxs,
+		EnumInfos:         file_ui_map_export_proto_enumTypes,
+		MessageInfos:      file_ui_map_export_proto_msgTypes,
+	}.Build()
+	File_ui_map_export_proto = out.File
+	file_ui_map_export_proto_goTypes = nil
+	file_ui_map_export_proto_depIdxs = nil
+}
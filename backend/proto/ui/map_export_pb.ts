@@ -0,0 +1,269 @@
+/* eslint-disable */
+// @generated by protobuf-ts 2.11.1 with parameter add_pb_suffix,eslint_disable,ts_nocheck,generate_dependencies,long_type_bigint
+// @generated from protobuf file "ui/map_export.proto" (package "ui", syntax proto3)
+// tslint:disable
+// @ts-nocheck
+import type { BinaryWriteOptions } from "@protobuf-ts/runtime";
+import type { IBinaryWriter } from "@protobuf-ts/runtime";
+import { WireType } from "@protobuf-ts/runtime";
+import type { BinaryReadOptions } from "@protobuf-ts/runtime";
+import type { IBinaryReader } from "@protobuf-ts/runtime";
+import { UnknownFieldHandler } from "@protobuf-ts/runtime";
+import type { PartialMessage } from "@protobuf-ts/runtime";
+import { reflectionMergePartial } from "@protobuf-ts/runtime";
+import { MessageType } from "@protobuf-ts/runtime";
+import { Timestamp } from "../google/protobuf/timestamp_pb";
+import { EventFilter } from "./ui_pb";
+/**
+ * @generated from protobuf message ui.MapExportRequest
+ */
+export interface MapExportRequest {
+    /**
+     * @generated from protobuf field: ui.MapExportFormat format = 1
+     */
+    format: MapExportFormat;
+    /**
+     * Only flows from/to the namespace are exported if set
+     *
+     * @generated from protobuf field: string namespace = 2
+     */
+    namespace: string;
+    /**
+     * Filters are applied in the same way as in GetEventsRequest
+     *
+     * @generated from protobuf field: repeated ui.EventFilter blacklist = 3
+     */
+    blacklist: EventFilter[];
+    /**
+     * @generated from protobuf field: repeated ui.EventFilter whitelist = 4
+     */
+    whitelist: EventFilter[];
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp since = 5
+     */
+    since?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp until = 6
+     */
+    until?: Timestamp;
+}
+/**
+ * @generated from protobuf message ui.MapExportResponse
+ */
+export interface MapExportResponse {
+    /**
+     * @generated from protobuf field: ui.MapExportFormat format = 1
+     */
+    format: MapExportFormat;
+    /**
+     * @generated from protobuf field: string content = 2
+     */
+    content: string;
+    /**
+     * @generated from protobuf field: string content_type = 3
+     */
+    contentType: string;
+    /**
+     * @generated from protobuf field: uint32 nodes_number = 4
+     */
+    nodesNumber: number;
+    /**
+     * @generated from protobuf field: uint32 edges_number = 5
+     */
+    edgesNumber: number;
+    /**
+     * @generated from protobuf field: uint32 flows_number = 6
+     */
+    flowsNumber: number;
+}
+/**
+ * @generated from protobuf enum ui.MapExportFormat
+ */
+export enum MapExportFormat {
+    /**
+     * Node/edge graph, the format is documented in backend/internal/map_export
+     *
+     * @generated from protobuf enum value: MAP_EXPORT_JSON = 0;
+     */
+    MAP_EXPORT_JSON = 0,
+    /**
+     * @generated from protobuf enum value: MAP_EXPORT_DOT = 1;
+     */
+    MAP_EXPORT_DOT = 1,
+    /**
+     * @generated from protobuf enum value: MAP_EXPORT_MERMAID = 2;
+     */
+    MAP_EXPORT_MERMAID = 2
+}
+// @generated message type with reflection information, may provide speed optimized methods
+class MapExportRequest$Type extends MessageType<MapExportRequest> {
+    constructor() {
+        super("ui.MapExportRequest", [
+            { no: 1, name: "format", kind: "enum", T: () => ["ui.MapExportFormat", MapExportFormat] },
+            { no: 2, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "blacklist", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => EventFilter },
+            { no: 4, name: "whitelist", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => EventFilter },
+            { no: 5, name: "since", kind: "message", T: () => Timestamp },
+            { no: 6, name: "until", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<MapExportRequest>): MapExportRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.format = 0;
+        message.namespace = "";
+        message.blacklist = [];
+        message.whitelist = [];
+        if (value !== undefined)
+            reflectionMergePartial<MapExportRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: MapExportRequest): MapExportRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.MapExportFormat format */ 1:
+                    message.format = reader.int32();
+                    break;
+                case /* string namespace */ 2:
+                    message.namespace = reader.string();
+                    break;
+                case /* repeated ui.EventFilter blacklist */ 3:
+                    message.blacklist.push(EventFilter.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated ui.EventFilter whitelist */ 4:
+                    message.whitelist.push(EventFilter.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* google.protobuf.Timestamp since */ 5:
+                    message.since = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.since);
+                    break;
+                case /* google.protobuf.Timestamp until */ 6:
+                    message.until = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.until);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: MapExportRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.MapExportFormat format = 1; */
+        if (message.format !== 0)
+            writer.tag(1, WireType.Varint).int32(message.format);
+        /* string namespace = 2; */
+        if (message.namespace !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.namespace);
+        /* repeated ui.EventFilter blacklist = 3; */
+        for (let i = 0; i < message.blacklist.length; i++)
+            EventFilter.internalBinaryWrite(message.blacklist[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.EventFilter whitelist = 4; */
+        for (let i = 0; i < message.whitelist.length; i++)
+            EventFilter.internalBinaryWrite(message.whitelist[i], writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp since = 5; */
+        if (message.since)
+            Timestamp.internalBinaryWrite(message.since, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp until = 6; */
+        if (message.until)
+            Timestamp.internalBinaryWrite(message.until, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.MapExportRequest
+ */
+export const MapExportRequest = new MapExportRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class MapExportResponse$Type extends MessageType<MapExportResponse> {
+    constructor() {
+        super("ui.MapExportResponse", [
+            { no: 1, name: "format", kind: "enum", T: () => ["ui.MapExportFormat", MapExportFormat] },
+            { no: 2, name: "content", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "content_type", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "nodes_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 5, name: "edges_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 6, name: "flows_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+    create(value?: PartialMessage<MapExportResponse>): MapExportResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.format = 0;
+        message.content = "";
+        message.contentType = "";
+        message.nodesNumber = 0;
+        message.edgesNumber = 0;
+        message.flowsNumber = 0;
+        if (value !== undefined)
+            reflectionMergePartial<MapExportResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: MapExportResponse): MapExportResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.MapExportFormat format */ 1:
+                    message.format = reader.int32();
+                    break;
+                case /* string content */ 2:
+                    message.content = reader.string();
+                    break;
+                case /* string content_type */ 3:
+                    message.contentType = reader.string();
+                    break;
+                case /* uint32 nodes_number */ 4:
+                    message.nodesNumber = reader.uint32();
+                    break;
+                case /* uint32 edges_number */ 5:
+                    message.edgesNumber = reader.uint32();
+                    break;
+                case /* uint32 flows_number */ 6:
+                    message.flowsNumber = reader.uint32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: MapExportResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.MapExportFormat format = 1; */
+        if (message.format !== 0)
+            writer.tag(1, WireType.Varint).int32(message.format);
+        /* string content = 2; */
+        if (message.content !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.content);
+        /* string content_type = 3; */
+        if (message.contentType !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.contentType);
+        /* uint32 nodes_number = 4; */
+        if (message.nodesNumber !== 0)
+            writer.tag(4, WireType.Varint).uint32(message.nodesNumber);
+        /* uint32 edges_number = 5; */
+        if (message.edgesNumber !== 0)
+            writer.tag(5, WireType.Varint).uint32(message.edgesNumber);
+        /* uint32 flows_number = 6; */
+        if (message.flowsNumber !== 0)
+            writer.tag(6, WireType.Varint).uint32(message.flowsNumber);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.MapExportResponse
+ */
+export const MapExportResponse = new MapExportResponse$Type();
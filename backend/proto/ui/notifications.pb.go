@@ -29,6 +29,7 @@ type Notification struct {
 	//	*Notification_DataState
 	//	*Notification_Status
 	//	*Notification_NoPermission
+	//	*Notification_FlowStats
 	Notification  isNotification_Notification `protobuf_oneof:"notification"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -107,6 +108,15 @@ func (x *Notification) GetNoPermission() *NoPermission {
 	return nil
 }
 
+func (x *Notification) GetFlowStats() *FlowStats {
+	if x != nil {
+		if x, ok := x.Notification.(*Notification_FlowStats); ok {
+			return x.FlowStats
+		}
+	}
+	return nil
+}
+
 type isNotification_Notification interface {
 	isNotification_Notification()
 }
@@ -127,6 +137,10 @@ type Notification_NoPermission struct {
 	NoPermission *NoPermission `protobuf:"bytes,4,opt,name=no_permission,json=noPermission,proto3,oneof"`
 }
 
+type Notification_FlowStats struct {
+	FlowStats *FlowStats `protobuf:"bytes,5,opt,name=flow_stats,json=flowStats,proto3,oneof"`
+}
+
 func (*Notification_ConnState) isNotification_Notification() {}
 
 func (*Notification_DataState) isNotification_Notification() {}
@@ -135,6 +149,8 @@ func (*Notification_Status) isNotification_Notification() {}
 
 func (*Notification_NoPermission) isNotification_Notification() {}
 
+func (*Notification_FlowStats) isNotification_Notification() {}
+
 type ConnectionState struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Backend is successfully connected to hubble-relay
@@ -210,7 +226,12 @@ func (x *ConnectionState) GetK8SConnected() bool {
 type DataState struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// No pods in selected namespace
-	NoActivity    bool `protobuf:"varint,1,opt,name=no_activity,json=noActivity,proto3" json:"no_activity,omitempty"`
+	NoActivity bool `protobuf:"varint,1,opt,name=no_activity,json=noActivity,proto3" json:"no_activity,omitempty"`
+	// Namespace which the state relates to
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// There are no pods in the namespace at all, otherwise there were no
+	// flows during the configured period
+	NoPods        bool `protobuf:"varint,3,opt,name=no_pods,json=noPods,proto3" json:"no_pods,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -252,6 +273,20 @@ func (x *DataState) GetNoActivity() bool {
 	return false
 }
 
+func (x *DataState) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DataState) GetNoPods() bool {
+	if x != nil {
+		return x.NoPods
+	}
+	return false
+}
+
 type NoPermission struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Resource      string                 `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
@@ -308,23 +343,27 @@ var File_ui_notifications_proto protoreflect.FileDescriptor
 
 const file_ui_notifications_proto_rawDesc = "" +
 	"\n" +
-	"\x16ui/notifications.proto\x12\x02ui\x1a\x0fui/status.proto\"\xee\x01\n" +
+	"\x16ui/notifications.proto\x12\x02ui\x1a\x0fui/status.proto\"\x9e\x02\n" +
 	"\fNotification\x124\n" +
 	"\n" +
 	"conn_state\x18\x01 \x01(\v2\x13.ui.ConnectionStateH\x00R\tconnState\x12.\n" +
 	"\n" +
 	"data_state\x18\x02 \x01(\v2\r.ui.DataStateH\x00R\tdataState\x12/\n" +
 	"\x06status\x18\x03 \x01(\v2\x15.ui.GetStatusResponseH\x00R\x06status\x127\n" +
-	"\rno_permission\x18\x04 \x01(\v2\x10.ui.NoPermissionH\x00R\fnoPermissionB\x0e\n" +
+	"\rno_permission\x18\x04 \x01(\v2\x10.ui.NoPermissionH\x00R\fnoPermission\x12.\n" +
+	"\n" +
+	"flow_stats\x18\x05 \x01(\v2\r.ui.FlowStatsH\x00R\tflowStatsB\x0e\n" +
 	"\fnotification\"\xb7\x01\n" +
 	"\x0fConnectionState\x12'\n" +
 	"\x0frelay_connected\x18\x01 \x01(\bR\x0erelayConnected\x12-\n" +
 	"\x12relay_reconnecting\x18\x02 \x01(\bR\x11relayReconnecting\x12'\n" +
 	"\x0fk8s_unavailable\x18\x03 \x01(\bR\x0ek8sUnavailable\x12#\n" +
-	"\rk8s_connected\x18\x04 \x01(\bR\fk8sConnected\",\n" +
+	"\rk8s_connected\x18\x04 \x01(\bR\fk8sConnected\"c\n" +
 	"\tDataState\x12\x1f\n" +
 	"\vno_activity\x18\x01 \x01(\bR\n" +
-	"noActivity\"@\n" +
+	"noActivity\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x17\n" +
+	"\ano_pods\x18\x03 \x01(\bR\x06noPods\"@\n" +
 	"\fNoPermission\x12\x1a\n" +
 	"\bresource\x18\x01 \x01(\tR\bresource\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05errorb\x06proto3"
@@ -348,17 +387,19 @@ var file_ui_notifications_proto_goTypes = []any{
 	(*DataState)(nil),         // 2: ui.DataState
 	(*NoPermission)(nil),      // 3: ui.NoPermission
 	(*GetStatusResponse)(nil), // 4: ui.GetStatusResponse
+	(*FlowStats)(nil),         // 5: ui.FlowStats
 }
 var file_ui_notifications_proto_depIdxs = []int32{
 	1, // 0: ui.Notification.conn_state:type_name -> ui.ConnectionState
 	2, // 1: ui.Notification.data_state:type_name -> ui.DataState
 	4, // 2: ui.Notification.status:type_name -> ui.GetStatusResponse
 	3, // 3: ui.Notification.no_permission:type_name -> ui.NoPermission
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 4: ui.Notification.flow_stats:type_name -> ui.FlowStats
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_ui_notifications_proto_init() }
@@ -372,6 +413,7 @@ func file_ui_notifications_proto_init() {
 		(*Notification_DataState)(nil),
 		(*Notification_Status)(nil),
 		(*Notification_NoPermission)(nil),
+		(*Notification_FlowStats)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
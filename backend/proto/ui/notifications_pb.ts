@@ -12,6 +12,7 @@ import { UnknownFieldHandler } from "@protobuf-ts/runtime";
 import type { PartialMessage } from "@protobuf-ts/runtime";
 import { reflectionMergePartial } from "@protobuf-ts/runtime";
 import { MessageType } from "@protobuf-ts/runtime";
+import { FlowStats } from "./status_pb";
 import { GetStatusResponse } from "./status_pb";
 /**
  * @generated from protobuf message ui.Notification
@@ -44,6 +45,12 @@ export interface Notification {
          * @generated from protobuf field: ui.NoPermission no_permission = 4
          */
         noPermission: NoPermission;
+    } | {
+        oneofKind: "flowStats";
+        /**
+         * @generated from protobuf field: ui.FlowStats flow_stats = 5
+         */
+        flowStats: FlowStats;
     } | {
         oneofKind: undefined;
     };
@@ -87,6 +94,19 @@ export interface DataState {
      * @generated from protobuf field: bool no_activity = 1
      */
     noActivity: boolean;
+    /**
+     * Namespace which the state relates to
+     *
+     * @generated from protobuf field: string namespace = 2
+     */
+    namespace: string;
+    /**
+     * There are no pods in the namespace at all, otherwise there were no
+     * flows during the configured period
+     *
+     * @generated from protobuf field: bool no_pods = 3
+     */
+    noPods: boolean;
 }
 /**
  * @generated from protobuf message ui.NoPermission
@@ -108,7 +128,8 @@ class Notification$Type extends MessageType<Notification> {
             { no: 1, name: "conn_state", kind: "message", oneof: "notification", T: () => ConnectionState },
             { no: 2, name: "data_state", kind: "message", oneof: "notification", T: () => DataState },
             { no: 3, name: "status", kind: "message", oneof: "notification", T: () => GetStatusResponse },
-            { no: 4, name: "no_permission", kind: "message", oneof: "notification", T: () => NoPermission }
+            { no: 4, name: "no_permission", kind: "message", oneof: "notification", T: () => NoPermission },
+            { no: 5, name: "flow_stats", kind: "message", oneof: "notification", T: () => FlowStats }
         ]);
     }
     create(value?: PartialMessage<Notification>): Notification {
@@ -147,6 +168,12 @@ class Notification$Type extends MessageType<Notification> {
                         noPermission: NoPermission.internalBinaryRead(reader, reader.uint32(), options, (message.notification as any).noPermission)
                     };
                     break;
+                case /* ui.FlowStats flow_stats */ 5:
+                    message.notification = {
+                        oneofKind: "flowStats",
+                        flowStats: FlowStats.internalBinaryRead(reader, reader.uint32(), options, (message.notification as any).flowStats)
+                    };
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -171,6 +198,9 @@ class Notification$Type extends MessageType<Notification> {
         /* ui.NoPermission no_permission = 4; */
         if (message.notification.oneofKind === "noPermission")
             NoPermission.internalBinaryWrite(message.notification.noPermission, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* ui.FlowStats flow_stats = 5; */
+        if (message.notification.oneofKind === "flowStats")
+            FlowStats.internalBinaryWrite(message.notification.flowStats, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -256,12 +286,16 @@ export const ConnectionState = new ConnectionState$Type();
 class DataState$Type extends MessageType<DataState> {
     constructor() {
         super("ui.DataState", [
-            { no: 1, name: "no_activity", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+            { no: 1, name: "no_activity", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 2, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "no_pods", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
     create(value?: PartialMessage<DataState>): DataState {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.noActivity = false;
+        message.namespace = "";
+        message.noPods = false;
         if (value !== undefined)
             reflectionMergePartial<DataState>(this, message, value);
         return message;
@@ -274,6 +308,12 @@ class DataState$Type extends MessageType<DataState> {
                 case /* bool no_activity */ 1:
                     message.noActivity = reader.bool();
                     break;
+                case /* string namespace */ 2:
+                    message.namespace = reader.string();
+                    break;
+                case /* bool no_pods */ 3:
+                    message.noPods = reader.bool();
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -289,6 +329,12 @@ class DataState$Type extends MessageType<DataState> {
         /* bool no_activity = 1; */
         if (message.noActivity !== false)
             writer.tag(1, WireType.Varint).bool(message.noActivity);
+        /* string namespace = 2; */
+        if (message.namespace !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.namespace);
+        /* bool no_pods = 3; */
+        if (message.noPods !== false)
+            writer.tag(3, WireType.Varint).bool(message.noPods);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -0,0 +1,1101 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v4.25.2
+// source: ui/policies.proto
+
+package ui
+
+import (
+	flow "github.com/cilium/cilium/api/v1/flow"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PolicyMatchKind int32
+
+const (
+	PolicyMatchKind_UNKNOWN_POLICY_MATCH PolicyMatchKind = 0
+	PolicyMatchKind_NO_POLICY_MATCH      PolicyMatchKind = 1
+	PolicyMatchKind_L3_ONLY              PolicyMatchKind = 2
+	PolicyMatchKind_L3_L4                PolicyMatchKind = 3
+	PolicyMatchKind_L4_ONLY              PolicyMatchKind = 4
+	PolicyMatchKind_ALLOW_ALL            PolicyMatchKind = 5
+	PolicyMatchKind_L3_PROTO             PolicyMatchKind = 6
+	PolicyMatchKind_PROTO_ONLY           PolicyMatchKind = 7
+	PolicyMatchKind_L7                   PolicyMatchKind = 8
+)
+
+// Enum value maps for PolicyMatchKind.
+var (
+	PolicyMatchKind_name = map[int32]string{
+		0: "UNKNOWN_POLICY_MATCH",
+		1: "NO_POLICY_MATCH",
+		2: "L3_ONLY",
+		3: "L3_L4",
+		4: "L4_ONLY",
+		5: "ALLOW_ALL",
+		6: "L3_PROTO",
+		7: "PROTO_ONLY",
+		8: "L7",
+	}
+	PolicyMatchKind_value = map[string]int32{
+		"UNKNOWN_POLICY_MATCH": 0,
+		"NO_POLICY_MATCH":      1,
+		"L3_ONLY":              2,
+		"L3_L4":                3,
+		"L4_ONLY":              4,
+		"ALLOW_ALL":            5,
+		"L3_PROTO":             6,
+		"PROTO_ONLY":           7,
+		"L7":                   8,
+	}
+)
+
+func (x PolicyMatchKind) Enum() *PolicyMatchKind {
+	p := new(PolicyMatchKind)
+	*p = x
+	return p
+}
+
+func (x PolicyMatchKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyMatchKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ui_policies_proto_enumTypes[0].Descriptor()
+}
+
+func (PolicyMatchKind) Type() protoreflect.EnumType {
+	return &file_ui_policies_proto_enumTypes[0]
+}
+
+func (x PolicyMatchKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyMatchKind.Descriptor instead.
+func (PolicyMatchKind) EnumDescriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{0}
+}
+
+type PolicyImpact int32
+
+const (
+	PolicyImpact_UNCHANGED_IMPACT PolicyImpact = 0
+	PolicyImpact_WOULD_BE_DROPPED PolicyImpact = 1
+	PolicyImpact_WOULD_BE_ALLOWED PolicyImpact = 2
+)
+
+// Enum value maps for PolicyImpact.
+var (
+	PolicyImpact_name = map[int32]string{
+		0: "UNCHANGED_IMPACT",
+		1: "WOULD_BE_DROPPED",
+		2: "WOULD_BE_ALLOWED",
+	}
+	PolicyImpact_value = map[string]int32{
+		"UNCHANGED_IMPACT": 0,
+		"WOULD_BE_DROPPED": 1,
+		"WOULD_BE_ALLOWED": 2,
+	}
+)
+
+func (x PolicyImpact) Enum() *PolicyImpact {
+	p := new(PolicyImpact)
+	*p = x
+	return p
+}
+
+func (x PolicyImpact) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyImpact) Descriptor() protoreflect.EnumDescriptor {
+	return file_ui_policies_proto_enumTypes[1].Descriptor()
+}
+
+func (PolicyImpact) Type() protoreflect.EnumType {
+	return &file_ui_policies_proto_enumTypes[1]
+}
+
+func (x PolicyImpact) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyImpact.Descriptor instead.
+func (PolicyImpact) EnumDescriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{1}
+}
+
+type PolicyVerdictExplanationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Subject:
+	//
+	//	*PolicyVerdictExplanationRequest_Flow
+	//	*PolicyVerdictExplanationRequest_FlowUuid
+	//	*PolicyVerdictExplanationRequest_LinkId
+	Subject       isPolicyVerdictExplanationRequest_Subject `protobuf_oneof:"subject"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyVerdictExplanationRequest) Reset() {
+	*x = PolicyVerdictExplanationRequest{}
+	mi := &file_ui_policies_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyVerdictExplanationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyVerdictExplanationRequest) ProtoMessage() {}
+
+func (x *PolicyVerdictExplanationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyVerdictExplanationRequest.ProtoReflect.Descriptor instead.
+func (*PolicyVerdictExplanationRequest) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PolicyVerdictExplanationRequest) GetSubject() isPolicyVerdictExplanationRequest_Subject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *PolicyVerdictExplanationRequest) GetFlow() *flow.Flow {
+	if x != nil {
+		if x, ok := x.Subject.(*PolicyVerdictExplanationRequest_Flow); ok {
+			return x.Flow
+		}
+	}
+	return nil
+}
+
+func (x *PolicyVerdictExplanationRequest) GetFlowUuid() string {
+	if x != nil {
+		if x, ok := x.Subject.(*PolicyVerdictExplanationRequest_FlowUuid); ok {
+			return x.FlowUuid
+		}
+	}
+	return ""
+}
+
+func (x *PolicyVerdictExplanationRequest) GetLinkId() string {
+	if x != nil {
+		if x, ok := x.Subject.(*PolicyVerdictExplanationRequest_LinkId); ok {
+			return x.LinkId
+		}
+	}
+	return ""
+}
+
+type isPolicyVerdictExplanationRequest_Subject interface {
+	isPolicyVerdictExplanationRequest_Subject()
+}
+
+type PolicyVerdictExplanationRequest_Flow struct {
+	// The flow to explain as it was received by the client
+	Flow *flow.Flow `protobuf:"bytes,1,opt,name=flow,proto3,oneof"`
+}
+
+type PolicyVerdictExplanationRequest_FlowUuid struct {
+	// The flow is looked up in the flows recently seen by backend
+	FlowUuid string `protobuf:"bytes,2,opt,name=flow_uuid,json=flowUuid,proto3,oneof"`
+}
+
+type PolicyVerdictExplanationRequest_LinkId struct {
+	// The latest flow of the link is explained
+	LinkId string `protobuf:"bytes,3,opt,name=link_id,json=linkId,proto3,oneof"`
+}
+
+func (*PolicyVerdictExplanationRequest_Flow) isPolicyVerdictExplanationRequest_Subject() {}
+
+func (*PolicyVerdictExplanationRequest_FlowUuid) isPolicyVerdictExplanationRequest_Subject() {}
+
+func (*PolicyVerdictExplanationRequest_LinkId) isPolicyVerdictExplanationRequest_Subject() {}
+
+type PolicyVerdictExplanationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Explanation   *PolicyVerdictExplanation `protobuf:"bytes,1,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyVerdictExplanationResponse) Reset() {
+	*x = PolicyVerdictExplanationResponse{}
+	mi := &file_ui_policies_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyVerdictExplanationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyVerdictExplanationResponse) ProtoMessage() {}
+
+func (x *PolicyVerdictExplanationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyVerdictExplanationResponse.ProtoReflect.Descriptor instead.
+func (*PolicyVerdictExplanationResponse) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PolicyVerdictExplanationResponse) GetExplanation() *PolicyVerdictExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+type PolicyReference struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Kind      string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Revision  uint64                 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The policy object was found in the cluster
+	IsResolved bool `protobuf:"varint,5,opt,name=is_resolved,json=isResolved,proto3" json:"is_resolved,omitempty"`
+	// The policy has L7 rules (http, kafka, dns) in any of its port rules
+	HasL7Rules bool `protobuf:"varint,6,opt,name=has_l7_rules,json=hasL7Rules,proto3" json:"has_l7_rules,omitempty"`
+	// The reason why the policy object was not resolved
+	ResolveError  string `protobuf:"bytes,7,opt,name=resolve_error,json=resolveError,proto3" json:"resolve_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyReference) Reset() {
+	*x = PolicyReference{}
+	mi := &file_ui_policies_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyReference) ProtoMessage() {}
+
+func (x *PolicyReference) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyReference.ProtoReflect.Descriptor instead.
+func (*PolicyReference) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PolicyReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolicyReference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PolicyReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PolicyReference) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PolicyReference) GetIsResolved() bool {
+	if x != nil {
+		return x.IsResolved
+	}
+	return false
+}
+
+func (x *PolicyReference) GetHasL7Rules() bool {
+	if x != nil {
+		return x.HasL7Rules
+	}
+	return false
+}
+
+func (x *PolicyReference) GetResolveError() string {
+	if x != nil {
+		return x.ResolveError
+	}
+	return ""
+}
+
+type PolicyVerdictExplanation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FlowUuid         string                 `protobuf:"bytes,1,opt,name=flow_uuid,json=flowUuid,proto3" json:"flow_uuid,omitempty"`
+	Verdict          flow.Verdict           `protobuf:"varint,2,opt,name=verdict,proto3,enum=flow.Verdict" json:"verdict,omitempty"`
+	TrafficDirection flow.TrafficDirection  `protobuf:"varint,3,opt,name=traffic_direction,json=trafficDirection,proto3,enum=flow.TrafficDirection" json:"traffic_direction,omitempty"`
+	MatchKind        PolicyMatchKind        `protobuf:"varint,4,opt,name=match_kind,json=matchKind,proto3,enum=ui.PolicyMatchKind" json:"match_kind,omitempty"`
+	AllowedBy        []*PolicyReference     `protobuf:"bytes,5,rep,name=allowed_by,json=allowedBy,proto3" json:"allowed_by,omitempty"`
+	DeniedBy         []*PolicyReference     `protobuf:"bytes,6,rep,name=denied_by,json=deniedBy,proto3" json:"denied_by,omitempty"`
+	// The flow would have been dropped if policy enforcement was not in
+	// audit mode
+	IsAudit bool `protobuf:"varint,7,opt,name=is_audit,json=isAudit,proto3" json:"is_audit,omitempty"`
+	// Nothing explicitly denied the flow, it was dropped since no policy
+	// allowed it
+	IsDefaultDeny bool `protobuf:"varint,8,opt,name=is_default_deny,json=isDefaultDeny,proto3" json:"is_default_deny,omitempty"`
+	// One line human readable explanation
+	Summary       string   `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`
+	Details       []string `protobuf:"bytes,10,rep,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyVerdictExplanation) Reset() {
+	*x = PolicyVerdictExplanation{}
+	mi := &file_ui_policies_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyVerdictExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyVerdictExplanation) ProtoMessage() {}
+
+func (x *PolicyVerdictExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyVerdictExplanation.ProtoReflect.Descriptor instead.
+func (*PolicyVerdictExplanation) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PolicyVerdictExplanation) GetFlowUuid() string {
+	if x != nil {
+		return x.FlowUuid
+	}
+	return ""
+}
+
+func (x *PolicyVerdictExplanation) GetVerdict() flow.Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return flow.Verdict(0)
+}
+
+func (x *PolicyVerdictExplanation) GetTrafficDirection() flow.TrafficDirection {
+	if x != nil {
+		return x.TrafficDirection
+	}
+	return flow.TrafficDirection(0)
+}
+
+func (x *PolicyVerdictExplanation) GetMatchKind() PolicyMatchKind {
+	if x != nil {
+		return x.MatchKind
+	}
+	return PolicyMatchKind_UNKNOWN_POLICY_MATCH
+}
+
+func (x *PolicyVerdictExplanation) GetAllowedBy() []*PolicyReference {
+	if x != nil {
+		return x.AllowedBy
+	}
+	return nil
+}
+
+func (x *PolicyVerdictExplanation) GetDeniedBy() []*PolicyReference {
+	if x != nil {
+		return x.DeniedBy
+	}
+	return nil
+}
+
+func (x *PolicyVerdictExplanation) GetIsAudit() bool {
+	if x != nil {
+		return x.IsAudit
+	}
+	return false
+}
+
+func (x *PolicyVerdictExplanation) GetIsDefaultDeny() bool {
+	if x != nil {
+		return x.IsDefaultDeny
+	}
+	return false
+}
+
+func (x *PolicyVerdictExplanation) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *PolicyVerdictExplanation) GetDetails() []string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type PolicyDraftsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Only flows observed within the window are used, unset bound means that
+	// the window is not limited from that side
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyDraftsRequest) Reset() {
+	*x = PolicyDraftsRequest{}
+	mi := &file_ui_policies_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyDraftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyDraftsRequest) ProtoMessage() {}
+
+func (x *PolicyDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyDraftsRequest.ProtoReflect.Descriptor instead.
+func (*PolicyDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PolicyDraftsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PolicyDraftsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *PolicyDraftsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type PolicyDraftsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Drafts []*PolicyDraft         `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts,omitempty"`
+	// The number of flows the drafts are generated from
+	FlowsNumber   uint32 `protobuf:"varint,2,opt,name=flows_number,json=flowsNumber,proto3" json:"flows_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyDraftsResponse) Reset() {
+	*x = PolicyDraftsResponse{}
+	mi := &file_ui_policies_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyDraftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyDraftsResponse) ProtoMessage() {}
+
+func (x *PolicyDraftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyDraftsResponse.ProtoReflect.Descriptor instead.
+func (*PolicyDraftsResponse) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PolicyDraftsResponse) GetDrafts() []*PolicyDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+func (x *PolicyDraftsResponse) GetFlowsNumber() uint32 {
+	if x != nil {
+		return x.FlowsNumber
+	}
+	return 0
+}
+
+type PolicyDraft struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The id of the service (map card) selected by the policy
+	ServiceId string `protobuf:"bytes,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// CiliumNetworkPolicy manifest
+	Yaml          string `protobuf:"bytes,4,opt,name=yaml,proto3" json:"yaml,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyDraft) Reset() {
+	*x = PolicyDraft{}
+	mi := &file_ui_policies_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyDraft) ProtoMessage() {}
+
+func (x *PolicyDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyDraft.ProtoReflect.Descriptor instead.
+func (*PolicyDraft) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PolicyDraft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolicyDraft) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PolicyDraft) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *PolicyDraft) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
+}
+
+type PolicySimulationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// CiliumNetworkPolicy, CiliumClusterwideNetworkPolicy or NetworkPolicy
+	// manifests, several documents are allowed
+	Yaml          string `protobuf:"bytes,2,opt,name=yaml,proto3" json:"yaml,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicySimulationRequest) Reset() {
+	*x = PolicySimulationRequest{}
+	mi := &file_ui_policies_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicySimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicySimulationRequest) ProtoMessage() {}
+
+func (x *PolicySimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicySimulationRequest.ProtoReflect.Descriptor instead.
+func (*PolicySimulationRequest) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PolicySimulationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PolicySimulationRequest) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
+}
+
+type PolicySimulationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only the links which verdict would be changed by the draft
+	Links       []*SimulatedLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	RulesNumber uint32           `protobuf:"varint,2,opt,name=rules_number,json=rulesNumber,proto3" json:"rules_number,omitempty"`
+	LinksNumber uint32           `protobuf:"varint,3,opt,name=links_number,json=linksNumber,proto3" json:"links_number,omitempty"`
+	// Draft policies cannot be parsed, nothing is simulated
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicySimulationResponse) Reset() {
+	*x = PolicySimulationResponse{}
+	mi := &file_ui_policies_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicySimulationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicySimulationResponse) ProtoMessage() {}
+
+func (x *PolicySimulationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicySimulationResponse.ProtoReflect.Descriptor instead.
+func (*PolicySimulationResponse) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PolicySimulationResponse) GetLinks() []*SimulatedLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *PolicySimulationResponse) GetRulesNumber() uint32 {
+	if x != nil {
+		return x.RulesNumber
+	}
+	return 0
+}
+
+func (x *PolicySimulationResponse) GetLinksNumber() uint32 {
+	if x != nil {
+		return x.LinksNumber
+	}
+	return 0
+}
+
+func (x *PolicySimulationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SimulatedLink struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LinkId           string                 `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	SourceId         string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DestinationId    string                 `protobuf:"bytes,3,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	DestinationPort  uint32                 `protobuf:"varint,4,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	IpProtocol       IPProtocol             `protobuf:"varint,5,opt,name=ip_protocol,json=ipProtocol,proto3,enum=ui.IPProtocol" json:"ip_protocol,omitempty"`
+	CurrentVerdict   flow.Verdict           `protobuf:"varint,6,opt,name=current_verdict,json=currentVerdict,proto3,enum=flow.Verdict" json:"current_verdict,omitempty"`
+	SimulatedVerdict flow.Verdict           `protobuf:"varint,7,opt,name=simulated_verdict,json=simulatedVerdict,proto3,enum=flow.Verdict" json:"simulated_verdict,omitempty"`
+	Impact           PolicyImpact           `protobuf:"varint,8,opt,name=impact,proto3,enum=ui.PolicyImpact" json:"impact,omitempty"`
+	// Names of the draft policies that cause the change
+	Policies      []string `protobuf:"bytes,9,rep,name=policies,proto3" json:"policies,omitempty"`
+	Reason        string   `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatedLink) Reset() {
+	*x = SimulatedLink{}
+	mi := &file_ui_policies_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedLink) ProtoMessage() {}
+
+func (x *SimulatedLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_policies_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedLink.ProtoReflect.Descriptor instead.
+func (*SimulatedLink) Descriptor() ([]byte, []int) {
+	return file_ui_policies_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SimulatedLink) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+func (x *SimulatedLink) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *SimulatedLink) GetDestinationId() string {
+	if x != nil {
+		return x.DestinationId
+	}
+	return ""
+}
+
+func (x *SimulatedLink) GetDestinationPort() uint32 {
+	if x != nil {
+		return x.DestinationPort
+	}
+	return 0
+}
+
+func (x *SimulatedLink) GetIpProtocol() IPProtocol {
+	if x != nil {
+		return x.IpProtocol
+	}
+	return IPProtocol_UNKNOWN_IP_PROTOCOL
+}
+
+func (x *SimulatedLink) GetCurrentVerdict() flow.Verdict {
+	if x != nil {
+		return x.CurrentVerdict
+	}
+	return flow.Verdict(0)
+}
+
+func (x *SimulatedLink) GetSimulatedVerdict() flow.Verdict {
+	if x != nil {
+		return x.SimulatedVerdict
+	}
+	return flow.Verdict(0)
+}
+
+func (x *SimulatedLink) GetImpact() PolicyImpact {
+	if x != nil {
+		return x.Impact
+	}
+	return PolicyImpact_UNCHANGED_IMPACT
+}
+
+func (x *SimulatedLink) GetPolicies() []string {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *SimulatedLink) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_ui_policies_proto protoreflect.FileDescriptor
+
+const file_ui_policies_proto_rawDesc = "" +
+	"\n" +
+	"\x11ui/policies.proto\x12\x02ui\x1a\x0fflow/flow.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vui/ui.proto\"\x88\x01\n" +
+	"\x1fPolicyVerdictExplanationRequest\x12 \n" +
+	"\x04flow\x18\x01 \x01(\v2\n" +
+	".flow.FlowH\x00R\x04flow\x12\x1d\n" +
+	"\tflow_uuid\x18\x02 \x01(\tH\x00R\bflowUuid\x12\x19\n" +
+	"\alink_id\x18\x03 \x01(\tH\x00R\x06linkIdB\t\n" +
+	"\asubject\"b\n" +
+	" PolicyVerdictExplanationResponse\x12>\n" +
+	"\vexplanation\x18\x01 \x01(\v2\x1c.ui.PolicyVerdictExplanationR\vexplanation\"\xdb\x01\n" +
+	"\x0fPolicyReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x04R\brevision\x12\x1f\n" +
+	"\vis_resolved\x18\x05 \x01(\bR\n" +
+	"isResolved\x12 \n" +
+	"\fhas_l7_rules\x18\x06 \x01(\bR\n" +
+	"hasL7Rules\x12#\n" +
+	"\rresolve_error\x18\a \x01(\tR\fresolveError\"\xb6\x03\n" +
+	"\x18PolicyVerdictExplanation\x12\x1b\n" +
+	"\tflow_uuid\x18\x01 \x01(\tR\bflowUuid\x12'\n" +
+	"\averdict\x18\x02 \x01(\x0e2\r.flow.VerdictR\averdict\x12C\n" +
+	"\x11traffic_direction\x18\x03 \x01(\x0e2\x16.flow.TrafficDirectionR\x10trafficDirection\x122\n" +
+	"\n" +
+	"match_kind\x18\x04 \x01(\x0e2\x13.ui.PolicyMatchKindR\tmatchKind\x122\n" +
+	"\n" +
+	"allowed_by\x18\x05 \x03(\v2\x13.ui.PolicyReferenceR\tallowedBy\x120\n" +
+	"\tdenied_by\x18\x06 \x03(\v2\x13.ui.PolicyReferenceR\bdeniedBy\x12\x19\n" +
+	"\bis_audit\x18\a \x01(\bR\aisAudit\x12&\n" +
+	"\x0fis_default_deny\x18\b \x01(\bR\risDefaultDeny\x12\x18\n" +
+	"\asummary\x18\t \x01(\tR\asummary\x12\x18\n" +
+	"\adetails\x18\n" +
+	" \x03(\tR\adetails\"\x97\x01\n" +
+	"\x13PolicyDraftsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"b\n" +
+	"\x14PolicyDraftsResponse\x12'\n" +
+	"\x06drafts\x18\x01 \x03(\v2\x0f.ui.PolicyDraftR\x06drafts\x12!\n" +
+	"\fflows_number\x18\x02 \x01(\rR\vflowsNumber\"r\n" +
+	"\vPolicyDraft\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04yaml\x18\x04 \x01(\tR\x04yaml\"K\n" +
+	"\x17PolicySimulationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04yaml\x18\x02 \x01(\tR\x04yaml\"\x9f\x01\n" +
+	"\x18PolicySimulationResponse\x12'\n" +
+	"\x05links\x18\x01 \x03(\v2\x11.ui.SimulatedLinkR\x05links\x12!\n" +
+	"\frules_number\x18\x02 \x01(\rR\vrulesNumber\x12!\n" +
+	"\flinks_number\x18\x03 \x01(\rR\vlinksNumber\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x9a\x03\n" +
+	"\rSimulatedLink\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12%\n" +
+	"\x0edestination_id\x18\x03 \x01(\tR\rdestinationId\x12)\n" +
+	"\x10destination_port\x18\x04 \x01(\rR\x0fdestinationPort\x12/\n" +
+	"\vip_protocol\x18\x05 \x01(\x0e2\x0e.ui.IPProtocolR\n" +
+	"ipProtocol\x126\n" +
+	"\x0fcurrent_verdict\x18\x06 \x01(\x0e2\r.flow.VerdictR\x0ecurrentVerdict\x12:\n" +
+	"\x11simulated_verdict\x18\a \x01(\x0e2\r.flow.VerdictR\x10simulatedVerdict\x12(\n" +
+	"\x06impact\x18\b \x01(\x0e2\x10.ui.PolicyImpactR\x06impact\x12\x1a\n" +
+	"\bpolicies\x18\t \x03(\tR\bpolicies\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason*\x9a\x01\n" +
+	"\x0fPolicyMatchKind\x12\x18\n" +
+	"\x14UNKNOWN_POLICY_MATCH\x10\x00\x12\x13\n" +
+	"\x0fNO_POLICY_MATCH\x10\x01\x12\v\n" +
+	"\aL3_ONLY\x10\x02\x12\t\n" +
+	"\x05L3_L4\x10\x03\x12\v\n" +
+	"\aL4_ONLY\x10\x04\x12\r\n" +
+	"\tALLOW_ALL\x10\x05\x12\f\n" +
+	"\bL3_PROTO\x10\x06\x12\x0e\n" +
+	"\n" +
+	"PROTO_ONLY\x10\a\x12\x06\n" +
+	"\x02L7\x10\b*P\n" +
+	"\fPolicyImpact\x12\x14\n" +
+	"\x10UNCHANGED_IMPACT\x10\x00\x12\x14\n" +
+	"\x10WOULD_BE_DROPPED\x10\x01\x12\x14\n" +
+	"\x10WOULD_BE_ALLOWED\x10\x02b\x06proto3"
+
+var (
+	file_ui_policies_proto_rawDescOnce sync.Once
+	file_ui_policies_proto_rawDescData []byte
+)
+
+func file_ui_policies_proto_rawDescGZIP() []byte {
+	file_ui_policies_proto_rawDescOnce.Do(func() {
+		file_ui_policies_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ui_policies_proto_rawDesc), len(file_ui_policies_proto_rawDesc)))
+	})
+	return file_ui_policies_proto_rawDescData
+}
+
+var file_ui_policies_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ui_policies_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ui_policies_proto_goTypes = []any{
+	(PolicyMatchKind)(0),                     // 0: ui.PolicyMatchKind
+	(PolicyImpact)(0),                        // 1: ui.PolicyImpact
+	(*PolicyVerdictExplanationRequest)(nil),  // 2: ui.PolicyVerdictExplanationRequest
+	(*PolicyVerdictExplanationResponse)(nil), // 3: ui.PolicyVerdictExplanationResponse
+	(*PolicyReference)(nil),                  // 4: ui.PolicyReference
+	(*PolicyVerdictExplanation)(nil),         // 5: ui.PolicyVerdictExplanation
+	(*PolicyDraftsRequest)(nil),              // 6: ui.PolicyDraftsRequest
+	(*PolicyDraftsResponse)(nil),             // 7: ui.PolicyDraftsResponse
+	(*PolicyDraft)(nil),                      // 8: ui.PolicyDraft
+	(*PolicySimulationRequest)(nil),          // 9: ui.PolicySimulationRequest
+	(*PolicySimulationResponse)(nil),         // 10: ui.PolicySimulationResponse
+	(*SimulatedLink)(nil),                    // 11: ui.SimulatedLink
+	(*flow.Flow)(nil),                        // 12: flow.Flow
+	(flow.Verdict)(0),                        // 13: flow.Verdict
+	(flow.TrafficDirection)(0),               // 14: flow.TrafficDirection
+	(*timestamppb.Timestamp)(nil),            // 15: google.protobuf.Timestamp
+	(IPProtocol)(0),                          // 16: ui.IPProtocol
+}
+var file_ui_policies_proto_depIdxs = []int32{
+	12, // 0: ui.PolicyVerdictExplanationRequest.flow:type_name -> flow.Flow
+	5,  // 1: ui.PolicyVerdictExplanationResponse.explanation:type_name -> ui.PolicyVerdictExplanation
+	13, // 2: ui.PolicyVerdictExplanation.verdict:type_name -> flow.Verdict
+	14, // 3: ui.PolicyVerdictExplanation.traffic_direction:type_name -> flow.TrafficDirection
+	0,  // 4: ui.PolicyVerdictExplanation.match_kind:type_name -> ui.PolicyMatchKind
+	4,  // 5: ui.PolicyVerdictExplanation.allowed_by:type_name -> ui.PolicyReference
+	4,  // 6: ui.PolicyVerdictExplanation.denied_by:type_name -> ui.PolicyReference
+	15, // 7: ui.PolicyDraftsRequest.since:type_name -> google.protobuf.Timestamp
+	15, // 8: ui.PolicyDraftsRequest.until:type_name -> google.protobuf.Timestamp
+	8,  // 9: ui.PolicyDraftsResponse.drafts:type_name -> ui.PolicyDraft
+	11, // 10: ui.PolicySimulationResponse.links:type_name -> ui.SimulatedLink
+	16, // 11: ui.SimulatedLink.ip_protocol:type_name -> ui.IPProtocol
+	13, // 12: ui.SimulatedLink.current_verdict:type_name -> flow.Verdict
+	13, // 13: ui.SimulatedLink.simulated_verdict:type_name -> flow.Verdict
+	1,  // 14: ui.SimulatedLink.impact:type_name -> ui.PolicyImpact
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_ui_policies_proto_init() }
+func file_ui_policies_proto_init() {
+	if File_ui_policies_proto != nil {
+		return
+	}
+	file_ui_ui_proto_init()
+	file_ui_policies_proto_msgTypes[0].OneofWrappers = []any{
+		(*PolicyVerdictExplanationRequest_Flow)(nil),
+		(*PolicyVerdictExplanationRequest_FlowUuid)(nil),
+		(*PolicyVerdictExplanationRequest_LinkId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ui_policies_proto_rawDesc), len(file_ui_policies_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ui_policies_proto_goTypes,
+		DependencyIndexes: file_ui_policies_proto_depIdxs,
+		EnumInfos:         file_ui_policies_proto_enumTypes,
+		MessageInfos:      file_ui_policies_proto_msgTypes,
+	}.Build()
+	File_ui_policies_proto = out.File
+	file_ui_policies_proto_goTypes = nil
+	file_ui_policies_proto_depIdxs = nil
+}
@@ -0,0 +1,1123 @@
+/* eslint-disable */
+// @generated by protobuf-ts 2.11.1 with parameter add_pb_suffix,eslint_disable,ts_nocheck,generate_dependencies,long_type_bigint
+// @generated from protobuf file "ui/policies.proto" (package "ui", syntax proto3)
+// tslint:disable
+// @ts-nocheck
+import type { BinaryWriteOptions } from "@protobuf-ts/runtime";
+import type { IBinaryWriter } from "@protobuf-ts/runtime";
+import { WireType } from "@protobuf-ts/runtime";
+import type { BinaryReadOptions } from "@protobuf-ts/runtime";
+import type { IBinaryReader } from "@protobuf-ts/runtime";
+import { UnknownFieldHandler } from "@protobuf-ts/runtime";
+import type { PartialMessage } from "@protobuf-ts/runtime";
+import { reflectionMergePartial } from "@protobuf-ts/runtime";
+import { MessageType } from "@protobuf-ts/runtime";
+import { IPProtocol } from "./ui_pb";
+import { Timestamp } from "../google/protobuf/timestamp_pb";
+import { TrafficDirection } from "../flow/flow_pb";
+import { Verdict } from "../flow/flow_pb";
+import { Flow } from "../flow/flow_pb";
+/**
+ * @generated from protobuf message ui.PolicyVerdictExplanationRequest
+ */
+export interface PolicyVerdictExplanationRequest {
+    /**
+     * @generated from protobuf oneof: subject
+     */
+    subject: {
+        oneofKind: "flow";
+        /**
+         * The flow to explain as it was received by the client
+         *
+         * @generated from protobuf field: flow.Flow flow = 1
+         */
+        flow: Flow;
+    } | {
+        oneofKind: "flowUuid";
+        /**
+         * The flow is looked up in the flows recently seen by backend
+         *
+         * @generated from protobuf field: string flow_uuid = 2
+         */
+        flowUuid: string;
+    } | {
+        oneofKind: "linkId";
+        /**
+         * The latest flow of the link is explained
+         *
+         * @generated from protobuf field: string link_id = 3
+         */
+        linkId: string;
+    } | {
+        oneofKind: undefined;
+    };
+}
+/**
+ * @generated from protobuf message ui.PolicyVerdictExplanationResponse
+ */
+export interface PolicyVerdictExplanationResponse {
+    /**
+     * @generated from protobuf field: ui.PolicyVerdictExplanation explanation = 1
+     */
+    explanation?: PolicyVerdictExplanation;
+}
+/**
+ * @generated from protobuf message ui.PolicyReference
+ */
+export interface PolicyReference {
+    /**
+     * @generated from protobuf field: string name = 1
+     */
+    name: string;
+    /**
+     * @generated from protobuf field: string namespace = 2
+     */
+    namespace: string;
+    /**
+     * @generated from protobuf field: string kind = 3
+     */
+    kind: string;
+    /**
+     * @generated from protobuf field: uint64 revision = 4
+     */
+    revision: bigint;
+    /**
+     * The policy object was found in the cluster
+     *
+     * @generated from protobuf field: bool is_resolved = 5
+     */
+    isResolved: boolean;
+    /**
+     * The policy has L7 rules (http, kafka, dns) in any of its port rules
+     *
+     * @generated from protobuf field: bool has_l7_rules = 6
+     */
+    hasL7Rules: boolean;
+    /**
+     * The reason why the policy object was not resolved
+     *
+     * @generated from protobuf field: string resolve_error = 7
+     */
+    resolveError: string;
+}
+/**
+ * @generated from protobuf message ui.PolicyVerdictExplanation
+ */
+export interface PolicyVerdictExplanation {
+    /**
+     * @generated from protobuf field: string flow_uuid = 1
+     */
+    flowUuid: string;
+    /**
+     * @generated from protobuf field: flow.Verdict verdict = 2
+     */
+    verdict: Verdict;
+    /**
+     * @generated from protobuf field: flow.TrafficDirection traffic_direction = 3
+     */
+    trafficDirection: TrafficDirection;
+    /**
+     * @generated from protobuf field: ui.PolicyMatchKind match_kind = 4
+     */
+    matchKind: PolicyMatchKind;
+    /**
+     * @generated from protobuf field: repeated ui.PolicyReference allowed_by = 5
+     */
+    allowedBy: PolicyReference[];
+    /**
+     * @generated from protobuf field: repeated ui.PolicyReference denied_by = 6
+     */
+    deniedBy: PolicyReference[];
+    /**
+     * The flow would have been dropped if policy enforcement was not in
+     * audit mode
+     *
+     * @generated from protobuf field: bool is_audit = 7
+     */
+    isAudit: boolean;
+    /**
+     * Nothing explicitly denied the flow, it was dropped since no policy
+     * allowed it
+     *
+     * @generated from protobuf field: bool is_default_deny = 8
+     */
+    isDefaultDeny: boolean;
+    /**
+     * One line human readable explanation
+     *
+     * @generated from protobuf field: string summary = 9
+     */
+    summary: string;
+    /**
+     * @generated from protobuf field: repeated string details = 10
+     */
+    details: string[];
+}
+/**
+ * @generated from protobuf message ui.PolicyDraftsRequest
+ */
+export interface PolicyDraftsRequest {
+    /**
+     * @generated from protobuf field: string namespace = 1
+     */
+    namespace: string;
+    /**
+     * Only flows observed within the window are used, unset bound means that
+     * the window is not limited from that side
+     *
+     * @generated from protobuf field: google.protobuf.Timestamp since = 2
+     */
+    since?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp until = 3
+     */
+    until?: Timestamp;
+}
+/**
+ * @generated from protobuf message ui.PolicyDraftsResponse
+ */
+export interface PolicyDraftsResponse {
+    /**
+     * @generated from protobuf field: repeated ui.PolicyDraft drafts = 1
+     */
+    drafts: PolicyDraft[];
+    /**
+     * The number of flows the drafts are generated from
+     *
+     * @generated from protobuf field: uint32 flows_number = 2
+     */
+    flowsNumber: number;
+}
+/**
+ * @generated from protobuf message ui.PolicyDraft
+ */
+export interface PolicyDraft {
+    /**
+     * @generated from protobuf field: string name = 1
+     */
+    name: string;
+    /**
+     * @generated from protobuf field: string namespace = 2
+     */
+    namespace: string;
+    /**
+     * The id of the service (map card) selected by the policy
+     *
+     * @generated from protobuf field: string service_id = 3
+     */
+    serviceId: string;
+    /**
+     * CiliumNetworkPolicy manifest
+     *
+     * @generated from protobuf field: string yaml = 4
+     */
+    yaml: string;
+}
+/**
+ * @generated from protobuf message ui.PolicySimulationRequest
+ */
+export interface PolicySimulationRequest {
+    /**
+     * @generated from protobuf field: string namespace = 1
+     */
+    namespace: string;
+    /**
+     * CiliumNetworkPolicy, CiliumClusterwideNetworkPolicy or NetworkPolicy
+     * manifests, several documents are allowed
+     *
+     * @generated from protobuf field: string yaml = 2
+     */
+    yaml: string;
+}
+/**
+ * @generated from protobuf message ui.PolicySimulationResponse
+ */
+export interface PolicySimulationResponse {
+    /**
+     * Only the links which verdict would be changed by the draft
+     *
+     * @generated from protobuf field: repeated ui.SimulatedLink links = 1
+     */
+    links: SimulatedLink[];
+    /**
+     * @generated from protobuf field: uint32 rules_number = 2
+     */
+    rulesNumber: number;
+    /**
+     * @generated from protobuf field: uint32 links_number = 3
+     */
+    linksNumber: number;
+    /**
+     * Draft policies cannot be parsed, nothing is simulated
+     *
+     * @generated from protobuf field: string error = 4
+     */
+    error: string;
+}
+/**
+ * @generated from protobuf message ui.SimulatedLink
+ */
+export interface SimulatedLink {
+    /**
+     * @generated from protobuf field: string link_id = 1
+     */
+    linkId: string;
+    /**
+     * @generated from protobuf field: string source_id = 2
+     */
+    sourceId: string;
+    /**
+     * @generated from protobuf field: string destination_id = 3
+     */
+    destinationId: string;
+    /**
+     * @generated from protobuf field: uint32 destination_port = 4
+     */
+    destinationPort: number;
+    /**
+     * @generated from protobuf field: ui.IPProtocol ip_protocol = 5
+     */
+    ipProtocol: IPProtocol;
+    /**
+     * @generated from protobuf field: flow.Verdict current_verdict = 6
+     */
+    currentVerdict: Verdict;
+    /**
+     * @generated from protobuf field: flow.Verdict simulated_verdict = 7
+     */
+    simulatedVerdict: Verdict;
+    /**
+     * @generated from protobuf field: ui.PolicyImpact impact = 8
+     */
+    impact: PolicyImpact;
+    /**
+     * Names of the draft policies that cause the change
+     *
+     * @generated from protobuf field: repeated string policies = 9
+     */
+    policies: string[];
+    /**
+     * @generated from protobuf field: string reason = 10
+     */
+    reason: string;
+}
+/**
+ * @generated from protobuf enum ui.PolicyMatchKind
+ */
+export enum PolicyMatchKind {
+    /**
+     * @generated from protobuf enum value: UNKNOWN_POLICY_MATCH = 0;
+     */
+    UNKNOWN_POLICY_MATCH = 0,
+    /**
+     * @generated from protobuf enum value: NO_POLICY_MATCH = 1;
+     */
+    NO_POLICY_MATCH = 1,
+    /**
+     * @generated from protobuf enum value: L3_ONLY = 2;
+     */
+    L3_ONLY = 2,
+    /**
+     * @generated from protobuf enum value: L3_L4 = 3;
+     */
+    L3_L4 = 3,
+    /**
+     * @generated from protobuf enum value: L4_ONLY = 4;
+     */
+    L4_ONLY = 4,
+    /**
+     * @generated from protobuf enum value: ALLOW_ALL = 5;
+     */
+    ALLOW_ALL = 5,
+    /**
+     * @generated from protobuf enum value: L3_PROTO = 6;
+     */
+    L3_PROTO = 6,
+    /**
+     * @generated from protobuf enum value: PROTO_ONLY = 7;
+     */
+    PROTO_ONLY = 7,
+    /**
+     * @generated from protobuf enum value: L7 = 8;
+     */
+    L7 = 8
+}
+/**
+ * @generated from protobuf enum ui.PolicyImpact
+ */
+export enum PolicyImpact {
+    /**
+     * @generated from protobuf enum value: UNCHANGED_IMPACT = 0;
+     */
+    UNCHANGED_IMPACT = 0,
+    /**
+     * @generated from protobuf enum value: WOULD_BE_DROPPED = 1;
+     */
+    WOULD_BE_DROPPED = 1,
+    /**
+     * @generated from protobuf enum value: WOULD_BE_ALLOWED = 2;
+     */
+    WOULD_BE_ALLOWED = 2
+}
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicyVerdictExplanationRequest$Type extends MessageType<PolicyVerdictExplanationRequest> {
+    constructor() {
+        super("ui.PolicyVerdictExplanationRequest", [
+            { no: 1, name: "flow", kind: "message", oneof: "subject", T: () => Flow },
+            { no: 2, name: "flow_uuid", kind: "scalar", oneof: "subject", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "link_id", kind: "scalar", oneof: "subject", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<PolicyVerdictExplanationRequest>): PolicyVerdictExplanationRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.subject = { oneofKind: undefined };
+        if (value !== undefined)
+            reflectionMergePartial<PolicyVerdictExplanationRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicyVerdictExplanationRequest): PolicyVerdictExplanationRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* flow.Flow flow */ 1:
+                    message.subject = {
+                        oneofKind: "flow",
+                        flow: Flow.internalBinaryRead(reader, reader.uint32(), options, (message.subject as any).flow)
+                    };
+                    break;
+                case /* string flow_uuid */ 2:
+                    message.subject = {
+                        oneofKind: "flowUuid",
+                        flowUuid: reader.string()
+                    };
+                    break;
+                case /* string link_id */ 3:
+                    message.subject = {
+                        oneofKind: "linkId",
+                        linkId: reader.string()
+                    };
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicyVerdictExplanationRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* flow.Flow flow = 1; */
+        if (message.subject.oneofKind === "flow")
+            Flow.internalBinaryWrite(message.subject.flow, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* string flow_uuid = 2; */
+        if (message.subject.oneofKind === "flowUuid")
+            writer.tag(2, WireType.LengthDelimited).string(message.subject.flowUuid);
+        /* string link_id = 3; */
+        if (message.subject.oneofKind === "linkId")
+            writer.tag(3, WireType.LengthDelimited).string(message.subject.linkId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicyVerdictExplanationRequest
+ */
+export const PolicyVerdictExplanationRequest = new PolicyVerdictExplanationRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicyVerdictExplanationResponse$Type extends MessageType<PolicyVerdictExplanationResponse> {
+    constructor() {
+        super("ui.PolicyVerdictExplanationResponse", [
+            { no: 1, name: "explanation", kind: "message", T: () => PolicyVerdictExplanation }
+        ]);
+    }
+    create(value?: PartialMessage<PolicyVerdictExplanationResponse>): PolicyVerdictExplanationResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<PolicyVerdictExplanationResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicyVerdictExplanationResponse): PolicyVerdictExplanationResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.PolicyVerdictExplanation explanation */ 1:
+                    message.explanation = PolicyVerdictExplanation.internalBinaryRead(reader, reader.uint32(), options, message.explanation);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicyVerdictExplanationResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.PolicyVerdictExplanation explanation = 1; */
+        if (message.explanation)
+            PolicyVerdictExplanation.internalBinaryWrite(message.explanation, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicyVerdictExplanationResponse
+ */
+export const PolicyVerdictExplanationResponse = new PolicyVerdictExplanationResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicyReference$Type extends MessageType<PolicyReference> {
+    constructor() {
+        super("ui.PolicyReference", [
+            { no: 1, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "kind", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "revision", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 5, name: "is_resolved", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "has_l7_rules", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 7, name: "resolve_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<PolicyReference>): PolicyReference {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.name = "";
+        message.namespace = "";
+        message.kind = "";
+        message.revision = 0n;
+        message.isResolved = false;
+        message.hasL7Rules = false;
+        message.resolveError = "";
+        if (value !== undefined)
+            reflectionMergePartial<PolicyReference>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicyReference): PolicyReference {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string name */ 1:
+                    message.name = reader.string();
+                    break;
+                case /* string namespace */ 2:
+                    message.namespace = reader.string();
+                    break;
+                case /* string kind */ 3:
+                    message.kind = reader.string();
+                    break;
+                case /* uint64 revision */ 4:
+                    message.revision = reader.uint64().toBigInt();
+                    break;
+                case /* bool is_resolved */ 5:
+                    message.isResolved = reader.bool();
+                    break;
+                case /* bool has_l7_rules */ 6:
+                    message.hasL7Rules = reader.bool();
+                    break;
+                case /* string resolve_error */ 7:
+                    message.resolveError = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicyReference, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string name = 1; */
+        if (message.name !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.name);
+        /* string namespace = 2; */
+        if (message.namespace !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.namespace);
+        /* string kind = 3; */
+        if (message.kind !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.kind);
+        /* uint64 revision = 4; */
+        if (message.revision !== 0n)
+            writer.tag(4, WireType.Varint).uint64(message.revision);
+        /* bool is_resolved = 5; */
+        if (message.isResolved !== false)
+            writer.tag(5, WireType.Varint).bool(message.isResolved);
+        /* bool has_l7_rules = 6; */
+        if (message.hasL7Rules !== false)
+            writer.tag(6, WireType.Varint).bool(message.hasL7Rules);
+        /* string resolve_error = 7; */
+        if (message.resolveError !== "")
+            writer.tag(7, WireType.LengthDelimited).string(message.resolveError);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicyReference
+ */
+export const PolicyReference = new PolicyReference$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicyVerdictExplanation$Type extends MessageType<PolicyVerdictExplanation> {
+    constructor() {
+        super("ui.PolicyVerdictExplanation", [
+            { no: 1, name: "flow_uuid", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "verdict", kind: "enum", T: () => ["flow.Verdict", Verdict] },
+            { no: 3, name: "traffic_direction", kind: "enum", T: () => ["flow.TrafficDirection", TrafficDirection] },
+            { no: 4, name: "match_kind", kind: "enum", T: () => ["ui.PolicyMatchKind", PolicyMatchKind] },
+            { no: 5, name: "allowed_by", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => PolicyReference },
+            { no: 6, name: "denied_by", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => PolicyReference },
+            { no: 7, name: "is_audit", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 8, name: "is_default_deny", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 9, name: "summary", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 10, name: "details", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<PolicyVerdictExplanation>): PolicyVerdictExplanation {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.flowUuid = "";
+        message.verdict = 0;
+        message.trafficDirection = 0;
+        message.matchKind = 0;
+        message.allowedBy = [];
+        message.deniedBy = [];
+        message.isAudit = false;
+        message.isDefaultDeny = false;
+        message.summary = "";
+        message.details = [];
+        if (value !== undefined)
+            reflectionMergePartial<PolicyVerdictExplanation>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicyVerdictExplanation): PolicyVerdictExplanation {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string flow_uuid */ 1:
+                    message.flowUuid = reader.string();
+                    break;
+                case /* flow.Verdict verdict */ 2:
+                    message.verdict = reader.int32();
+                    break;
+                case /* flow.TrafficDirection traffic_direction */ 3:
+                    message.trafficDirection = reader.int32();
+                    break;
+                case /* ui.PolicyMatchKind match_kind */ 4:
+                    message.matchKind = reader.int32();
+                    break;
+                case /* repeated ui.PolicyReference allowed_by */ 5:
+                    message.allowedBy.push(PolicyReference.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated ui.PolicyReference denied_by */ 6:
+                    message.deniedBy.push(PolicyReference.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* bool is_audit */ 7:
+                    message.isAudit = reader.bool();
+                    break;
+                case /* bool is_default_deny */ 8:
+                    message.isDefaultDeny = reader.bool();
+                    break;
+                case /* string summary */ 9:
+                    message.summary = reader.string();
+                    break;
+                case /* repeated string details */ 10:
+                    message.details.push(reader.string());
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicyVerdictExplanation, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string flow_uuid = 1; */
+        if (message.flowUuid !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.flowUuid);
+        /* flow.Verdict verdict = 2; */
+        if (message.verdict !== 0)
+            writer.tag(2, WireType.Varint).int32(message.verdict);
+        /* flow.TrafficDirection traffic_direction = 3; */
+        if (message.trafficDirection !== 0)
+            writer.tag(3, WireType.Varint).int32(message.trafficDirection);
+        /* ui.PolicyMatchKind match_kind = 4; */
+        if (message.matchKind !== 0)
+            writer.tag(4, WireType.Varint).int32(message.matchKind);
+        /* repeated ui.PolicyReference allowed_by = 5; */
+        for (let i = 0; i < message.allowedBy.length; i++)
+            PolicyReference.internalBinaryWrite(message.allowedBy[i], writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.PolicyReference denied_by = 6; */
+        for (let i = 0; i < message.deniedBy.length; i++)
+            PolicyReference.internalBinaryWrite(message.deniedBy[i], writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* bool is_audit = 7; */
+        if (message.isAudit !== false)
+            writer.tag(7, WireType.Varint).bool(message.isAudit);
+        /* bool is_default_deny = 8; */
+        if (message.isDefaultDeny !== false)
+            writer.tag(8, WireType.Varint).bool(message.isDefaultDeny);
+        /* string summary = 9; */
+        if (message.summary !== "")
+            writer.tag(9, WireType.LengthDelimited).string(message.summary);
+        /* repeated string details = 10; */
+        for (let i = 0; i < message.details.length; i++)
+            writer.tag(10, WireType.LengthDelimited).string(message.details[i]);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicyVerdictExplanation
+ */
+export const PolicyVerdictExplanation = new PolicyVerdictExplanation$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicyDraftsRequest$Type extends MessageType<PolicyDraftsRequest> {
+    constructor() {
+        super("ui.PolicyDraftsRequest", [
+            { no: 1, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "since", kind: "message", T: () => Timestamp },
+            { no: 3, name: "until", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<PolicyDraftsRequest>): PolicyDraftsRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.namespace = "";
+        if (value !== undefined)
+            reflectionMergePartial<PolicyDraftsRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicyDraftsRequest): PolicyDraftsRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string namespace */ 1:
+                    message.namespace = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp since */ 2:
+                    message.since = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.since);
+                    break;
+                case /* google.protobuf.Timestamp until */ 3:
+                    message.until = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.until);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicyDraftsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string namespace = 1; */
+        if (message.namespace !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.namespace);
+        /* google.protobuf.Timestamp since = 2; */
+        if (message.since)
+            Timestamp.internalBinaryWrite(message.since, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp until = 3; */
+        if (message.until)
+            Timestamp.internalBinaryWrite(message.until, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicyDraftsRequest
+ */
+export const PolicyDraftsRequest = new PolicyDraftsRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicyDraftsResponse$Type extends MessageType<PolicyDraftsResponse> {
+    constructor() {
+        super("ui.PolicyDraftsResponse", [
+            { no: 1, name: "drafts", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => PolicyDraft },
+            { no: 2, name: "flows_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+    create(value?: PartialMessage<PolicyDraftsResponse>): PolicyDraftsResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.drafts = [];
+        message.flowsNumber = 0;
+        if (value !== undefined)
+            reflectionMergePartial<PolicyDraftsResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicyDraftsResponse): PolicyDraftsResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated ui.PolicyDraft drafts */ 1:
+                    message.drafts.push(PolicyDraft.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* uint32 flows_number */ 2:
+                    message.flowsNumber = reader.uint32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicyDraftsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated ui.PolicyDraft drafts = 1; */
+        for (let i = 0; i < message.drafts.length; i++)
+            PolicyDraft.internalBinaryWrite(message.drafts[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* uint32 flows_number = 2; */
+        if (message.flowsNumber !== 0)
+            writer.tag(2, WireType.Varint).uint32(message.flowsNumber);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicyDraftsResponse
+ */
+export const PolicyDraftsResponse = new PolicyDraftsResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicyDraft$Type extends MessageType<PolicyDraft> {
+    constructor() {
+        super("ui.PolicyDraft", [
+            { no: 1, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "service_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "yaml", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<PolicyDraft>): PolicyDraft {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.name = "";
+        message.namespace = "";
+        message.serviceId = "";
+        message.yaml = "";
+        if (value !== undefined)
+            reflectionMergePartial<PolicyDraft>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicyDraft): PolicyDraft {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string name */ 1:
+                    message.name = reader.string();
+                    break;
+                case /* string namespace */ 2:
+                    message.namespace = reader.string();
+                    break;
+                case /* string service_id */ 3:
+                    message.serviceId = reader.string();
+                    break;
+                case /* string yaml */ 4:
+                    message.yaml = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicyDraft, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string name = 1; */
+        if (message.name !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.name);
+        /* string namespace = 2; */
+        if (message.namespace !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.namespace);
+        /* string service_id = 3; */
+        if (message.serviceId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.serviceId);
+        /* string yaml = 4; */
+        if (message.yaml !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.yaml);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicyDraft
+ */
+export const PolicyDraft = new PolicyDraft$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicySimulationRequest$Type extends MessageType<PolicySimulationRequest> {
+    constructor() {
+        super("ui.PolicySimulationRequest", [
+            { no: 1, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "yaml", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<PolicySimulationRequest>): PolicySimulationRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.namespace = "";
+        message.yaml = "";
+        if (value !== undefined)
+            reflectionMergePartial<PolicySimulationRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicySimulationRequest): PolicySimulationRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string namespace */ 1:
+                    message.namespace = reader.string();
+                    break;
+                case /* string yaml */ 2:
+                    message.yaml = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicySimulationRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string namespace = 1; */
+        if (message.namespace !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.namespace);
+        /* string yaml = 2; */
+        if (message.yaml !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.yaml);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicySimulationRequest
+ */
+export const PolicySimulationRequest = new PolicySimulationRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class PolicySimulationResponse$Type extends MessageType<PolicySimulationResponse> {
+    constructor() {
+        super("ui.PolicySimulationResponse", [
+            { no: 1, name: "links", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => SimulatedLink },
+            { no: 2, name: "rules_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 3, name: "links_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 4, name: "error", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<PolicySimulationResponse>): PolicySimulationResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.links = [];
+        message.rulesNumber = 0;
+        message.linksNumber = 0;
+        message.error = "";
+        if (value !== undefined)
+            reflectionMergePartial<PolicySimulationResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PolicySimulationResponse): PolicySimulationResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated ui.SimulatedLink links */ 1:
+                    message.links.push(SimulatedLink.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* uint32 rules_number */ 2:
+                    message.rulesNumber = reader.uint32();
+                    break;
+                case /* uint32 links_number */ 3:
+                    message.linksNumber = reader.uint32();
+                    break;
+                case /* string error */ 4:
+                    message.error = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: PolicySimulationResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated ui.SimulatedLink links = 1; */
+        for (let i = 0; i < message.links.length; i++)
+            SimulatedLink.internalBinaryWrite(message.links[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* uint32 rules_number = 2; */
+        if (message.rulesNumber !== 0)
+            writer.tag(2, WireType.Varint).uint32(message.rulesNumber);
+        /* uint32 links_number = 3; */
+        if (message.linksNumber !== 0)
+            writer.tag(3, WireType.Varint).uint32(message.linksNumber);
+        /* string error = 4; */
+        if (message.error !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.error);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.PolicySimulationResponse
+ */
+export const PolicySimulationResponse = new PolicySimulationResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class SimulatedLink$Type extends MessageType<SimulatedLink> {
+    constructor() {
+        super("ui.SimulatedLink", [
+            { no: 1, name: "link_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "source_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "destination_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "destination_port", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 5, name: "ip_protocol", kind: "enum", T: () => ["ui.IPProtocol", IPProtocol] },
+            { no: 6, name: "current_verdict", kind: "enum", T: () => ["flow.Verdict", Verdict] },
+            { no: 7, name: "simulated_verdict", kind: "enum", T: () => ["flow.Verdict", Verdict] },
+            { no: 8, name: "impact", kind: "enum", T: () => ["ui.PolicyImpact", PolicyImpact] },
+            { no: 9, name: "policies", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 10, name: "reason", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<SimulatedLink>): SimulatedLink {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.linkId = "";
+        message.sourceId = "";
+        message.destinationId = "";
+        message.destinationPort = 0;
+        message.ipProtocol = 0;
+        message.currentVerdict = 0;
+        message.simulatedVerdict = 0;
+        message.impact = 0;
+        message.policies = [];
+        message.reason = "";
+        if (value !== undefined)
+            reflectionMergePartial<SimulatedLink>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SimulatedLink): SimulatedLink {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string link_id */ 1:
+                    message.linkId = reader.string();
+                    break;
+                case /* string source_id */ 2:
+                    message.sourceId = reader.string();
+                    break;
+                case /* string destination_id */ 3:
+                    message.destinationId = reader.string();
+                    break;
+                case /* uint32 destination_port */ 4:
+                    message.destinationPort = reader.uint32();
+                    break;
+                case /* ui.IPProtocol ip_protocol */ 5:
+                    message.ipProtocol = reader.int32();
+                    break;
+                case /* flow.Verdict current_verdict */ 6:
+                    message.currentVerdict = reader.int32();
+                    break;
+                case /* flow.Verdict simulated_verdict */ 7:
+                    message.simulatedVerdict = reader.int32();
+                    break;
+                case /* ui.PolicyImpact impact */ 8:
+                    message.impact = reader.int32();
+                    break;
+                case /* repeated string policies */ 9:
+                    message.policies.push(reader.string());
+                    break;
+                case /* string reason */ 10:
+                    message.reason = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: SimulatedLink, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string link_id = 1; */
+        if (message.linkId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.linkId);
+        /* string source_id = 2; */
+        if (message.sourceId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.sourceId);
+        /* string destination_id = 3; */
+        if (message.destinationId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.destinationId);
+        /* uint32 destination_port = 4; */
+        if (message.destinationPort !== 0)
+            writer.tag(4, WireType.Varint).uint32(message.destinationPort);
+        /* ui.IPProtocol ip_protocol = 5; */
+        if (message.ipProtocol !== 0)
+            writer.tag(5, WireType.Varint).int32(message.ipProtocol);
+        /* flow.Verdict current_verdict = 6; */
+        if (message.currentVerdict !== 0)
+            writer.tag(6, WireType.Varint).int32(message.currentVerdict);
+        /* flow.Verdict simulated_verdict = 7; */
+        if (message.simulatedVerdict !== 0)
+            writer.tag(7, WireType.Varint).int32(message.simulatedVerdict);
+        /* ui.PolicyImpact impact = 8; */
+        if (message.impact !== 0)
+            writer.tag(8, WireType.Varint).int32(message.impact);
+        /* repeated string policies = 9; */
+        for (let i = 0; i < message.policies.length; i++)
+            writer.tag(9, WireType.LengthDelimited).string(message.policies[i]);
+        /* string reason = 10; */
+        if (message.reason !== "")
+            writer.tag(10, WireType.LengthDelimited).string(message.reason);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.SimulatedLink
+ */
+export const SimulatedLink = new SimulatedLink$Type();
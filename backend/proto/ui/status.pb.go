@@ -59,11 +59,14 @@ func (*GetStatusRequest) Descriptor() ([]byte, []int) {
 }
 
 type GetStatusResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Nodes         *observer.GetNodesResponse     `protobuf:"bytes,1,opt,name=nodes,proto3" json:"nodes,omitempty"`
-	ServerStatus  *observer.ServerStatusResponse `protobuf:"bytes,2,opt,name=server_status,json=serverStatus,proto3" json:"server_status,omitempty"`
-	Versions      []*DeployedComponent           `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
-	Flows         *FlowStats                     `protobuf:"bytes,4,opt,name=flows,proto3" json:"flows,omitempty"`
+	state        protoimpl.MessageState         `protogen:"open.v1"`
+	Nodes        *observer.GetNodesResponse     `protobuf:"bytes,1,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	ServerStatus *observer.ServerStatusResponse `protobuf:"bytes,2,opt,name=server_status,json=serverStatus,proto3" json:"server_status,omitempty"`
+	Versions     []*DeployedComponent           `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+	Flows        *FlowStats                     `protobuf:"bytes,4,opt,name=flows,proto3" json:"flows,omitempty"`
+	// Availability of every node seen by backend, nodes that are gone from
+	// hubble-relay response are reported as unavailable
+	NodeStatuses  []*NodeStatus `protobuf:"bytes,5,rep,name=node_statuses,json=nodeStatuses,proto3" json:"node_statuses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +129,13 @@ func (x *GetStatusResponse) GetFlows() *FlowStats {
 	return nil
 }
 
+func (x *GetStatusResponse) GetNodeStatuses() []*NodeStatus {
+	if x != nil {
+		return x.NodeStatuses
+	}
+	return nil
+}
+
 type NodeStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -231,8 +241,12 @@ func (x *DeployedComponent) GetVersion() string {
 }
 
 type FlowStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PerSecond     float32                `protobuf:"fixed32,1,opt,name=per_second,json=perSecond,proto3" json:"per_second,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PerSecond float32                `protobuf:"fixed32,1,opt,name=per_second,json=perSecond,proto3" json:"per_second,omitempty"`
+	// Rates of flows processed by backend, sorted from the highest one
+	PerNamespace  []*FlowRate `protobuf:"bytes,2,rep,name=per_namespace,json=perNamespace,proto3" json:"per_namespace,omitempty"`
+	PerNode       []*FlowRate `protobuf:"bytes,3,rep,name=per_node,json=perNode,proto3" json:"per_node,omitempty"`
+	PerVerdict    []*FlowRate `protobuf:"bytes,4,rep,name=per_verdict,json=perVerdict,proto3" json:"per_verdict,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -274,27 +288,109 @@ func (x *FlowStats) GetPerSecond() float32 {
 	return 0
 }
 
+func (x *FlowStats) GetPerNamespace() []*FlowRate {
+	if x != nil {
+		return x.PerNamespace
+	}
+	return nil
+}
+
+func (x *FlowStats) GetPerNode() []*FlowRate {
+	if x != nil {
+		return x.PerNode
+	}
+	return nil
+}
+
+func (x *FlowStats) GetPerVerdict() []*FlowRate {
+	if x != nil {
+		return x.PerVerdict
+	}
+	return nil
+}
+
+type FlowRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PerSecond     float32                `protobuf:"fixed32,2,opt,name=per_second,json=perSecond,proto3" json:"per_second,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowRate) Reset() {
+	*x = FlowRate{}
+	mi := &file_ui_status_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowRate) ProtoMessage() {}
+
+func (x *FlowRate) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_status_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowRate.ProtoReflect.Descriptor instead.
+func (*FlowRate) Descriptor() ([]byte, []int) {
+	return file_ui_status_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FlowRate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FlowRate) GetPerSecond() float32 {
+	if x != nil {
+		return x.PerSecond
+	}
+	return 0
+}
+
 var File_ui_status_proto protoreflect.FileDescriptor
 
 const file_ui_status_proto_rawDesc = "" +
 	"\n" +
 	"\x0fui/status.proto\x12\x02ui\x1a\x17observer/observer.proto\"\x12\n" +
-	"\x10GetStatusRequest\"\xe2\x01\n" +
+	"\x10GetStatusRequest\"\x97\x02\n" +
 	"\x11GetStatusResponse\x120\n" +
 	"\x05nodes\x18\x01 \x01(\v2\x1a.observer.GetNodesResponseR\x05nodes\x12C\n" +
 	"\rserver_status\x18\x02 \x01(\v2\x1e.observer.ServerStatusResponseR\fserverStatus\x121\n" +
 	"\bversions\x18\x03 \x03(\v2\x15.ui.DeployedComponentR\bversions\x12#\n" +
-	"\x05flows\x18\x04 \x01(\v2\r.ui.FlowStatsR\x05flows\"C\n" +
+	"\x05flows\x18\x04 \x01(\v2\r.ui.FlowStatsR\x05flows\x123\n" +
+	"\rnode_statuses\x18\x05 \x03(\v2\x0e.ui.NodeStatusR\fnodeStatuses\"C\n" +
 	"\n" +
 	"NodeStatus\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fis_available\x18\x02 \x01(\bR\visAvailable\"A\n" +
 	"\x11DeployedComponent\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\"*\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"\xb5\x01\n" +
 	"\tFlowStats\x12\x1d\n" +
 	"\n" +
-	"per_second\x18\x01 \x01(\x02R\tperSecondb\x06proto3"
+	"per_second\x18\x01 \x01(\x02R\tperSecond\x121\n" +
+	"\rper_namespace\x18\x02 \x03(\v2\f.ui.FlowRateR\fperNamespace\x12'\n" +
+	"\bper_node\x18\x03 \x03(\v2\f.ui.FlowRateR\aperNode\x12-\n" +
+	"\vper_verdict\x18\x04 \x03(\v2\f.ui.FlowRateR\n" +
+	"perVerdict\";\n" +
+	"\bFlowRate\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"per_second\x18\x02 \x01(\x02R\tperSecondb\x06proto3"
 
 var (
 	file_ui_status_proto_rawDescOnce sync.Once
@@ -308,26 +404,31 @@ func file_ui_status_proto_rawDescGZIP() []byte {
 	return file_ui_status_proto_rawDescData
 }
 
-var file_ui_status_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ui_status_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_ui_status_proto_goTypes = []any{
 	(*GetStatusRequest)(nil),              // 0: ui.GetStatusRequest
 	(*GetStatusResponse)(nil),             // 1: ui.GetStatusResponse
 	(*NodeStatus)(nil),                    // 2: ui.NodeStatus
 	(*DeployedComponent)(nil),             // 3: ui.DeployedComponent
 	(*FlowStats)(nil),                     // 4: ui.FlowStats
-	(*observer.GetNodesResponse)(nil),     // 5: observer.GetNodesResponse
-	(*observer.ServerStatusResponse)(nil), // 6: observer.ServerStatusResponse
+	(*FlowRate)(nil),                      // 5: ui.FlowRate
+	(*observer.GetNodesResponse)(nil),     // 6: observer.GetNodesResponse
+	(*observer.ServerStatusResponse)(nil), // 7: observer.ServerStatusResponse
 }
 var file_ui_status_proto_depIdxs = []int32{
-	5, // 0: ui.GetStatusResponse.nodes:type_name -> observer.GetNodesResponse
-	6, // 1: ui.GetStatusResponse.server_status:type_name -> observer.ServerStatusResponse
+	6, // 0: ui.GetStatusResponse.nodes:type_name -> observer.GetNodesResponse
+	7, // 1: ui.GetStatusResponse.server_status:type_name -> observer.ServerStatusResponse
 	3, // 2: ui.GetStatusResponse.versions:type_name -> ui.DeployedComponent
 	4, // 3: ui.GetStatusResponse.flows:type_name -> ui.FlowStats
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 4: ui.GetStatusResponse.node_statuses:type_name -> ui.NodeStatus
+	5, // 5: ui.FlowStats.per_namespace:type_name -> ui.FlowRate
+	5, // 6: ui.FlowStats.per_node:type_name -> ui.FlowRate
+	5, // 7: ui.FlowStats.per_verdict:type_name -> ui.FlowRate
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_ui_status_proto_init() }
@@ -341,7 +442,7 @@ func file_ui_status_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ui_status_proto_rawDesc), len(file_ui_status_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -39,6 +39,13 @@ export interface GetStatusResponse {
      * @generated from protobuf field: ui.FlowStats flows = 4
      */
     flows?: FlowStats;
+    /**
+     * Availability of every node seen by backend, nodes that are gone from
+     * hubble-relay response are reported as unavailable
+     *
+     * @generated from protobuf field: repeated ui.NodeStatus node_statuses = 5
+     */
+    nodeStatuses: NodeStatus[];
 }
 /**
  * @generated from protobuf message ui.NodeStatus
@@ -74,6 +81,33 @@ export interface FlowStats {
      * @generated from protobuf field: float per_second = 1
      */
     perSecond: number;
+    /**
+     * Rates of flows processed by backend, sorted from the highest one
+     *
+     * @generated from protobuf field: repeated ui.FlowRate per_namespace = 2
+     */
+    perNamespace: FlowRate[];
+    /**
+     * @generated from protobuf field: repeated ui.FlowRate per_node = 3
+     */
+    perNode: FlowRate[];
+    /**
+     * @generated from protobuf field: repeated ui.FlowRate per_verdict = 4
+     */
+    perVerdict: FlowRate[];
+}
+/**
+ * @generated from protobuf message ui.FlowRate
+ */
+export interface FlowRate {
+    /**
+     * @generated from protobuf field: string key = 1
+     */
+    key: string;
+    /**
+     * @generated from protobuf field: float per_second = 2
+     */
+    perSecond: number;
 }
 // @generated message type with reflection information, may provide speed optimized methods
 class GetStatusRequest$Type extends MessageType<GetStatusRequest> {
@@ -120,12 +154,14 @@ class GetStatusResponse$Type extends MessageType<GetStatusResponse> {
             { no: 1, name: "nodes", kind: "message", T: () => GetNodesResponse },
             { no: 2, name: "server_status", kind: "message", T: () => ServerStatusResponse },
             { no: 3, name: "versions", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DeployedComponent },
-            { no: 4, name: "flows", kind: "message", T: () => FlowStats }
+            { no: 4, name: "flows", kind: "message", T: () => FlowStats },
+            { no: 5, name: "node_statuses", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => NodeStatus }
         ]);
     }
     create(value?: PartialMessage<GetStatusResponse>): GetStatusResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.versions = [];
+        message.nodeStatuses = [];
         if (value !== undefined)
             reflectionMergePartial<GetStatusResponse>(this, message, value);
         return message;
@@ -147,6 +183,9 @@ class GetStatusResponse$Type extends MessageType<GetStatusResponse> {
                 case /* ui.FlowStats flows */ 4:
                     message.flows = FlowStats.internalBinaryRead(reader, reader.uint32(), options, message.flows);
                     break;
+                case /* repeated ui.NodeStatus node_statuses */ 5:
+                    message.nodeStatuses.push(NodeStatus.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -171,6 +210,9 @@ class GetStatusResponse$Type extends MessageType<GetStatusResponse> {
         /* ui.FlowStats flows = 4; */
         if (message.flows)
             FlowStats.internalBinaryWrite(message.flows, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.NodeStatus node_statuses = 5; */
+        for (let i = 0; i < message.nodeStatuses.length; i++)
+            NodeStatus.internalBinaryWrite(message.nodeStatuses[i], writer.tag(5, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -295,12 +337,18 @@ export const DeployedComponent = new DeployedComponent$Type();
 class FlowStats$Type extends MessageType<FlowStats> {
     constructor() {
         super("ui.FlowStats", [
-            { no: 1, name: "per_second", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ }
+            { no: 1, name: "per_second", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ },
+            { no: 2, name: "per_namespace", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => FlowRate },
+            { no: 3, name: "per_node", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => FlowRate },
+            { no: 4, name: "per_verdict", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => FlowRate }
         ]);
     }
     create(value?: PartialMessage<FlowStats>): FlowStats {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.perSecond = 0;
+        message.perNamespace = [];
+        message.perNode = [];
+        message.perVerdict = [];
         if (value !== undefined)
             reflectionMergePartial<FlowStats>(this, message, value);
         return message;
@@ -313,6 +361,15 @@ class FlowStats$Type extends MessageType<FlowStats> {
                 case /* float per_second */ 1:
                     message.perSecond = reader.float();
                     break;
+                case /* repeated ui.FlowRate per_namespace */ 2:
+                    message.perNamespace.push(FlowRate.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated ui.FlowRate per_node */ 3:
+                    message.perNode.push(FlowRate.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated ui.FlowRate per_verdict */ 4:
+                    message.perVerdict.push(FlowRate.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -328,6 +385,15 @@ class FlowStats$Type extends MessageType<FlowStats> {
         /* float per_second = 1; */
         if (message.perSecond !== 0)
             writer.tag(1, WireType.Bit32).float(message.perSecond);
+        /* repeated ui.FlowRate per_namespace = 2; */
+        for (let i = 0; i < message.perNamespace.length; i++)
+            FlowRate.internalBinaryWrite(message.perNamespace[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.FlowRate per_node = 3; */
+        for (let i = 0; i < message.perNode.length; i++)
+            FlowRate.internalBinaryWrite(message.perNode[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.FlowRate per_verdict = 4; */
+        for (let i = 0; i < message.perVerdict.length; i++)
+            FlowRate.internalBinaryWrite(message.perVerdict[i], writer.tag(4, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -338,3 +404,58 @@ class FlowStats$Type extends MessageType<FlowStats> {
  * @generated MessageType for protobuf message ui.FlowStats
  */
 export const FlowStats = new FlowStats$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class FlowRate$Type extends MessageType<FlowRate> {
+    constructor() {
+        super("ui.FlowRate", [
+            { no: 1, name: "key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "per_second", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ }
+        ]);
+    }
+    create(value?: PartialMessage<FlowRate>): FlowRate {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.key = "";
+        message.perSecond = 0;
+        if (value !== undefined)
+            reflectionMergePartial<FlowRate>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: FlowRate): FlowRate {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string key */ 1:
+                    message.key = reader.string();
+                    break;
+                case /* float per_second */ 2:
+                    message.perSecond = reader.float();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: FlowRate, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string key = 1; */
+        if (message.key !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.key);
+        /* float per_second = 2; */
+        if (message.perSecond !== 0)
+            writer.tag(2, WireType.Bit32).float(message.perSecond);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.FlowRate
+ */
+export const FlowRate = new FlowRate$Type();
@@ -41,34 +41,49 @@ const (
 type EventType int32
 
 const (
-	EventType_UNKNOWN_EVENT       EventType = 0
-	EventType_FLOW                EventType = 1
-	EventType_K8S_NAMESPACE_STATE EventType = 2
-	EventType_SERVICE_STATE       EventType = 3
-	EventType_SERVICE_LINK_STATE  EventType = 4
-	EventType_FLOWS               EventType = 5
-	EventType_STATUS              EventType = 6
+	EventType_UNKNOWN_EVENT          EventType = 0
+	EventType_FLOW                   EventType = 1
+	EventType_K8S_NAMESPACE_STATE    EventType = 2
+	EventType_SERVICE_STATE          EventType = 3
+	EventType_SERVICE_LINK_STATE     EventType = 4
+	EventType_FLOWS                  EventType = 5
+	EventType_STATUS                 EventType = 6
+	EventType_DROP_REASONS           EventType = 7
+	EventType_NAMESPACE_MAP          EventType = 8
+	EventType_K8S_SERVICE_LINK_STATE EventType = 9
+	EventType_SERVICE_ENDPOINTS      EventType = 10
+	EventType_L7_SUMMARY             EventType = 11
 )
 
 // Enum value maps for EventType.
 var (
 	EventType_name = map[int32]string{
-		0: "UNKNOWN_EVENT",
-		1: "FLOW",
-		2: "K8S_NAMESPACE_STATE",
-		3: "SERVICE_STATE",
-		4: "SERVICE_LINK_STATE",
-		5: "FLOWS",
-		6: "STATUS",
+		0:  "UNKNOWN_EVENT",
+		1:  "FLOW",
+		2:  "K8S_NAMESPACE_STATE",
+		3:  "SERVICE_STATE",
+		4:  "SERVICE_LINK_STATE",
+		5:  "FLOWS",
+		6:  "STATUS",
+		7:  "DROP_REASONS",
+		8:  "NAMESPACE_MAP",
+		9:  "K8S_SERVICE_LINK_STATE",
+		10: "SERVICE_ENDPOINTS",
+		11: "L7_SUMMARY",
 	}
 	EventType_value = map[string]int32{
-		"UNKNOWN_EVENT":       0,
-		"FLOW":                1,
-		"K8S_NAMESPACE_STATE": 2,
-		"SERVICE_STATE":       3,
-		"SERVICE_LINK_STATE":  4,
-		"FLOWS":               5,
-		"STATUS":              6,
+		"UNKNOWN_EVENT":          0,
+		"FLOW":                   1,
+		"K8S_NAMESPACE_STATE":    2,
+		"SERVICE_STATE":          3,
+		"SERVICE_LINK_STATE":     4,
+		"FLOWS":                  5,
+		"STATUS":                 6,
+		"DROP_REASONS":           7,
+		"NAMESPACE_MAP":          8,
+		"K8S_SERVICE_LINK_STATE": 9,
+		"SERVICE_ENDPOINTS":      10,
+		"L7_SUMMARY":             11,
 	}
 )
 
@@ -155,6 +170,60 @@ func (IPProtocol) EnumDescriptor() ([]byte, []int) {
 	return file_ui_ui_proto_rawDescGZIP(), []int{1}
 }
 
+// States of cilium endpoints collapsed to the ones that matter on the map
+type EndpointState int32
+
+const (
+	EndpointState_UNKNOWN_ENDPOINT_STATE EndpointState = 0
+	EndpointState_ENDPOINT_READY         EndpointState = 1
+	// Includes waiting for regeneration and restoring
+	EndpointState_ENDPOINT_REGENERATING EndpointState = 2
+	EndpointState_ENDPOINT_NOT_READY    EndpointState = 3
+)
+
+// Enum value maps for EndpointState.
+var (
+	EndpointState_name = map[int32]string{
+		0: "UNKNOWN_ENDPOINT_STATE",
+		1: "ENDPOINT_READY",
+		2: "ENDPOINT_REGENERATING",
+		3: "ENDPOINT_NOT_READY",
+	}
+	EndpointState_value = map[string]int32{
+		"UNKNOWN_ENDPOINT_STATE": 0,
+		"ENDPOINT_READY":         1,
+		"ENDPOINT_REGENERATING":  2,
+		"ENDPOINT_NOT_READY":     3,
+	}
+)
+
+func (x EndpointState) Enum() *EndpointState {
+	p := new(EndpointState)
+	*p = x
+	return p
+}
+
+func (x EndpointState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EndpointState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ui_ui_proto_enumTypes[2].Descriptor()
+}
+
+func (EndpointState) Type() protoreflect.EnumType {
+	return &file_ui_ui_proto_enumTypes[2]
+}
+
+func (x EndpointState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EndpointState.Descriptor instead.
+func (EndpointState) EnumDescriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{2}
+}
+
 type StateChange int32
 
 const (
@@ -196,11 +265,11 @@ func (x StateChange) String() string {
 }
 
 func (StateChange) Descriptor() protoreflect.EnumDescriptor {
-	return file_ui_ui_proto_enumTypes[2].Descriptor()
+	return file_ui_ui_proto_enumTypes[3].Descriptor()
 }
 
 func (StateChange) Type() protoreflect.EnumType {
-	return &file_ui_ui_proto_enumTypes[2]
+	return &file_ui_ui_proto_enumTypes[3]
 }
 
 func (x StateChange) Number() protoreflect.EnumNumber {
@@ -209,7 +278,7 @@ func (x StateChange) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StateChange.Descriptor instead.
 func (StateChange) EnumDescriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{2}
+	return file_ui_ui_proto_rawDescGZIP(), []int{3}
 }
 
 // Here I didn't include "follow", "until", and "number". This request assumes follow,
@@ -224,6 +293,16 @@ type GetEventsRequest struct {
 	Whitelist     []*EventFilter         `protobuf:"bytes,3,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
 	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
 	StatusRequest *GetStatusRequest      `protobuf:"bytes,5,opt,name=status_request,json=statusRequest,proto3" json:"status_request,omitempty"`
+	// Filters in hubble CLI style, e.g. `from-pod=default/api and not port=53`,
+	// the expression is compiled into whitelist/blacklist flow filters and
+	// is joined with the ones above using "and"
+	FilterExpression string `protobuf:"bytes,6,opt,name=filter_expression,json=filterExpression,proto3" json:"filter_expression,omitempty"`
+	// Namespaces and pods the service map is built for, services outside of
+	// the scope are reported as boundary ones
+	Scope *ServiceMapScope `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
+	// ClusterMesh clusters the service map is built for, flows are let
+	// through if either of their sides belongs to one of the clusters
+	Clusters      []string `protobuf:"bytes,8,rep,name=clusters,proto3" json:"clusters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -293,6 +372,92 @@ func (x *GetEventsRequest) GetStatusRequest() *GetStatusRequest {
 	return nil
 }
 
+func (x *GetEventsRequest) GetFilterExpression() string {
+	if x != nil {
+		return x.FilterExpression
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetScope() *ServiceMapScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+// Namespace selector is resolved via k8s API when the stream starts. If pod
+// selector is set, only the pods whose labels it matches within the
+// namespaces are in the scope, otherwise the whole namespaces are.
+type ServiceMapScope struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Namespaces []string               `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Label selector of namespaces added to the ones above, e.g. `team=shop`
+	NamespaceSelector string `protobuf:"bytes,2,opt,name=namespace_selector,json=namespaceSelector,proto3" json:"namespace_selector,omitempty"`
+	// Label selector of pods, e.g. `app in (api, web),tier!=db`
+	PodSelector   string `protobuf:"bytes,3,opt,name=pod_selector,json=podSelector,proto3" json:"pod_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceMapScope) Reset() {
+	*x = ServiceMapScope{}
+	mi := &file_ui_ui_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceMapScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceMapScope) ProtoMessage() {}
+
+func (x *ServiceMapScope) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceMapScope.ProtoReflect.Descriptor instead.
+func (*ServiceMapScope) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServiceMapScope) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *ServiceMapScope) GetNamespaceSelector() string {
+	if x != nil {
+		return x.NamespaceSelector
+	}
+	return ""
+}
+
+func (x *ServiceMapScope) GetPodSelector() string {
+	if x != nil {
+		return x.PodSelector
+	}
+	return ""
+}
+
 type GetEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
@@ -304,7 +469,7 @@ type GetEventsResponse struct {
 
 func (x *GetEventsResponse) Reset() {
 	*x = GetEventsResponse{}
-	mi := &file_ui_ui_proto_msgTypes[1]
+	mi := &file_ui_ui_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +481,7 @@ func (x *GetEventsResponse) String() string {
 func (*GetEventsResponse) ProtoMessage() {}
 
 func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[1]
+	mi := &file_ui_ui_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +494,7 @@ func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
 func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{1}
+	return file_ui_ui_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetEventsResponse) GetNode() string {
@@ -363,6 +528,12 @@ type Event struct {
 	//	*Event_ServiceLinkState
 	//	*Event_Flows
 	//	*Event_Notification
+	//	*Event_NamespaceDropReasons
+	//	*Event_NamespaceNodeState
+	//	*Event_NamespaceLinkState
+	//	*Event_K8SServiceLinkState
+	//	*Event_ServiceEndpointsState
+	//	*Event_LinkL7SummaryState
 	Event         isEvent_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -370,7 +541,7 @@ type Event struct {
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_ui_ui_proto_msgTypes[2]
+	mi := &file_ui_ui_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -382,7 +553,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[2]
+	mi := &file_ui_ui_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +566,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{2}
+	return file_ui_ui_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Event) GetEvent() isEvent_Event {
@@ -459,6 +630,60 @@ func (x *Event) GetNotification() *Notification {
 	return nil
 }
 
+func (x *Event) GetNamespaceDropReasons() *NamespaceDropReasons {
+	if x != nil {
+		if x, ok := x.Event.(*Event_NamespaceDropReasons); ok {
+			return x.NamespaceDropReasons
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetNamespaceNodeState() *NamespaceNodeState {
+	if x != nil {
+		if x, ok := x.Event.(*Event_NamespaceNodeState); ok {
+			return x.NamespaceNodeState
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetNamespaceLinkState() *NamespaceLinkState {
+	if x != nil {
+		if x, ok := x.Event.(*Event_NamespaceLinkState); ok {
+			return x.NamespaceLinkState
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetK8SServiceLinkState() *K8SServiceLinkState {
+	if x != nil {
+		if x, ok := x.Event.(*Event_K8SServiceLinkState); ok {
+			return x.K8SServiceLinkState
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetServiceEndpointsState() *ServiceEndpointsState {
+	if x != nil {
+		if x, ok := x.Event.(*Event_ServiceEndpointsState); ok {
+			return x.ServiceEndpointsState
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetLinkL7SummaryState() *LinkL7SummaryState {
+	if x != nil {
+		if x, ok := x.Event.(*Event_LinkL7SummaryState); ok {
+			return x.LinkL7SummaryState
+		}
+	}
+	return nil
+}
+
 type isEvent_Event interface {
 	isEvent_Event()
 }
@@ -493,6 +718,30 @@ type Event_Notification struct {
 	Notification *Notification `protobuf:"bytes,8,opt,name=notification,proto3,oneof"`
 }
 
+type Event_NamespaceDropReasons struct {
+	NamespaceDropReasons *NamespaceDropReasons `protobuf:"bytes,9,opt,name=namespace_drop_reasons,json=namespaceDropReasons,proto3,oneof"`
+}
+
+type Event_NamespaceNodeState struct {
+	NamespaceNodeState *NamespaceNodeState `protobuf:"bytes,10,opt,name=namespace_node_state,json=namespaceNodeState,proto3,oneof"`
+}
+
+type Event_NamespaceLinkState struct {
+	NamespaceLinkState *NamespaceLinkState `protobuf:"bytes,11,opt,name=namespace_link_state,json=namespaceLinkState,proto3,oneof"`
+}
+
+type Event_K8SServiceLinkState struct {
+	K8SServiceLinkState *K8SServiceLinkState `protobuf:"bytes,12,opt,name=k8s_service_link_state,json=k8sServiceLinkState,proto3,oneof"`
+}
+
+type Event_ServiceEndpointsState struct {
+	ServiceEndpointsState *ServiceEndpointsState `protobuf:"bytes,13,opt,name=service_endpoints_state,json=serviceEndpointsState,proto3,oneof"`
+}
+
+type Event_LinkL7SummaryState struct {
+	LinkL7SummaryState *LinkL7SummaryState `protobuf:"bytes,14,opt,name=link_l7_summary_state,json=linkL7SummaryState,proto3,oneof"`
+}
+
 func (*Event_Flow) isEvent_Event() {}
 
 func (*Event_NamespaceState) isEvent_Event() {}
@@ -505,6 +754,18 @@ func (*Event_Flows) isEvent_Event() {}
 
 func (*Event_Notification) isEvent_Event() {}
 
+func (*Event_NamespaceDropReasons) isEvent_Event() {}
+
+func (*Event_NamespaceNodeState) isEvent_Event() {}
+
+func (*Event_NamespaceLinkState) isEvent_Event() {}
+
+func (*Event_K8SServiceLinkState) isEvent_Event() {}
+
+func (*Event_ServiceEndpointsState) isEvent_Event() {}
+
+func (*Event_LinkL7SummaryState) isEvent_Event() {}
+
 type Flows struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Flows         []*flow.Flow           `protobuf:"bytes,1,rep,name=flows,proto3" json:"flows,omitempty"`
@@ -514,7 +775,7 @@ type Flows struct {
 
 func (x *Flows) Reset() {
 	*x = Flows{}
-	mi := &file_ui_ui_proto_msgTypes[3]
+	mi := &file_ui_ui_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +787,7 @@ func (x *Flows) String() string {
 func (*Flows) ProtoMessage() {}
 
 func (x *Flows) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[3]
+	mi := &file_ui_ui_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +800,7 @@ func (x *Flows) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Flows.ProtoReflect.Descriptor instead.
 func (*Flows) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{3}
+	return file_ui_ui_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Flows) GetFlows() []*flow.Flow {
@@ -566,7 +827,7 @@ type EventFilter struct {
 
 func (x *EventFilter) Reset() {
 	*x = EventFilter{}
-	mi := &file_ui_ui_proto_msgTypes[4]
+	mi := &file_ui_ui_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +839,7 @@ func (x *EventFilter) String() string {
 func (*EventFilter) ProtoMessage() {}
 
 func (x *EventFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[4]
+	mi := &file_ui_ui_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +852,7 @@ func (x *EventFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
 func (*EventFilter) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{4}
+	return file_ui_ui_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EventFilter) GetFilter() isEventFilter_Filter {
@@ -650,30 +911,28 @@ func (*EventFilter_ServiceFilter) isEventFilter_Filter() {}
 
 func (*EventFilter_ServiceLinkFilter) isEventFilter_Filter() {}
 
-type NamespaceDescriptor struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type FlowByUUIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NamespaceDescriptor) Reset() {
-	*x = NamespaceDescriptor{}
-	mi := &file_ui_ui_proto_msgTypes[5]
+func (x *FlowByUUIDRequest) Reset() {
+	*x = FlowByUUIDRequest{}
+	mi := &file_ui_ui_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NamespaceDescriptor) String() string {
+func (x *FlowByUUIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NamespaceDescriptor) ProtoMessage() {}
+func (*FlowByUUIDRequest) ProtoMessage() {}
 
-func (x *NamespaceDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[5]
+func (x *FlowByUUIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,55 +943,94 @@ func (x *NamespaceDescriptor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NamespaceDescriptor.ProtoReflect.Descriptor instead.
-func (*NamespaceDescriptor) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use FlowByUUIDRequest.ProtoReflect.Descriptor instead.
+func (*FlowByUUIDRequest) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *NamespaceDescriptor) GetId() string {
+func (x *FlowByUUIDRequest) GetUuid() string {
 	if x != nil {
-		return x.Id
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *NamespaceDescriptor) GetName() string {
+type FlowByUUIDResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Flow  *flow.Flow             `protobuf:"bytes,1,opt,name=flow,proto3" json:"flow,omitempty"`
+	// The flow is found in the flows recently seen by backend, otherwise it
+	// is fetched from hubble-relay
+	IsFromHistory bool `protobuf:"varint,2,opt,name=is_from_history,json=isFromHistory,proto3" json:"is_from_history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowByUUIDResponse) Reset() {
+	*x = FlowByUUIDResponse{}
+	mi := &file_ui_ui_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowByUUIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowByUUIDResponse) ProtoMessage() {}
+
+func (x *FlowByUUIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[7]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *NamespaceDescriptor) GetCreationTimestamp() *timestamppb.Timestamp {
+// Deprecated: Use FlowByUUIDResponse.ProtoReflect.Descriptor instead.
+func (*FlowByUUIDResponse) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FlowByUUIDResponse) GetFlow() *flow.Flow {
 	if x != nil {
-		return x.CreationTimestamp
+		return x.Flow
 	}
 	return nil
 }
 
-type NamespaceState struct {
+func (x *FlowByUUIDResponse) GetIsFromHistory() bool {
+	if x != nil {
+		return x.IsFromHistory
+	}
+	return false
+}
+
+type FilterExpressionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     *NamespaceDescriptor   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NamespaceState) Reset() {
-	*x = NamespaceState{}
-	mi := &file_ui_ui_proto_msgTypes[6]
+func (x *FilterExpressionRequest) Reset() {
+	*x = FilterExpressionRequest{}
+	mi := &file_ui_ui_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NamespaceState) String() string {
+func (x *FilterExpressionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NamespaceState) ProtoMessage() {}
+func (*FilterExpressionRequest) ProtoMessage() {}
 
-func (x *NamespaceState) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[6]
+func (x *FilterExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,26 +1041,247 @@ func (x *NamespaceState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NamespaceState.ProtoReflect.Descriptor instead.
-func (*NamespaceState) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use FilterExpressionRequest.ProtoReflect.Descriptor instead.
+func (*FilterExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *NamespaceState) GetNamespace() *NamespaceDescriptor {
+func (x *FilterExpressionRequest) GetExpression() string {
 	if x != nil {
-		return x.Namespace
+		return x.Expression
 	}
-	return nil
+	return ""
 }
 
-func (x *NamespaceState) GetType() StateChange {
-	if x != nil {
-		return x.Type
-	}
-	return StateChange_UNKNOWN_STATE_CHANGE
+// Flow filters the expression is compiled into or the error if it's invalid
+// or can't be expressed as flow filters, e.g. `from-ip=10.0.0.0/8 and
+// from-ip=10.1.0.0/16`
+type FilterExpressionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Whitelist     []*flow.FlowFilter     `protobuf:"bytes,1,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	Blacklist     []*flow.FlowFilter     `protobuf:"bytes,2,rep,name=blacklist,proto3" json:"blacklist,omitempty"`
+	Error         *FilterExpressionError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-// Come up with a better name for Service.
+func (x *FilterExpressionResponse) Reset() {
+	*x = FilterExpressionResponse{}
+	mi := &file_ui_ui_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterExpressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterExpressionResponse) ProtoMessage() {}
+
+func (x *FilterExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterExpressionResponse.ProtoReflect.Descriptor instead.
+func (*FilterExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FilterExpressionResponse) GetWhitelist() []*flow.FlowFilter {
+	if x != nil {
+		return x.Whitelist
+	}
+	return nil
+}
+
+func (x *FilterExpressionResponse) GetBlacklist() []*flow.FlowFilter {
+	if x != nil {
+		return x.Blacklist
+	}
+	return nil
+}
+
+func (x *FilterExpressionResponse) GetError() *FilterExpressionError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type FilterExpressionError struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Zero-based offset of the expression part the error refers to
+	Position      uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterExpressionError) Reset() {
+	*x = FilterExpressionError{}
+	mi := &file_ui_ui_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterExpressionError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterExpressionError) ProtoMessage() {}
+
+func (x *FilterExpressionError) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterExpressionError.ProtoReflect.Descriptor instead.
+func (*FilterExpressionError) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FilterExpressionError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FilterExpressionError) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type NamespaceDescriptor struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NamespaceDescriptor) Reset() {
+	*x = NamespaceDescriptor{}
+	mi := &file_ui_ui_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceDescriptor) ProtoMessage() {}
+
+func (x *NamespaceDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceDescriptor.ProtoReflect.Descriptor instead.
+func (*NamespaceDescriptor) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NamespaceDescriptor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NamespaceDescriptor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NamespaceDescriptor) GetCreationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return nil
+}
+
+type NamespaceState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *NamespaceDescriptor   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceState) Reset() {
+	*x = NamespaceState{}
+	mi := &file_ui_ui_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceState) ProtoMessage() {}
+
+func (x *NamespaceState) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceState.ProtoReflect.Descriptor instead.
+func (*NamespaceState) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NamespaceState) GetNamespace() *NamespaceDescriptor {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *NamespaceState) GetType() StateChange {
+	if x != nil {
+		return x.Type
+	}
+	return StateChange_UNKNOWN_STATE_CHANGE
+}
+
+// Come up with a better name for Service.
 type Service struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// An opaque ID that uniquely identifies the service.
@@ -773,20 +1292,31 @@ type Service struct {
 	DnsNames              []string `protobuf:"bytes,5,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
 	EgressPolicyEnforced  bool     `protobuf:"varint,6,opt,name=egress_policy_enforced,json=egressPolicyEnforced,proto3" json:"egress_policy_enforced,omitempty"`
 	IngressPolicyEnforced bool     `protobuf:"varint,7,opt,name=ingress_policy_enforced,json=ingressPolicyEnforced,proto3" json:"ingress_policy_enforced,omitempty"`
-	// This is a string instead of boolean because Cilium reports status message. If it's
-	// successfully enabled, this string is set to "OK" I think.
+	// Status of cilium endpoints of the card: "OK" if all of them are ready,
+	// e.g. "1/3 endpoints ready" otherwise, empty if endpoints are unknown.
 	VisibilityPolicyStatus string `protobuf:"bytes,8,opt,name=visibility_policy_status,json=visibilityPolicyStatus,proto3" json:"visibility_policy_status,omitempty"`
 	// We probably can't reliably set creation timestamp.
 	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
 	Workloads         []*flow.Workload       `protobuf:"bytes,10,rep,name=workloads,proto3" json:"workloads,omitempty"`
 	Identity          uint32                 `protobuf:"varint,12,opt,name=identity,proto3" json:"identity,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Ids this service had before its identity has changed, flows referring
+	// to them belong to this service
+	IdAliases []string `protobuf:"bytes,13,rep,name=id_aliases,json=idAliases,proto3" json:"id_aliases,omitempty"`
+	// The service is outside of the requested scope and is shown only
+	// because it talks to services within the scope
+	IsBoundary bool `protobuf:"varint,14,opt,name=is_boundary,json=isBoundary,proto3" json:"is_boundary,omitempty"`
+	// The service is k8s Service without ready backends, flows to it aren't
+	// translated to any pod
+	IsWithoutEndpoints bool `protobuf:"varint,15,opt,name=is_without_endpoints,json=isWithoutEndpoints,proto3" json:"is_without_endpoints,omitempty"`
+	// ClusterMesh cluster the service belongs to, empty if it's unknown
+	Cluster       string `protobuf:"bytes,16,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Service) Reset() {
 	*x = Service{}
-	mi := &file_ui_ui_proto_msgTypes[7]
+	mi := &file_ui_ui_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -798,7 +1328,7 @@ func (x *Service) String() string {
 func (*Service) ProtoMessage() {}
 
 func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[7]
+	mi := &file_ui_ui_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,7 +1341,7 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Service.ProtoReflect.Descriptor instead.
 func (*Service) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{7}
+	return file_ui_ui_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Service) GetId() string {
@@ -884,36 +1414,1528 @@ func (x *Service) GetWorkloads() []*flow.Workload {
 	return nil
 }
 
-func (x *Service) GetIdentity() uint32 {
+func (x *Service) GetIdentity() uint32 {
+	if x != nil {
+		return x.Identity
+	}
+	return 0
+}
+
+func (x *Service) GetIdAliases() []string {
+	if x != nil {
+		return x.IdAliases
+	}
+	return nil
+}
+
+func (x *Service) GetIsBoundary() bool {
+	if x != nil {
+		return x.IsBoundary
+	}
+	return false
+}
+
+func (x *Service) GetIsWithoutEndpoints() bool {
+	if x != nil {
+		return x.IsWithoutEndpoints
+	}
+	return false
+}
+
+func (x *Service) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type ServiceState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceState) Reset() {
+	*x = ServiceState{}
+	mi := &file_ui_ui_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceState) ProtoMessage() {}
+
+func (x *ServiceState) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceState.ProtoReflect.Descriptor instead.
+func (*ServiceState) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ServiceState) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *ServiceState) GetType() StateChange {
+	if x != nil {
+		return x.Type
+	}
+	return StateChange_UNKNOWN_STATE_CHANGE
+}
+
+type CiliumEndpointStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name and namespace of the pod the endpoint belongs to
+	PodName   string        `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	Namespace string        `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	State     EndpointState `protobuf:"varint,3,opt,name=state,proto3,enum=ui.EndpointState" json:"state,omitempty"`
+	// State as cilium reports it, e.g. "waiting-to-regenerate"
+	CiliumState           string `protobuf:"bytes,4,opt,name=cilium_state,json=ciliumState,proto3" json:"cilium_state,omitempty"`
+	IngressPolicyEnforced bool   `protobuf:"varint,5,opt,name=ingress_policy_enforced,json=ingressPolicyEnforced,proto3" json:"ingress_policy_enforced,omitempty"`
+	EgressPolicyEnforced  bool   `protobuf:"varint,6,opt,name=egress_policy_enforced,json=egressPolicyEnforced,proto3" json:"egress_policy_enforced,omitempty"`
+	Identity              uint32 `protobuf:"varint,7,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CiliumEndpointStatus) Reset() {
+	*x = CiliumEndpointStatus{}
+	mi := &file_ui_ui_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CiliumEndpointStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CiliumEndpointStatus) ProtoMessage() {}
+
+func (x *CiliumEndpointStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CiliumEndpointStatus.ProtoReflect.Descriptor instead.
+func (*CiliumEndpointStatus) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CiliumEndpointStatus) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *CiliumEndpointStatus) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CiliumEndpointStatus) GetState() EndpointState {
+	if x != nil {
+		return x.State
+	}
+	return EndpointState_UNKNOWN_ENDPOINT_STATE
+}
+
+func (x *CiliumEndpointStatus) GetCiliumState() string {
+	if x != nil {
+		return x.CiliumState
+	}
+	return ""
+}
+
+func (x *CiliumEndpointStatus) GetIngressPolicyEnforced() bool {
+	if x != nil {
+		return x.IngressPolicyEnforced
+	}
+	return false
+}
+
+func (x *CiliumEndpointStatus) GetEgressPolicyEnforced() bool {
+	if x != nil {
+		return x.EgressPolicyEnforced
+	}
+	return false
+}
+
+func (x *CiliumEndpointStatus) GetIdentity() uint32 {
+	if x != nil {
+		return x.Identity
+	}
+	return 0
+}
+
+// Cilium endpoints of the pods seen behind the service card
+type ServiceEndpoints struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ServiceId     string                  `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Endpoints     []*CiliumEndpointStatus `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	Ready         uint32                  `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Regenerating  uint32                  `protobuf:"varint,4,opt,name=regenerating,proto3" json:"regenerating,omitempty"`
+	NotReady      uint32                  `protobuf:"varint,5,opt,name=not_ready,json=notReady,proto3" json:"not_ready,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceEndpoints) Reset() {
+	*x = ServiceEndpoints{}
+	mi := &file_ui_ui_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceEndpoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEndpoints) ProtoMessage() {}
+
+func (x *ServiceEndpoints) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEndpoints.ProtoReflect.Descriptor instead.
+func (*ServiceEndpoints) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ServiceEndpoints) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceEndpoints) GetEndpoints() []*CiliumEndpointStatus {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *ServiceEndpoints) GetReady() uint32 {
+	if x != nil {
+		return x.Ready
+	}
+	return 0
+}
+
+func (x *ServiceEndpoints) GetRegenerating() uint32 {
+	if x != nil {
+		return x.Regenerating
+	}
+	return 0
+}
+
+func (x *ServiceEndpoints) GetNotReady() uint32 {
+	if x != nil {
+		return x.NotReady
+	}
+	return 0
+}
+
+type ServiceEndpointsState struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ServiceEndpoints *ServiceEndpoints      `protobuf:"bytes,1,opt,name=service_endpoints,json=serviceEndpoints,proto3" json:"service_endpoints,omitempty"`
+	Type             StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ServiceEndpointsState) Reset() {
+	*x = ServiceEndpointsState{}
+	mi := &file_ui_ui_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceEndpointsState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEndpointsState) ProtoMessage() {}
+
+func (x *ServiceEndpointsState) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEndpointsState.ProtoReflect.Descriptor instead.
+func (*ServiceEndpointsState) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ServiceEndpointsState) GetServiceEndpoints() *ServiceEndpoints {
+	if x != nil {
+		return x.ServiceEndpoints
+	}
+	return nil
+}
+
+func (x *ServiceEndpointsState) GetType() StateChange {
+	if x != nil {
+		return x.Type
+	}
+	return StateChange_UNKNOWN_STATE_CHANGE
+}
+
+type ServiceFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     []string               `protobuf:"bytes,1,rep,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceFilter) Reset() {
+	*x = ServiceFilter{}
+	mi := &file_ui_ui_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceFilter) ProtoMessage() {}
+
+func (x *ServiceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceFilter.ProtoReflect.Descriptor instead.
+func (*ServiceFilter) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ServiceFilter) GetNamespace() []string {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type ServiceLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An opaque ID that uniquely identifies this link.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// source Service id
+	SourceId string `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// destination Service id
+	DestinationId   string               `protobuf:"bytes,3,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	DestinationPort uint32               `protobuf:"varint,4,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	IpProtocol      IPProtocol           `protobuf:"varint,5,opt,name=ip_protocol,json=ipProtocol,proto3,enum=ui.IPProtocol" json:"ip_protocol,omitempty"`
+	Verdict         flow.Verdict         `protobuf:"varint,6,opt,name=verdict,proto3,enum=flow.Verdict" json:"verdict,omitempty"`
+	FlowAmount      uint64               `protobuf:"varint,7,opt,name=flow_amount,json=flowAmount,proto3" json:"flow_amount,omitempty"`
+	Latency         *ServiceLink_Latency `protobuf:"bytes,8,opt,name=latency,proto3" json:"latency,omitempty"`
+	BytesTransfered uint64               `protobuf:"varint,9,opt,name=bytes_transfered,json=bytesTransfered,proto3" json:"bytes_transfered,omitempty"`
+	AuthType        flow.AuthType        `protobuf:"varint,10,opt,name=auth_type,json=authType,proto3,enum=flow.AuthType" json:"auth_type,omitempty"`
+	IsEncrypted     bool                 `protobuf:"varint,11,opt,name=is_encrypted,json=isEncrypted,proto3" json:"is_encrypted,omitempty"`
+	// Number of flows per verdict seen on this link
+	VerdictCounts []*VerdictCount `protobuf:"bytes,12,rep,name=verdict_counts,json=verdictCounts,proto3" json:"verdict_counts,omitempty"`
+	// Histogram of drop reasons of dropped flows, the most frequent first
+	DropReasons []*DropReasonCount `protobuf:"bytes,13,rep,name=drop_reasons,json=dropReasons,proto3" json:"drop_reasons,omitempty"`
+	// k8s Service the flows of the link were sent to, if any
+	K8SService    *K8SServiceRef `protobuf:"bytes,14,opt,name=k8s_service,json=k8sService,proto3" json:"k8s_service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceLink) Reset() {
+	*x = ServiceLink{}
+	mi := &file_ui_ui_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLink) ProtoMessage() {}
+
+func (x *ServiceLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLink.ProtoReflect.Descriptor instead.
+func (*ServiceLink) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ServiceLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceLink) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ServiceLink) GetDestinationId() string {
+	if x != nil {
+		return x.DestinationId
+	}
+	return ""
+}
+
+func (x *ServiceLink) GetDestinationPort() uint32 {
+	if x != nil {
+		return x.DestinationPort
+	}
+	return 0
+}
+
+func (x *ServiceLink) GetIpProtocol() IPProtocol {
+	if x != nil {
+		return x.IpProtocol
+	}
+	return IPProtocol_UNKNOWN_IP_PROTOCOL
+}
+
+func (x *ServiceLink) GetVerdict() flow.Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return flow.Verdict(0)
+}
+
+func (x *ServiceLink) GetFlowAmount() uint64 {
+	if x != nil {
+		return x.FlowAmount
+	}
+	return 0
+}
+
+func (x *ServiceLink) GetLatency() *ServiceLink_Latency {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *ServiceLink) GetBytesTransfered() uint64 {
+	if x != nil {
+		return x.BytesTransfered
+	}
+	return 0
+}
+
+func (x *ServiceLink) GetAuthType() flow.AuthType {
+	if x != nil {
+		return x.AuthType
+	}
+	return flow.AuthType(0)
+}
+
+func (x *ServiceLink) GetIsEncrypted() bool {
+	if x != nil {
+		return x.IsEncrypted
+	}
+	return false
+}
+
+func (x *ServiceLink) GetVerdictCounts() []*VerdictCount {
+	if x != nil {
+		return x.VerdictCounts
+	}
+	return nil
+}
+
+func (x *ServiceLink) GetDropReasons() []*DropReasonCount {
+	if x != nil {
+		return x.DropReasons
+	}
+	return nil
+}
+
+func (x *ServiceLink) GetK8SService() *K8SServiceRef {
+	if x != nil {
+		return x.K8SService
+	}
+	return nil
+}
+
+type K8SServiceRef struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// ClusterIP, NodePort, LoadBalancer or ExternalName, empty if the
+	// Service object is not known
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Name of the Service port the flows were sent to
+	PortName string `protobuf:"bytes,4,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	// Numbers of ready and all the backends from EndpointSlices
+	ReadyBackends uint32 `protobuf:"varint,5,opt,name=ready_backends,json=readyBackends,proto3" json:"ready_backends,omitempty"`
+	Backends      uint32 `protobuf:"varint,6,opt,name=backends,proto3" json:"backends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *K8SServiceRef) Reset() {
+	*x = K8SServiceRef{}
+	mi := &file_ui_ui_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *K8SServiceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SServiceRef) ProtoMessage() {}
+
+func (x *K8SServiceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SServiceRef.ProtoReflect.Descriptor instead.
+func (*K8SServiceRef) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *K8SServiceRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *K8SServiceRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *K8SServiceRef) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *K8SServiceRef) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *K8SServiceRef) GetReadyBackends() uint32 {
+	if x != nil {
+		return x.ReadyBackends
+	}
+	return 0
+}
+
+func (x *K8SServiceRef) GetBackends() uint32 {
+	if x != nil {
+		return x.Backends
+	}
+	return 0
+}
+
+// Flows from a service to the port of k8s Service, e.g. "frontend ->
+// svc/api:http", regardless of the backends they were balanced to
+type K8SServiceLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// source Service id
+	SourceId   string         `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	K8SService *K8SServiceRef `protobuf:"bytes,3,opt,name=k8s_service,json=k8sService,proto3" json:"k8s_service,omitempty"`
+	// Ids of the services behind k8s Service the flows ended up at
+	DestinationIds []string        `protobuf:"bytes,4,rep,name=destination_ids,json=destinationIds,proto3" json:"destination_ids,omitempty"`
+	FlowAmount     uint64          `protobuf:"varint,5,opt,name=flow_amount,json=flowAmount,proto3" json:"flow_amount,omitempty"`
+	VerdictCounts  []*VerdictCount `protobuf:"bytes,6,rep,name=verdict_counts,json=verdictCounts,proto3" json:"verdict_counts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *K8SServiceLink) Reset() {
+	*x = K8SServiceLink{}
+	mi := &file_ui_ui_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *K8SServiceLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SServiceLink) ProtoMessage() {}
+
+func (x *K8SServiceLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SServiceLink.ProtoReflect.Descriptor instead.
+func (*K8SServiceLink) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *K8SServiceLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *K8SServiceLink) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *K8SServiceLink) GetK8SService() *K8SServiceRef {
+	if x != nil {
+		return x.K8SService
+	}
+	return nil
+}
+
+func (x *K8SServiceLink) GetDestinationIds() []string {
+	if x != nil {
+		return x.DestinationIds
+	}
+	return nil
+}
+
+func (x *K8SServiceLink) GetFlowAmount() uint64 {
+	if x != nil {
+		return x.FlowAmount
+	}
+	return 0
+}
+
+func (x *K8SServiceLink) GetVerdictCounts() []*VerdictCount {
+	if x != nil {
+		return x.VerdictCounts
+	}
+	return nil
+}
+
+type K8SServiceLinkState struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	K8SServiceLink *K8SServiceLink        `protobuf:"bytes,1,opt,name=k8s_service_link,json=k8sServiceLink,proto3" json:"k8s_service_link,omitempty"`
+	Type           StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *K8SServiceLinkState) Reset() {
+	*x = K8SServiceLinkState{}
+	mi := &file_ui_ui_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *K8SServiceLinkState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*K8SServiceLinkState) ProtoMessage() {}
+
+func (x *K8SServiceLinkState) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use K8SServiceLinkState.ProtoReflect.Descriptor instead.
+func (*K8SServiceLinkState) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *K8SServiceLinkState) GetK8SServiceLink() *K8SServiceLink {
+	if x != nil {
+		return x.K8SServiceLink
+	}
+	return nil
+}
+
+func (x *K8SServiceLinkState) GetType() StateChange {
+	if x != nil {
+		return x.Type
+	}
+	return StateChange_UNKNOWN_STATE_CHANGE
+}
+
+type VerdictCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verdict       flow.Verdict           `protobuf:"varint,1,opt,name=verdict,proto3,enum=flow.Verdict" json:"verdict,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerdictCount) Reset() {
+	*x = VerdictCount{}
+	mi := &file_ui_ui_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerdictCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerdictCount) ProtoMessage() {}
+
+func (x *VerdictCount) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerdictCount.ProtoReflect.Descriptor instead.
+func (*VerdictCount) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerdictCount) GetVerdict() flow.Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return flow.Verdict(0)
+}
+
+func (x *VerdictCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type DropReasonCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        flow.DropReason        `protobuf:"varint,1,opt,name=reason,proto3,enum=flow.DropReason" json:"reason,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropReasonCount) Reset() {
+	*x = DropReasonCount{}
+	mi := &file_ui_ui_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropReasonCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropReasonCount) ProtoMessage() {}
+
+func (x *DropReasonCount) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropReasonCount.ProtoReflect.Descriptor instead.
+func (*DropReasonCount) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DropReasonCount) GetReason() flow.DropReason {
+	if x != nil {
+		return x.Reason
+	}
+	return flow.DropReason(0)
+}
+
+func (x *DropReasonCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Most frequent drop reasons of flows from/to the namespace
+type NamespaceDropReasons struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TopReasons    []*DropReasonCount     `protobuf:"bytes,2,rep,name=top_reasons,json=topReasons,proto3" json:"top_reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceDropReasons) Reset() {
+	*x = NamespaceDropReasons{}
+	mi := &file_ui_ui_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceDropReasons) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceDropReasons) ProtoMessage() {}
+
+func (x *NamespaceDropReasons) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceDropReasons.ProtoReflect.Descriptor instead.
+func (*NamespaceDropReasons) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NamespaceDropReasons) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespaceDropReasons) GetTopReasons() []*DropReasonCount {
+	if x != nil {
+		return x.TopReasons
+	}
+	return nil
+}
+
+type ServiceLinkState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceLink   *ServiceLink           `protobuf:"bytes,1,opt,name=service_link,json=serviceLink,proto3" json:"service_link,omitempty"`
+	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceLinkState) Reset() {
+	*x = ServiceLinkState{}
+	mi := &file_ui_ui_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceLinkState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLinkState) ProtoMessage() {}
+
+func (x *ServiceLinkState) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLinkState.ProtoReflect.Descriptor instead.
+func (*ServiceLinkState) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ServiceLinkState) GetServiceLink() *ServiceLink {
+	if x != nil {
+		return x.ServiceLink
+	}
+	return nil
+}
+
+func (x *ServiceLinkState) GetType() StateChange {
+	if x != nil {
+		return x.Type
+	}
+	return StateChange_UNKNOWN_STATE_CHANGE
+}
+
+// Namespace as a node of the cluster map, endpoints that don't belong to any
+// namespace (world, host, remote nodes) are grouped into reserved nodes
+type NamespaceNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Namespace name or reserved label like "reserved:world"
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsReserved bool   `protobuf:"varint,3,opt,name=is_reserved,json=isReserved,proto3" json:"is_reserved,omitempty"`
+	// Number of flows sent from or to the namespace
+	FlowAmount uint64 `protobuf:"varint,4,opt,name=flow_amount,json=flowAmount,proto3" json:"flow_amount,omitempty"`
+	// Number of distinct services seen in the namespace
+	ServicesNumber uint32 `protobuf:"varint,5,opt,name=services_number,json=servicesNumber,proto3" json:"services_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NamespaceNode) Reset() {
+	*x = NamespaceNode{}
+	mi := &file_ui_ui_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceNode) ProtoMessage() {}
+
+func (x *NamespaceNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceNode.ProtoReflect.Descriptor instead.
+func (*NamespaceNode) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NamespaceNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NamespaceNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NamespaceNode) GetIsReserved() bool {
+	if x != nil {
+		return x.IsReserved
+	}
+	return false
+}
+
+func (x *NamespaceNode) GetFlowAmount() uint64 {
+	if x != nil {
+		return x.FlowAmount
+	}
+	return 0
+}
+
+func (x *NamespaceNode) GetServicesNumber() uint32 {
+	if x != nil {
+		return x.ServicesNumber
+	}
+	return 0
+}
+
+type NamespaceNodeState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceNode *NamespaceNode         `protobuf:"bytes,1,opt,name=namespace_node,json=namespaceNode,proto3" json:"namespace_node,omitempty"`
+	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceNodeState) Reset() {
+	*x = NamespaceNodeState{}
+	mi := &file_ui_ui_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceNodeState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceNodeState) ProtoMessage() {}
+
+func (x *NamespaceNodeState) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceNodeState.ProtoReflect.Descriptor instead.
+func (*NamespaceNodeState) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NamespaceNodeState) GetNamespaceNode() *NamespaceNode {
+	if x != nil {
+		return x.NamespaceNode
+	}
+	return nil
+}
+
+func (x *NamespaceNodeState) GetType() StateChange {
+	if x != nil {
+		return x.Type
+	}
+	return StateChange_UNKNOWN_STATE_CHANGE
+}
+
+// All the flows sent from one namespace to another, flows within the same
+// namespace make a link with equal source and destination
+type NamespaceLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// source NamespaceNode id
+	SourceId string `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// destination NamespaceNode id
+	DestinationId       string             `protobuf:"bytes,3,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	FlowAmount          uint64             `protobuf:"varint,4,opt,name=flow_amount,json=flowAmount,proto3" json:"flow_amount,omitempty"`
+	VerdictCounts       []*VerdictCount    `protobuf:"bytes,5,rep,name=verdict_counts,json=verdictCounts,proto3" json:"verdict_counts,omitempty"`
+	DropReasons         []*DropReasonCount `protobuf:"bytes,6,rep,name=drop_reasons,json=dropReasons,proto3" json:"drop_reasons,omitempty"`
+	BytesTransfered     uint64             `protobuf:"varint,7,opt,name=bytes_transfered,json=bytesTransfered,proto3" json:"bytes_transfered,omitempty"`
+	EncryptedFlowAmount uint64             `protobuf:"varint,8,opt,name=encrypted_flow_amount,json=encryptedFlowAmount,proto3" json:"encrypted_flow_amount,omitempty"`
+	// Share of encrypted flows, from 0 to 1
+	EncryptionCoverage float32 `protobuf:"fixed32,9,opt,name=encryption_coverage,json=encryptionCoverage,proto3" json:"encryption_coverage,omitempty"`
+	// Number of distinct service links aggregated into this link
+	ServiceLinksNumber uint32 `protobuf:"varint,10,opt,name=service_links_number,json=serviceLinksNumber,proto3" json:"service_links_number,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NamespaceLink) Reset() {
+	*x = NamespaceLink{}
+	mi := &file_ui_ui_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceLink) ProtoMessage() {}
+
+func (x *NamespaceLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceLink.ProtoReflect.Descriptor instead.
+func (*NamespaceLink) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NamespaceLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NamespaceLink) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *NamespaceLink) GetDestinationId() string {
+	if x != nil {
+		return x.DestinationId
+	}
+	return ""
+}
+
+func (x *NamespaceLink) GetFlowAmount() uint64 {
+	if x != nil {
+		return x.FlowAmount
+	}
+	return 0
+}
+
+func (x *NamespaceLink) GetVerdictCounts() []*VerdictCount {
+	if x != nil {
+		return x.VerdictCounts
+	}
+	return nil
+}
+
+func (x *NamespaceLink) GetDropReasons() []*DropReasonCount {
+	if x != nil {
+		return x.DropReasons
+	}
+	return nil
+}
+
+func (x *NamespaceLink) GetBytesTransfered() uint64 {
+	if x != nil {
+		return x.BytesTransfered
+	}
+	return 0
+}
+
+func (x *NamespaceLink) GetEncryptedFlowAmount() uint64 {
+	if x != nil {
+		return x.EncryptedFlowAmount
+	}
+	return 0
+}
+
+func (x *NamespaceLink) GetEncryptionCoverage() float32 {
+	if x != nil {
+		return x.EncryptionCoverage
+	}
+	return 0
+}
+
+func (x *NamespaceLink) GetServiceLinksNumber() uint32 {
+	if x != nil {
+		return x.ServiceLinksNumber
+	}
+	return 0
+}
+
+type NamespaceLinkState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceLink *NamespaceLink         `protobuf:"bytes,1,opt,name=namespace_link,json=namespaceLink,proto3" json:"namespace_link,omitempty"`
+	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceLinkState) Reset() {
+	*x = NamespaceLinkState{}
+	mi := &file_ui_ui_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceLinkState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceLinkState) ProtoMessage() {}
+
+func (x *NamespaceLinkState) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceLinkState.ProtoReflect.Descriptor instead.
+func (*NamespaceLinkState) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *NamespaceLinkState) GetNamespaceLink() *NamespaceLink {
+	if x != nil {
+		return x.NamespaceLink
+	}
+	return nil
+}
+
+func (x *NamespaceLinkState) GetType() StateChange {
+	if x != nil {
+		return x.Type
+	}
+	return StateChange_UNKNOWN_STATE_CHANGE
+}
+
+type ServiceLinkFilter struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Source      []*ServiceFilter       `protobuf:"bytes,1,rep,name=source,proto3" json:"source,omitempty"`
+	Destination []*ServiceFilter       `protobuf:"bytes,2,rep,name=destination,proto3" json:"destination,omitempty"`
+	// Use string here to support things like port ranges (e.g. 100-200).
+	DestinationPort []string       `protobuf:"bytes,3,rep,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	Verdict         []flow.Verdict `protobuf:"varint,4,rep,packed,name=verdict,proto3,enum=flow.Verdict" json:"verdict,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ServiceLinkFilter) Reset() {
+	*x = ServiceLinkFilter{}
+	mi := &file_ui_ui_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceLinkFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLinkFilter) ProtoMessage() {}
+
+func (x *ServiceLinkFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLinkFilter.ProtoReflect.Descriptor instead.
+func (*ServiceLinkFilter) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ServiceLinkFilter) GetSource() []*ServiceFilter {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ServiceLinkFilter) GetDestination() []*ServiceFilter {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *ServiceLinkFilter) GetDestinationPort() []string {
+	if x != nil {
+		return x.DestinationPort
+	}
+	return nil
+}
+
+func (x *ServiceLinkFilter) GetVerdict() []flow.Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return nil
+}
+
+type ServiceDetailsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The number of the most recent flows to return, 20 if not set
+	FlowsLimit    uint32 `protobuf:"varint,2,opt,name=flows_limit,json=flowsLimit,proto3" json:"flows_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDetailsRequest) Reset() {
+	*x = ServiceDetailsRequest{}
+	mi := &file_ui_ui_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDetailsRequest) ProtoMessage() {}
+
+func (x *ServiceDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDetailsRequest.ProtoReflect.Descriptor instead.
+func (*ServiceDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ServiceDetailsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceDetailsRequest) GetFlowsLimit() uint32 {
+	if x != nil {
+		return x.FlowsLimit
+	}
+	return 0
+}
+
+type ServiceDetailsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Service *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Peers sending traffic to the service and the links they use
+	Inbound []*ServicePeer `protobuf:"bytes,2,rep,name=inbound,proto3" json:"inbound,omitempty"`
+	// Peers receiving traffic from the service and the links they use
+	Outbound  []*ServicePeer   `protobuf:"bytes,3,rep,name=outbound,proto3" json:"outbound,omitempty"`
+	Ports     []*ServicePort   `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
+	Workloads []*flow.Workload `protobuf:"bytes,5,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	// Pods in "<namespace>/<pod>" form
+	Pods []string `protobuf:"bytes,6,rep,name=pods,proto3" json:"pods,omitempty"`
+	// DNS and Kafka endpoints served by the service, HTTP ones are in
+	// http_endpoints
+	L7Endpoints []*L7Endpoint `protobuf:"bytes,7,rep,name=l7_endpoints,json=l7Endpoints,proto3" json:"l7_endpoints,omitempty"`
+	// The most recent flows from/to the service, the newest comes first
+	RecentFlows []*flow.Flow `protobuf:"bytes,8,rep,name=recent_flows,json=recentFlows,proto3" json:"recent_flows,omitempty"`
+	// The number of flows the details are computed from
+	FlowsNumber uint32 `protobuf:"varint,9,opt,name=flows_number,json=flowsNumber,proto3" json:"flows_number,omitempty"`
+	// HTTP endpoints served by the service, they are computed the same way
+	// as L7 summaries of links
+	HttpEndpoints []*HttpEndpointStats `protobuf:"bytes,10,rep,name=http_endpoints,json=httpEndpoints,proto3" json:"http_endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDetailsResponse) Reset() {
+	*x = ServiceDetailsResponse{}
+	mi := &file_ui_ui_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDetailsResponse) ProtoMessage() {}
+
+func (x *ServiceDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDetailsResponse.ProtoReflect.Descriptor instead.
+func (*ServiceDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ServiceDetailsResponse) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *ServiceDetailsResponse) GetInbound() []*ServicePeer {
+	if x != nil {
+		return x.Inbound
+	}
+	return nil
+}
+
+func (x *ServiceDetailsResponse) GetOutbound() []*ServicePeer {
+	if x != nil {
+		return x.Outbound
+	}
+	return nil
+}
+
+func (x *ServiceDetailsResponse) GetPorts() []*ServicePort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ServiceDetailsResponse) GetWorkloads() []*flow.Workload {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+func (x *ServiceDetailsResponse) GetPods() []string {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+func (x *ServiceDetailsResponse) GetL7Endpoints() []*L7Endpoint {
+	if x != nil {
+		return x.L7Endpoints
+	}
+	return nil
+}
+
+func (x *ServiceDetailsResponse) GetRecentFlows() []*flow.Flow {
+	if x != nil {
+		return x.RecentFlows
+	}
+	return nil
+}
+
+func (x *ServiceDetailsResponse) GetFlowsNumber() uint32 {
+	if x != nil {
+		return x.FlowsNumber
+	}
+	return 0
+}
+
+func (x *ServiceDetailsResponse) GetHttpEndpoints() []*HttpEndpointStats {
 	if x != nil {
-		return x.Identity
+		return x.HttpEndpoints
 	}
-	return 0
+	return nil
 }
 
-type ServiceState struct {
+type ServicePeer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	Links         []*ServiceLink         `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceState) Reset() {
-	*x = ServiceState{}
-	mi := &file_ui_ui_proto_msgTypes[8]
+func (x *ServicePeer) Reset() {
+	*x = ServicePeer{}
+	mi := &file_ui_ui_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceState) String() string {
+func (x *ServicePeer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceState) ProtoMessage() {}
+func (*ServicePeer) ProtoMessage() {}
 
-func (x *ServiceState) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[8]
+func (x *ServicePeer) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,47 +2946,50 @@ func (x *ServiceState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceState.ProtoReflect.Descriptor instead.
-func (*ServiceState) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ServicePeer.ProtoReflect.Descriptor instead.
+func (*ServicePeer) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ServiceState) GetService() *Service {
+func (x *ServicePeer) GetService() *Service {
 	if x != nil {
 		return x.Service
 	}
 	return nil
 }
 
-func (x *ServiceState) GetType() StateChange {
+func (x *ServicePeer) GetLinks() []*ServiceLink {
 	if x != nil {
-		return x.Type
+		return x.Links
 	}
-	return StateChange_UNKNOWN_STATE_CHANGE
+	return nil
 }
 
-type ServiceFilter struct {
+// Port the service is observed listening on
+type ServicePort struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     []string               `protobuf:"bytes,1,rep,name=namespace,proto3" json:"namespace,omitempty"`
+	Port          uint32                 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol      IPProtocol             `protobuf:"varint,2,opt,name=protocol,proto3,enum=ui.IPProtocol" json:"protocol,omitempty"`
+	FlowAmount    uint64                 `protobuf:"varint,3,opt,name=flow_amount,json=flowAmount,proto3" json:"flow_amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceFilter) Reset() {
-	*x = ServiceFilter{}
-	mi := &file_ui_ui_proto_msgTypes[9]
+func (x *ServicePort) Reset() {
+	*x = ServicePort{}
+	mi := &file_ui_ui_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceFilter) String() string {
+func (x *ServicePort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceFilter) ProtoMessage() {}
+func (*ServicePort) ProtoMessage() {}
 
-func (x *ServiceFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[9]
+func (x *ServicePort) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,53 +3000,62 @@ func (x *ServiceFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceFilter.ProtoReflect.Descriptor instead.
-func (*ServiceFilter) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ServicePort.ProtoReflect.Descriptor instead.
+func (*ServicePort) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ServiceFilter) GetNamespace() []string {
+func (x *ServicePort) GetPort() uint32 {
 	if x != nil {
-		return x.Namespace
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-type ServiceLink struct {
+func (x *ServicePort) GetProtocol() IPProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return IPProtocol_UNKNOWN_IP_PROTOCOL
+}
+
+func (x *ServicePort) GetFlowAmount() uint64 {
+	if x != nil {
+		return x.FlowAmount
+	}
+	return 0
+}
+
+// L7 endpoint served by the service, e.g. HTTP method and path
+type L7Endpoint struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// An opaque ID that uniquely identifies this link.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// source Service id
-	SourceId string `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	// destination Service id
-	DestinationId   string               `protobuf:"bytes,3,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
-	DestinationPort uint32               `protobuf:"varint,4,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
-	IpProtocol      IPProtocol           `protobuf:"varint,5,opt,name=ip_protocol,json=ipProtocol,proto3,enum=ui.IPProtocol" json:"ip_protocol,omitempty"`
-	Verdict         flow.Verdict         `protobuf:"varint,6,opt,name=verdict,proto3,enum=flow.Verdict" json:"verdict,omitempty"`
-	FlowAmount      uint64               `protobuf:"varint,7,opt,name=flow_amount,json=flowAmount,proto3" json:"flow_amount,omitempty"`
-	Latency         *ServiceLink_Latency `protobuf:"bytes,8,opt,name=latency,proto3" json:"latency,omitempty"`
-	BytesTransfered uint64               `protobuf:"varint,9,opt,name=bytes_transfered,json=bytesTransfered,proto3" json:"bytes_transfered,omitempty"`
-	AuthType        flow.AuthType        `protobuf:"varint,10,opt,name=auth_type,json=authType,proto3,enum=flow.AuthType" json:"auth_type,omitempty"`
-	IsEncrypted     bool                 `protobuf:"varint,11,opt,name=is_encrypted,json=isEncrypted,proto3" json:"is_encrypted,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Either "dns" or "kafka"
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Method   string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Query for DNS and topic for Kafka
+	Path     string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Requests uint64 `protobuf:"varint,4,opt,name=requests,proto3" json:"requests,omitempty"`
+	// Responses with DNS rcode != 0 or Kafka error code != 0
+	Errors        uint64 `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceLink) Reset() {
-	*x = ServiceLink{}
-	mi := &file_ui_ui_proto_msgTypes[10]
+func (x *L7Endpoint) Reset() {
+	*x = L7Endpoint{}
+	mi := &file_ui_ui_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceLink) String() string {
+func (x *L7Endpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceLink) ProtoMessage() {}
+func (*L7Endpoint) ProtoMessage() {}
 
-func (x *ServiceLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[10]
+func (x *L7Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,111 +3066,165 @@ func (x *ServiceLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceLink.ProtoReflect.Descriptor instead.
-func (*ServiceLink) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use L7Endpoint.ProtoReflect.Descriptor instead.
+func (*L7Endpoint) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ServiceLink) GetId() string {
+func (x *L7Endpoint) GetProtocol() string {
 	if x != nil {
-		return x.Id
+		return x.Protocol
 	}
 	return ""
 }
 
-func (x *ServiceLink) GetSourceId() string {
+func (x *L7Endpoint) GetMethod() string {
 	if x != nil {
-		return x.SourceId
+		return x.Method
 	}
 	return ""
 }
 
-func (x *ServiceLink) GetDestinationId() string {
+func (x *L7Endpoint) GetPath() string {
 	if x != nil {
-		return x.DestinationId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ServiceLink) GetDestinationPort() uint32 {
+func (x *L7Endpoint) GetRequests() uint64 {
 	if x != nil {
-		return x.DestinationPort
+		return x.Requests
 	}
 	return 0
 }
 
-func (x *ServiceLink) GetIpProtocol() IPProtocol {
+func (x *L7Endpoint) GetErrors() uint64 {
 	if x != nil {
-		return x.IpProtocol
+		return x.Errors
 	}
-	return IPProtocol_UNKNOWN_IP_PROTOCOL
+	return 0
 }
 
-func (x *ServiceLink) GetVerdict() flow.Verdict {
+// HTTP endpoint of the link, numeric ids and UUIDs of the path are
+// collapsed, e.g. "/users/{id}"
+type HttpEndpointStats struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Method        string                  `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Requests      uint64                  `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	StatusClasses []*HttpStatusClassCount `protobuf:"bytes,4,rep,name=status_classes,json=statusClasses,proto3" json:"status_classes,omitempty"`
+	// Latency percentiles of the recent responses
+	LatencyP50    *durationpb.Duration `protobuf:"bytes,5,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP90    *durationpb.Duration `protobuf:"bytes,6,opt,name=latency_p90,json=latencyP90,proto3" json:"latency_p90,omitempty"`
+	LatencyP99    *durationpb.Duration `protobuf:"bytes,7,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HttpEndpointStats) Reset() {
+	*x = HttpEndpointStats{}
+	mi := &file_ui_ui_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpEndpointStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpEndpointStats) ProtoMessage() {}
+
+func (x *HttpEndpointStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[37]
 	if x != nil {
-		return x.Verdict
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return flow.Verdict(0)
+	return mi.MessageOf(x)
 }
 
-func (x *ServiceLink) GetFlowAmount() uint64 {
+// Deprecated: Use HttpEndpointStats.ProtoReflect.Descriptor instead.
+func (*HttpEndpointStats) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HttpEndpointStats) GetMethod() string {
 	if x != nil {
-		return x.FlowAmount
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpEndpointStats) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HttpEndpointStats) GetRequests() uint64 {
+	if x != nil {
+		return x.Requests
 	}
 	return 0
 }
 
-func (x *ServiceLink) GetLatency() *ServiceLink_Latency {
+func (x *HttpEndpointStats) GetStatusClasses() []*HttpStatusClassCount {
 	if x != nil {
-		return x.Latency
+		return x.StatusClasses
 	}
 	return nil
 }
 
-func (x *ServiceLink) GetBytesTransfered() uint64 {
+func (x *HttpEndpointStats) GetLatencyP50() *durationpb.Duration {
 	if x != nil {
-		return x.BytesTransfered
+		return x.LatencyP50
 	}
-	return 0
+	return nil
 }
 
-func (x *ServiceLink) GetAuthType() flow.AuthType {
+func (x *HttpEndpointStats) GetLatencyP90() *durationpb.Duration {
 	if x != nil {
-		return x.AuthType
+		return x.LatencyP90
 	}
-	return flow.AuthType(0)
+	return nil
 }
 
-func (x *ServiceLink) GetIsEncrypted() bool {
+func (x *HttpEndpointStats) GetLatencyP99() *durationpb.Duration {
 	if x != nil {
-		return x.IsEncrypted
+		return x.LatencyP99
 	}
-	return false
+	return nil
 }
 
-type ServiceLinkState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceLink   *ServiceLink           `protobuf:"bytes,1,opt,name=service_link,json=serviceLink,proto3" json:"service_link,omitempty"`
-	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+type HttpStatusClassCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. "2xx" or "5xx"
+	StatusClass   string `protobuf:"bytes,1,opt,name=status_class,json=statusClass,proto3" json:"status_class,omitempty"`
+	Count         uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceLinkState) Reset() {
-	*x = ServiceLinkState{}
-	mi := &file_ui_ui_proto_msgTypes[11]
+func (x *HttpStatusClassCount) Reset() {
+	*x = HttpStatusClassCount{}
+	mi := &file_ui_ui_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceLinkState) String() string {
+func (x *HttpStatusClassCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceLinkState) ProtoMessage() {}
+func (*HttpStatusClassCount) ProtoMessage() {}
 
-func (x *ServiceLinkState) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[11]
+func (x *HttpStatusClassCount) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,51 +3235,51 @@ func (x *ServiceLinkState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceLinkState.ProtoReflect.Descriptor instead.
-func (*ServiceLinkState) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use HttpStatusClassCount.ProtoReflect.Descriptor instead.
+func (*HttpStatusClassCount) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ServiceLinkState) GetServiceLink() *ServiceLink {
+func (x *HttpStatusClassCount) GetStatusClass() string {
 	if x != nil {
-		return x.ServiceLink
+		return x.StatusClass
 	}
-	return nil
+	return ""
 }
 
-func (x *ServiceLinkState) GetType() StateChange {
+func (x *HttpStatusClassCount) GetCount() uint64 {
 	if x != nil {
-		return x.Type
+		return x.Count
 	}
-	return StateChange_UNKNOWN_STATE_CHANGE
+	return 0
 }
 
-type ServiceLinkFilter struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Source      []*ServiceFilter       `protobuf:"bytes,1,rep,name=source,proto3" json:"source,omitempty"`
-	Destination []*ServiceFilter       `protobuf:"bytes,2,rep,name=destination,proto3" json:"destination,omitempty"`
-	// Use string here to support things like port ranges (e.g. 100-200).
-	DestinationPort []string       `protobuf:"bytes,3,rep,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
-	Verdict         []flow.Verdict `protobuf:"varint,4,rep,packed,name=verdict,proto3,enum=flow.Verdict" json:"verdict,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type LinkL7Summary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the service link the requests were sent over
+	LinkId        string               `protobuf:"bytes,1,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	SourceId      string               `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	DestinationId string               `protobuf:"bytes,3,opt,name=destination_id,json=destinationId,proto3" json:"destination_id,omitempty"`
+	HttpEndpoints []*HttpEndpointStats `protobuf:"bytes,4,rep,name=http_endpoints,json=httpEndpoints,proto3" json:"http_endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceLinkFilter) Reset() {
-	*x = ServiceLinkFilter{}
-	mi := &file_ui_ui_proto_msgTypes[12]
+func (x *LinkL7Summary) Reset() {
+	*x = LinkL7Summary{}
+	mi := &file_ui_ui_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceLinkFilter) String() string {
+func (x *LinkL7Summary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceLinkFilter) ProtoMessage() {}
+func (*LinkL7Summary) ProtoMessage() {}
 
-func (x *ServiceLinkFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[12]
+func (x *LinkL7Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,39 +3290,91 @@ func (x *ServiceLinkFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceLinkFilter.ProtoReflect.Descriptor instead.
-func (*ServiceLinkFilter) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use LinkL7Summary.ProtoReflect.Descriptor instead.
+func (*LinkL7Summary) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ServiceLinkFilter) GetSource() []*ServiceFilter {
+func (x *LinkL7Summary) GetLinkId() string {
 	if x != nil {
-		return x.Source
+		return x.LinkId
 	}
-	return nil
+	return ""
 }
 
-func (x *ServiceLinkFilter) GetDestination() []*ServiceFilter {
+func (x *LinkL7Summary) GetSourceId() string {
 	if x != nil {
-		return x.Destination
+		return x.SourceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ServiceLinkFilter) GetDestinationPort() []string {
+func (x *LinkL7Summary) GetDestinationId() string {
 	if x != nil {
-		return x.DestinationPort
+		return x.DestinationId
+	}
+	return ""
+}
+
+func (x *LinkL7Summary) GetHttpEndpoints() []*HttpEndpointStats {
+	if x != nil {
+		return x.HttpEndpoints
 	}
 	return nil
 }
 
-func (x *ServiceLinkFilter) GetVerdict() []flow.Verdict {
+type LinkL7SummaryState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkL7Summary *LinkL7Summary         `protobuf:"bytes,1,opt,name=link_l7_summary,json=linkL7Summary,proto3" json:"link_l7_summary,omitempty"`
+	Type          StateChange            `protobuf:"varint,2,opt,name=type,proto3,enum=ui.StateChange" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkL7SummaryState) Reset() {
+	*x = LinkL7SummaryState{}
+	mi := &file_ui_ui_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkL7SummaryState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkL7SummaryState) ProtoMessage() {}
+
+func (x *LinkL7SummaryState) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[40]
 	if x != nil {
-		return x.Verdict
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkL7SummaryState.ProtoReflect.Descriptor instead.
+func (*LinkL7SummaryState) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LinkL7SummaryState) GetLinkL7Summary() *LinkL7Summary {
+	if x != nil {
+		return x.LinkL7Summary
 	}
 	return nil
 }
 
+func (x *LinkL7SummaryState) GetType() StateChange {
+	if x != nil {
+		return x.Type
+	}
+	return StateChange_UNKNOWN_STATE_CHANGE
+}
+
 type GetControlStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1243,7 +3383,7 @@ type GetControlStreamRequest struct {
 
 func (x *GetControlStreamRequest) Reset() {
 	*x = GetControlStreamRequest{}
-	mi := &file_ui_ui_proto_msgTypes[13]
+	mi := &file_ui_ui_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1255,7 +3395,7 @@ func (x *GetControlStreamRequest) String() string {
 func (*GetControlStreamRequest) ProtoMessage() {}
 
 func (x *GetControlStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[13]
+	mi := &file_ui_ui_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +3408,7 @@ func (x *GetControlStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetControlStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetControlStreamRequest) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{13}
+	return file_ui_ui_proto_rawDescGZIP(), []int{41}
 }
 
 type GetControlStreamResponse struct {
@@ -1284,7 +3424,7 @@ type GetControlStreamResponse struct {
 
 func (x *GetControlStreamResponse) Reset() {
 	*x = GetControlStreamResponse{}
-	mi := &file_ui_ui_proto_msgTypes[14]
+	mi := &file_ui_ui_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1296,7 +3436,7 @@ func (x *GetControlStreamResponse) String() string {
 func (*GetControlStreamResponse) ProtoMessage() {}
 
 func (x *GetControlStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[14]
+	mi := &file_ui_ui_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,7 +3449,7 @@ func (x *GetControlStreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetControlStreamResponse.ProtoReflect.Descriptor instead.
 func (*GetControlStreamResponse) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{14}
+	return file_ui_ui_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetControlStreamResponse) GetEvent() isGetControlStreamResponse_Event {
@@ -1364,7 +3504,7 @@ type ServiceLink_Latency struct {
 
 func (x *ServiceLink_Latency) Reset() {
 	*x = ServiceLink_Latency{}
-	mi := &file_ui_ui_proto_msgTypes[15]
+	mi := &file_ui_ui_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1376,7 +3516,7 @@ func (x *ServiceLink_Latency) String() string {
 func (*ServiceLink_Latency) ProtoMessage() {}
 
 func (x *ServiceLink_Latency) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[15]
+	mi := &file_ui_ui_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,7 +3529,7 @@ func (x *ServiceLink_Latency) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceLink_Latency.ProtoReflect.Descriptor instead.
 func (*ServiceLink_Latency) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{10, 0}
+	return file_ui_ui_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *ServiceLink_Latency) GetMin() *durationpb.Duration {
@@ -1422,7 +3562,7 @@ type GetControlStreamResponse_NamespaceStates struct {
 
 func (x *GetControlStreamResponse_NamespaceStates) Reset() {
 	*x = GetControlStreamResponse_NamespaceStates{}
-	mi := &file_ui_ui_proto_msgTypes[16]
+	mi := &file_ui_ui_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1434,7 +3574,7 @@ func (x *GetControlStreamResponse_NamespaceStates) String() string {
 func (*GetControlStreamResponse_NamespaceStates) ProtoMessage() {}
 
 func (x *GetControlStreamResponse_NamespaceStates) ProtoReflect() protoreflect.Message {
-	mi := &file_ui_ui_proto_msgTypes[16]
+	mi := &file_ui_ui_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,7 +3587,7 @@ func (x *GetControlStreamResponse_NamespaceStates) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GetControlStreamResponse_NamespaceStates.ProtoReflect.Descriptor instead.
 func (*GetControlStreamResponse_NamespaceStates) Descriptor() ([]byte, []int) {
-	return file_ui_ui_proto_rawDescGZIP(), []int{14, 0}
+	return file_ui_ui_proto_rawDescGZIP(), []int{42, 0}
 }
 
 func (x *GetControlStreamResponse_NamespaceStates) GetNamespaces() []*NamespaceState {
@@ -1461,18 +3601,27 @@ var File_ui_ui_proto protoreflect.FileDescriptor
 
 const file_ui_ui_proto_rawDesc = "" +
 	"\n" +
-	"\vui/ui.proto\x12\x02ui\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x0fflow/flow.proto\x1a\x16ui/notifications.proto\x1a\x0fui/status.proto\"\x8f\x02\n" +
+	"\vui/ui.proto\x12\x02ui\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x0fflow/flow.proto\x1a\x16ui/notifications.proto\x1a\x0fui/status.proto\"\x83\x03\n" +
 	"\x10GetEventsRequest\x12.\n" +
 	"\vevent_types\x18\x01 \x03(\x0e2\r.ui.EventTypeR\n" +
 	"eventTypes\x12-\n" +
 	"\tblacklist\x18\x02 \x03(\v2\x0f.ui.EventFilterR\tblacklist\x12-\n" +
 	"\twhitelist\x18\x03 \x03(\v2\x0f.ui.EventFilterR\twhitelist\x120\n" +
 	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12;\n" +
-	"\x0estatus_request\x18\x05 \x01(\v2\x14.ui.GetStatusRequestR\rstatusRequest\"\x84\x01\n" +
+	"\x0estatus_request\x18\x05 \x01(\v2\x14.ui.GetStatusRequestR\rstatusRequest\x12+\n" +
+	"\x11filter_expression\x18\x06 \x01(\tR\x10filterExpression\x12)\n" +
+	"\x05scope\x18\a \x01(\v2\x13.ui.ServiceMapScopeR\x05scope\x12\x1a\n" +
+	"\bclusters\x18\b \x03(\tR\bclusters\"\x83\x01\n" +
+	"\x0fServiceMapScope\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\tR\n" +
+	"namespaces\x12-\n" +
+	"\x12namespace_selector\x18\x02 \x01(\tR\x11namespaceSelector\x12!\n" +
+	"\fpod_selector\x18\x03 \x01(\tR\vpodSelector\"\x84\x01\n" +
 	"\x11GetEventsResponse\x12\x12\n" +
 	"\x04node\x18\x01 \x01(\tR\x04node\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12!\n" +
-	"\x06events\x18\x03 \x03(\v2\t.ui.EventR\x06events\"\xcb\x02\n" +
+	"\x06events\x18\x03 \x03(\v2\t.ui.EventR\x06events\"\xa7\x06\n" +
 	"\x05Event\x12 \n" +
 	"\x04flow\x18\x03 \x01(\v2\n" +
 	".flow.FlowH\x00R\x04flow\x12=\n" +
@@ -1480,7 +3629,14 @@ const file_ui_ui_proto_rawDesc = "" +
 	"\rservice_state\x18\x05 \x01(\v2\x10.ui.ServiceStateH\x00R\fserviceState\x12D\n" +
 	"\x12service_link_state\x18\x06 \x01(\v2\x14.ui.ServiceLinkStateH\x00R\x10serviceLinkState\x12!\n" +
 	"\x05flows\x18\a \x01(\v2\t.ui.FlowsH\x00R\x05flows\x126\n" +
-	"\fnotification\x18\b \x01(\v2\x10.ui.NotificationH\x00R\fnotificationB\a\n" +
+	"\fnotification\x18\b \x01(\v2\x10.ui.NotificationH\x00R\fnotification\x12P\n" +
+	"\x16namespace_drop_reasons\x18\t \x01(\v2\x18.ui.NamespaceDropReasonsH\x00R\x14namespaceDropReasons\x12J\n" +
+	"\x14namespace_node_state\x18\n" +
+	" \x01(\v2\x16.ui.NamespaceNodeStateH\x00R\x12namespaceNodeState\x12J\n" +
+	"\x14namespace_link_state\x18\v \x01(\v2\x16.ui.NamespaceLinkStateH\x00R\x12namespaceLinkState\x12N\n" +
+	"\x16k8s_service_link_state\x18\f \x01(\v2\x17.ui.K8sServiceLinkStateH\x00R\x13k8sServiceLinkState\x12S\n" +
+	"\x17service_endpoints_state\x18\r \x01(\v2\x19.ui.ServiceEndpointsStateH\x00R\x15serviceEndpointsState\x12K\n" +
+	"\x15link_l7_summary_state\x18\x0e \x01(\v2\x16.ui.LinkL7SummaryStateH\x00R\x12linkL7SummaryStateB\a\n" +
 	"\x05event\")\n" +
 	"\x05Flows\x12 \n" +
 	"\x05flows\x18\x01 \x03(\v2\n" +
@@ -1490,14 +3646,31 @@ const file_ui_ui_proto_rawDesc = "" +
 	"flowFilter\x12:\n" +
 	"\x0eservice_filter\x18\x03 \x01(\v2\x11.ui.ServiceFilterH\x00R\rserviceFilter\x12G\n" +
 	"\x13service_link_filter\x18\x04 \x01(\v2\x15.ui.ServiceLinkFilterH\x00R\x11serviceLinkFilterB\b\n" +
-	"\x06filter\"\x84\x01\n" +
+	"\x06filter\"'\n" +
+	"\x11FlowByUUIDRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\\\n" +
+	"\x12FlowByUUIDResponse\x12\x1e\n" +
+	"\x04flow\x18\x01 \x01(\v2\n" +
+	".flow.FlowR\x04flow\x12&\n" +
+	"\x0fis_from_history\x18\x02 \x01(\bR\risFromHistory\"9\n" +
+	"\x17FilterExpressionRequest\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\"\xab\x01\n" +
+	"\x18FilterExpressionResponse\x12.\n" +
+	"\twhitelist\x18\x01 \x03(\v2\x10.flow.FlowFilterR\twhitelist\x12.\n" +
+	"\tblacklist\x18\x02 \x03(\v2\x10.flow.FlowFilterR\tblacklist\x12/\n" +
+	"\x05error\x18\x03 \x01(\v2\x19.ui.FilterExpressionErrorR\x05error\"M\n" +
+	"\x15FilterExpressionError\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\rR\bposition\"\x84\x01\n" +
 	"\x13NamespaceDescriptor\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12I\n" +
 	"\x12creation_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11creationTimestamp\"l\n" +
 	"\x0eNamespaceState\x125\n" +
 	"\tnamespace\x18\x01 \x01(\v2\x17.ui.NamespaceDescriptorR\tnamespace\x12#\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"\xbd\x03\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"\xc9\x04\n" +
 	"\aService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
@@ -1510,12 +3683,36 @@ const file_ui_ui_proto_rawDesc = "" +
 	"\x12creation_timestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x11creationTimestamp\x12,\n" +
 	"\tworkloads\x18\n" +
 	" \x03(\v2\x0e.flow.WorkloadR\tworkloads\x12\x1a\n" +
-	"\bidentity\x18\f \x01(\rR\bidentity\"Z\n" +
+	"\bidentity\x18\f \x01(\rR\bidentity\x12\x1d\n" +
+	"\n" +
+	"id_aliases\x18\r \x03(\tR\tidAliases\x12\x1f\n" +
+	"\vis_boundary\x18\x0e \x01(\bR\n" +
+	"isBoundary\x120\n" +
+	"\x14is_without_endpoints\x18\x0f \x01(\bR\x12isWithoutEndpoints\x12\x18\n" +
+	"\acluster\x18\x10 \x01(\tR\acluster\"Z\n" +
 	"\fServiceState\x12%\n" +
 	"\aservice\x18\x01 \x01(\v2\v.ui.ServiceR\aservice\x12#\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"\xa5\x02\n" +
+	"\x14CiliumEndpointStatus\x12\x19\n" +
+	"\bpod_name\x18\x01 \x01(\tR\apodName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12'\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x11.ui.EndpointStateR\x05state\x12!\n" +
+	"\fcilium_state\x18\x04 \x01(\tR\vciliumState\x126\n" +
+	"\x17ingress_policy_enforced\x18\x05 \x01(\bR\x15ingressPolicyEnforced\x124\n" +
+	"\x16egress_policy_enforced\x18\x06 \x01(\bR\x14egressPolicyEnforced\x12\x1a\n" +
+	"\bidentity\x18\a \x01(\rR\bidentity\"\xc0\x01\n" +
+	"\x10ServiceEndpoints\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x126\n" +
+	"\tendpoints\x18\x02 \x03(\v2\x18.ui.CiliumEndpointStatusR\tendpoints\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\rR\x05ready\x12\"\n" +
+	"\fregenerating\x18\x04 \x01(\rR\fregenerating\x12\x1b\n" +
+	"\tnot_ready\x18\x05 \x01(\rR\bnotReady\"\x7f\n" +
+	"\x15ServiceEndpointsState\x12A\n" +
+	"\x11service_endpoints\x18\x01 \x01(\v2\x14.ui.ServiceEndpointsR\x10serviceEndpoints\x12#\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"-\n" +
 	"\rServiceFilter\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x03(\tR\tnamespace\"\xc8\x04\n" +
+	"\tnamespace\x18\x01 \x03(\tR\tnamespace\"\xed\x05\n" +
 	"\vServiceLink\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12%\n" +
@@ -1530,19 +3727,134 @@ const file_ui_ui_proto_rawDesc = "" +
 	"\x10bytes_transfered\x18\t \x01(\x04R\x0fbytesTransfered\x12+\n" +
 	"\tauth_type\x18\n" +
 	" \x01(\x0e2\x0e.flow.AuthTypeR\bauthType\x12!\n" +
-	"\fis_encrypted\x18\v \x01(\bR\visEncrypted\x1a\x90\x01\n" +
+	"\fis_encrypted\x18\v \x01(\bR\visEncrypted\x127\n" +
+	"\x0everdict_counts\x18\f \x03(\v2\x10.ui.VerdictCountR\rverdictCounts\x126\n" +
+	"\fdrop_reasons\x18\r \x03(\v2\x13.ui.DropReasonCountR\vdropReasons\x122\n" +
+	"\vk8s_service\x18\x0e \x01(\v2\x11.ui.K8sServiceRefR\n" +
+	"k8sService\x1a\x90\x01\n" +
 	"\aLatency\x12+\n" +
 	"\x03min\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03min\x12+\n" +
 	"\x03max\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03max\x12+\n" +
-	"\x03avg\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03avg\"k\n" +
+	"\x03avg\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03avg\"\xb5\x01\n" +
+	"\rK8sServiceRef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1b\n" +
+	"\tport_name\x18\x04 \x01(\tR\bportName\x12%\n" +
+	"\x0eready_backends\x18\x05 \x01(\rR\rreadyBackends\x12\x1a\n" +
+	"\bbackends\x18\x06 \x01(\rR\bbackends\"\xf4\x01\n" +
+	"\x0eK8sServiceLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x122\n" +
+	"\vk8s_service\x18\x03 \x01(\v2\x11.ui.K8sServiceRefR\n" +
+	"k8sService\x12'\n" +
+	"\x0fdestination_ids\x18\x04 \x03(\tR\x0edestinationIds\x12\x1f\n" +
+	"\vflow_amount\x18\x05 \x01(\x04R\n" +
+	"flowAmount\x127\n" +
+	"\x0everdict_counts\x18\x06 \x03(\v2\x10.ui.VerdictCountR\rverdictCounts\"x\n" +
+	"\x13K8sServiceLinkState\x12<\n" +
+	"\x10k8s_service_link\x18\x01 \x01(\v2\x12.ui.K8sServiceLinkR\x0ek8sServiceLink\x12#\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"M\n" +
+	"\fVerdictCount\x12'\n" +
+	"\averdict\x18\x01 \x01(\x0e2\r.flow.VerdictR\averdict\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"Q\n" +
+	"\x0fDropReasonCount\x12(\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x10.flow.DropReasonR\x06reason\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"j\n" +
+	"\x14NamespaceDropReasons\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x124\n" +
+	"\vtop_reasons\x18\x02 \x03(\v2\x13.ui.DropReasonCountR\n" +
+	"topReasons\"k\n" +
 	"\x10ServiceLinkState\x122\n" +
 	"\fservice_link\x18\x01 \x01(\v2\x0f.ui.ServiceLinkR\vserviceLink\x12#\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"\x9e\x01\n" +
+	"\rNamespaceNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vis_reserved\x18\x03 \x01(\bR\n" +
+	"isReserved\x12\x1f\n" +
+	"\vflow_amount\x18\x04 \x01(\x04R\n" +
+	"flowAmount\x12'\n" +
+	"\x0fservices_number\x18\x05 \x01(\rR\x0eservicesNumber\"s\n" +
+	"\x12NamespaceNodeState\x128\n" +
+	"\x0enamespace_node\x18\x01 \x01(\v2\x11.ui.NamespaceNodeR\rnamespaceNode\x12#\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"\xb7\x03\n" +
+	"\rNamespaceLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12%\n" +
+	"\x0edestination_id\x18\x03 \x01(\tR\rdestinationId\x12\x1f\n" +
+	"\vflow_amount\x18\x04 \x01(\x04R\n" +
+	"flowAmount\x127\n" +
+	"\x0everdict_counts\x18\x05 \x03(\v2\x10.ui.VerdictCountR\rverdictCounts\x126\n" +
+	"\fdrop_reasons\x18\x06 \x03(\v2\x13.ui.DropReasonCountR\vdropReasons\x12)\n" +
+	"\x10bytes_transfered\x18\a \x01(\x04R\x0fbytesTransfered\x122\n" +
+	"\x15encrypted_flow_amount\x18\b \x01(\x04R\x13encryptedFlowAmount\x12/\n" +
+	"\x13encryption_coverage\x18\t \x01(\x02R\x12encryptionCoverage\x120\n" +
+	"\x14service_links_number\x18\n" +
+	" \x01(\rR\x12serviceLinksNumber\"s\n" +
+	"\x12NamespaceLinkState\x128\n" +
+	"\x0enamespace_link\x18\x01 \x01(\v2\x11.ui.NamespaceLinkR\rnamespaceLink\x12#\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"\xc7\x01\n" +
 	"\x11ServiceLinkFilter\x12)\n" +
 	"\x06source\x18\x01 \x03(\v2\x11.ui.ServiceFilterR\x06source\x123\n" +
 	"\vdestination\x18\x02 \x03(\v2\x11.ui.ServiceFilterR\vdestination\x12)\n" +
 	"\x10destination_port\x18\x03 \x03(\tR\x0fdestinationPort\x12'\n" +
-	"\averdict\x18\x04 \x03(\x0e2\r.flow.VerdictR\averdict\"\x19\n" +
+	"\averdict\x18\x04 \x03(\x0e2\r.flow.VerdictR\averdict\"W\n" +
+	"\x15ServiceDetailsRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x1f\n" +
+	"\vflows_limit\x18\x02 \x01(\rR\n" +
+	"flowsLimit\"\xc3\x03\n" +
+	"\x16ServiceDetailsResponse\x12%\n" +
+	"\aservice\x18\x01 \x01(\v2\v.ui.ServiceR\aservice\x12)\n" +
+	"\ainbound\x18\x02 \x03(\v2\x0f.ui.ServicePeerR\ainbound\x12+\n" +
+	"\boutbound\x18\x03 \x03(\v2\x0f.ui.ServicePeerR\boutbound\x12%\n" +
+	"\x05ports\x18\x04 \x03(\v2\x0f.ui.ServicePortR\x05ports\x12,\n" +
+	"\tworkloads\x18\x05 \x03(\v2\x0e.flow.WorkloadR\tworkloads\x12\x12\n" +
+	"\x04pods\x18\x06 \x03(\tR\x04pods\x121\n" +
+	"\fl7_endpoints\x18\a \x03(\v2\x0e.ui.L7EndpointR\vl7Endpoints\x12-\n" +
+	"\frecent_flows\x18\b \x03(\v2\n" +
+	".flow.FlowR\vrecentFlows\x12!\n" +
+	"\fflows_number\x18\t \x01(\rR\vflowsNumber\x12<\n" +
+	"\x0ehttp_endpoints\x18\n" +
+	" \x03(\v2\x15.ui.HttpEndpointStatsR\rhttpEndpoints\"[\n" +
+	"\vServicePeer\x12%\n" +
+	"\aservice\x18\x01 \x01(\v2\v.ui.ServiceR\aservice\x12%\n" +
+	"\x05links\x18\x02 \x03(\v2\x0f.ui.ServiceLinkR\x05links\"n\n" +
+	"\vServicePort\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\rR\x04port\x12*\n" +
+	"\bprotocol\x18\x02 \x01(\x0e2\x0e.ui.IPProtocolR\bprotocol\x12\x1f\n" +
+	"\vflow_amount\x18\x03 \x01(\x04R\n" +
+	"flowAmount\"\x88\x01\n" +
+	"\n" +
+	"L7Endpoint\x12\x1a\n" +
+	"\bprotocol\x18\x01 \x01(\tR\bprotocol\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x1a\n" +
+	"\brequests\x18\x04 \x01(\x04R\brequests\x12\x16\n" +
+	"\x06errors\x18\x05 \x01(\x04R\x06errors\"\xd0\x02\n" +
+	"\x11HttpEndpointStats\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1a\n" +
+	"\brequests\x18\x03 \x01(\x04R\brequests\x12?\n" +
+	"\x0estatus_classes\x18\x04 \x03(\v2\x18.ui.HttpStatusClassCountR\rstatusClasses\x12:\n" +
+	"\vlatency_p50\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP50\x12:\n" +
+	"\vlatency_p90\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP90\x12:\n" +
+	"\vlatency_p99\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP99\"O\n" +
+	"\x14HttpStatusClassCount\x12!\n" +
+	"\fstatus_class\x18\x01 \x01(\tR\vstatusClass\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"\xaa\x01\n" +
+	"\rLinkL7Summary\x12\x17\n" +
+	"\alink_id\x18\x01 \x01(\tR\x06linkId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12%\n" +
+	"\x0edestination_id\x18\x03 \x01(\tR\rdestinationId\x12<\n" +
+	"\x0ehttp_endpoints\x18\x04 \x03(\v2\x15.ui.HttpEndpointStatsR\rhttpEndpoints\"t\n" +
+	"\x12LinkL7SummaryState\x129\n" +
+	"\x0flink_l7_summary\x18\x01 \x01(\v2\x11.ui.LinkL7SummaryR\rlinkL7Summary\x12#\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0f.ui.StateChangeR\x04type\"\x19\n" +
 	"\x17GetControlStreamRequest\"\xf2\x01\n" +
 	"\x18GetControlStreamResponse\x12N\n" +
 	"\n" +
@@ -1553,7 +3865,7 @@ const file_ui_ui_proto_rawDesc = "" +
 	"\n" +
 	"namespaces\x18\x01 \x03(\v2\x12.ui.NamespaceStateR\n" +
 	"namespacesB\a\n" +
-	"\x05event*\x83\x01\n" +
+	"\x05event*\xeb\x01\n" +
 	"\tEventType\x12\x11\n" +
 	"\rUNKNOWN_EVENT\x10\x00\x12\b\n" +
 	"\x04FLOW\x10\x01\x12\x17\n" +
@@ -1562,14 +3874,26 @@ const file_ui_ui_proto_rawDesc = "" +
 	"\x12SERVICE_LINK_STATE\x10\x04\x12\t\n" +
 	"\x05FLOWS\x10\x05\x12\n" +
 	"\n" +
-	"\x06STATUS\x10\x06*Q\n" +
+	"\x06STATUS\x10\x06\x12\x10\n" +
+	"\fDROP_REASONS\x10\a\x12\x11\n" +
+	"\rNAMESPACE_MAP\x10\b\x12\x1a\n" +
+	"\x16K8S_SERVICE_LINK_STATE\x10\t\x12\x15\n" +
+	"\x11SERVICE_ENDPOINTS\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"L7_SUMMARY\x10\v*Q\n" +
 	"\n" +
 	"IPProtocol\x12\x17\n" +
 	"\x13UNKNOWN_IP_PROTOCOL\x10\x00\x12\a\n" +
 	"\x03TCP\x10\x01\x12\a\n" +
 	"\x03UDP\x10\x02\x12\v\n" +
 	"\aICMP_V4\x10\x03\x12\v\n" +
-	"\aICMP_V6\x10\x04*Y\n" +
+	"\aICMP_V6\x10\x04*r\n" +
+	"\rEndpointState\x12\x1a\n" +
+	"\x16UNKNOWN_ENDPOINT_STATE\x10\x00\x12\x12\n" +
+	"\x0eENDPOINT_READY\x10\x01\x12\x19\n" +
+	"\x15ENDPOINT_REGENERATING\x10\x02\x12\x16\n" +
+	"\x12ENDPOINT_NOT_READY\x10\x03*Y\n" +
 	"\vStateChange\x12\x18\n" +
 	"\x14UNKNOWN_STATE_CHANGE\x10\x00\x12\t\n" +
 	"\x05ADDED\x10\x01\x12\f\n" +
@@ -1594,91 +3918,170 @@ func file_ui_ui_proto_rawDescGZIP() []byte {
 	return file_ui_ui_proto_rawDescData
 }
 
-var file_ui_ui_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_ui_ui_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_ui_ui_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ui_ui_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_ui_ui_proto_goTypes = []any{
 	(EventType)(0),                                   // 0: ui.EventType
 	(IPProtocol)(0),                                  // 1: ui.IPProtocol
-	(StateChange)(0),                                 // 2: ui.StateChange
-	(*GetEventsRequest)(nil),                         // 3: ui.GetEventsRequest
-	(*GetEventsResponse)(nil),                        // 4: ui.GetEventsResponse
-	(*Event)(nil),                                    // 5: ui.Event
-	(*Flows)(nil),                                    // 6: ui.Flows
-	(*EventFilter)(nil),                              // 7: ui.EventFilter
-	(*NamespaceDescriptor)(nil),                      // 8: ui.NamespaceDescriptor
-	(*NamespaceState)(nil),                           // 9: ui.NamespaceState
-	(*Service)(nil),                                  // 10: ui.Service
-	(*ServiceState)(nil),                             // 11: ui.ServiceState
-	(*ServiceFilter)(nil),                            // 12: ui.ServiceFilter
-	(*ServiceLink)(nil),                              // 13: ui.ServiceLink
-	(*ServiceLinkState)(nil),                         // 14: ui.ServiceLinkState
-	(*ServiceLinkFilter)(nil),                        // 15: ui.ServiceLinkFilter
-	(*GetControlStreamRequest)(nil),                  // 16: ui.GetControlStreamRequest
-	(*GetControlStreamResponse)(nil),                 // 17: ui.GetControlStreamResponse
-	(*ServiceLink_Latency)(nil),                      // 18: ui.ServiceLink.Latency
-	(*GetControlStreamResponse_NamespaceStates)(nil), // 19: ui.GetControlStreamResponse.NamespaceStates
-	(*timestamppb.Timestamp)(nil),                    // 20: google.protobuf.Timestamp
-	(*GetStatusRequest)(nil),                         // 21: ui.GetStatusRequest
-	(*flow.Flow)(nil),                                // 22: flow.Flow
-	(*Notification)(nil),                             // 23: ui.Notification
-	(*flow.FlowFilter)(nil),                          // 24: flow.FlowFilter
-	(*flow.Workload)(nil),                            // 25: flow.Workload
-	(flow.Verdict)(0),                                // 26: flow.Verdict
-	(flow.AuthType)(0),                               // 27: flow.AuthType
-	(*durationpb.Duration)(nil),                      // 28: google.protobuf.Duration
-	(*GetStatusResponse)(nil),                        // 29: ui.GetStatusResponse
+	(EndpointState)(0),                               // 2: ui.EndpointState
+	(StateChange)(0),                                 // 3: ui.StateChange
+	(*GetEventsRequest)(nil),                         // 4: ui.GetEventsRequest
+	(*ServiceMapScope)(nil),                          // 5: ui.ServiceMapScope
+	(*GetEventsResponse)(nil),                        // 6: ui.GetEventsResponse
+	(*Event)(nil),                                    // 7: ui.Event
+	(*Flows)(nil),                                    // 8: ui.Flows
+	(*EventFilter)(nil),                              // 9: ui.EventFilter
+	(*FlowByUUIDRequest)(nil),                        // 10: ui.FlowByUUIDRequest
+	(*FlowByUUIDResponse)(nil),                       // 11: ui.FlowByUUIDResponse
+	(*FilterExpressionRequest)(nil),                  // 12: ui.FilterExpressionRequest
+	(*FilterExpressionResponse)(nil),                 // 13: ui.FilterExpressionResponse
+	(*FilterExpressionError)(nil),                    // 14: ui.FilterExpressionError
+	(*NamespaceDescriptor)(nil),                      // 15: ui.NamespaceDescriptor
+	(*NamespaceState)(nil),                           // 16: ui.NamespaceState
+	(*Service)(nil),                                  // 17: ui.Service
+	(*ServiceState)(nil),                             // 18: ui.ServiceState
+	(*CiliumEndpointStatus)(nil),                     // 19: ui.CiliumEndpointStatus
+	(*ServiceEndpoints)(nil),                         // 20: ui.ServiceEndpoints
+	(*ServiceEndpointsState)(nil),                    // 21: ui.ServiceEndpointsState
+	(*ServiceFilter)(nil),                            // 22: ui.ServiceFilter
+	(*ServiceLink)(nil),                              // 23: ui.ServiceLink
+	(*K8SServiceRef)(nil),                            // 24: ui.K8sServiceRef
+	(*K8SServiceLink)(nil),                           // 25: ui.K8sServiceLink
+	(*K8SServiceLinkState)(nil),                      // 26: ui.K8sServiceLinkState
+	(*VerdictCount)(nil),                             // 27: ui.VerdictCount
+	(*DropReasonCount)(nil),                          // 28: ui.DropReasonCount
+	(*NamespaceDropReasons)(nil),                     // 29: ui.NamespaceDropReasons
+	(*ServiceLinkState)(nil),                         // 30: ui.ServiceLinkState
+	(*NamespaceNode)(nil),                            // 31: ui.NamespaceNode
+	(*NamespaceNodeState)(nil),                       // 32: ui.NamespaceNodeState
+	(*NamespaceLink)(nil),                            // 33: ui.NamespaceLink
+	(*NamespaceLinkState)(nil),                       // 34: ui.NamespaceLinkState
+	(*ServiceLinkFilter)(nil),                        // 35: ui.ServiceLinkFilter
+	(*ServiceDetailsRequest)(nil),                    // 36: ui.ServiceDetailsRequest
+	(*ServiceDetailsResponse)(nil),                   // 37: ui.ServiceDetailsResponse
+	(*ServicePeer)(nil),                              // 38: ui.ServicePeer
+	(*ServicePort)(nil),                              // 39: ui.ServicePort
+	(*L7Endpoint)(nil),                               // 40: ui.L7Endpoint
+	(*HttpEndpointStats)(nil),                        // 41: ui.HttpEndpointStats
+	(*HttpStatusClassCount)(nil),                     // 42: ui.HttpStatusClassCount
+	(*LinkL7Summary)(nil),                            // 43: ui.LinkL7Summary
+	(*LinkL7SummaryState)(nil),                       // 44: ui.LinkL7SummaryState
+	(*GetControlStreamRequest)(nil),                  // 45: ui.GetControlStreamRequest
+	(*GetControlStreamResponse)(nil),                 // 46: ui.GetControlStreamResponse
+	(*ServiceLink_Latency)(nil),                      // 47: ui.ServiceLink.Latency
+	(*GetControlStreamResponse_NamespaceStates)(nil), // 48: ui.GetControlStreamResponse.NamespaceStates
+	(*timestamppb.Timestamp)(nil),                    // 49: google.protobuf.Timestamp
+	(*GetStatusRequest)(nil),                         // 50: ui.GetStatusRequest
+	(*flow.Flow)(nil),                                // 51: flow.Flow
+	(*Notification)(nil),                             // 52: ui.Notification
+	(*flow.FlowFilter)(nil),                          // 53: flow.FlowFilter
+	(*flow.Workload)(nil),                            // 54: flow.Workload
+	(flow.Verdict)(0),                                // 55: flow.Verdict
+	(flow.AuthType)(0),                               // 56: flow.AuthType
+	(flow.DropReason)(0),                             // 57: flow.DropReason
+	(*durationpb.Duration)(nil),                      // 58: google.protobuf.Duration
+	(*GetStatusResponse)(nil),                        // 59: ui.GetStatusResponse
 }
 var file_ui_ui_proto_depIdxs = []int32{
 	0,  // 0: ui.GetEventsRequest.event_types:type_name -> ui.EventType
-	7,  // 1: ui.GetEventsRequest.blacklist:type_name -> ui.EventFilter
-	7,  // 2: ui.GetEventsRequest.whitelist:type_name -> ui.EventFilter
-	20, // 3: ui.GetEventsRequest.since:type_name -> google.protobuf.Timestamp
-	21, // 4: ui.GetEventsRequest.status_request:type_name -> ui.GetStatusRequest
-	20, // 5: ui.GetEventsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	5,  // 6: ui.GetEventsResponse.events:type_name -> ui.Event
-	22, // 7: ui.Event.flow:type_name -> flow.Flow
-	9,  // 8: ui.Event.namespace_state:type_name -> ui.NamespaceState
-	11, // 9: ui.Event.service_state:type_name -> ui.ServiceState
-	14, // 10: ui.Event.service_link_state:type_name -> ui.ServiceLinkState
-	6,  // 11: ui.Event.flows:type_name -> ui.Flows
-	23, // 12: ui.Event.notification:type_name -> ui.Notification
-	22, // 13: ui.Flows.flows:type_name -> flow.Flow
-	24, // 14: ui.EventFilter.flow_filter:type_name -> flow.FlowFilter
-	12, // 15: ui.EventFilter.service_filter:type_name -> ui.ServiceFilter
-	15, // 16: ui.EventFilter.service_link_filter:type_name -> ui.ServiceLinkFilter
-	20, // 17: ui.NamespaceDescriptor.creation_timestamp:type_name -> google.protobuf.Timestamp
-	8,  // 18: ui.NamespaceState.namespace:type_name -> ui.NamespaceDescriptor
-	2,  // 19: ui.NamespaceState.type:type_name -> ui.StateChange
-	20, // 20: ui.Service.creation_timestamp:type_name -> google.protobuf.Timestamp
-	25, // 21: ui.Service.workloads:type_name -> flow.Workload
-	10, // 22: ui.ServiceState.service:type_name -> ui.Service
-	2,  // 23: ui.ServiceState.type:type_name -> ui.StateChange
-	1,  // 24: ui.ServiceLink.ip_protocol:type_name -> ui.IPProtocol
-	26, // 25: ui.ServiceLink.verdict:type_name -> flow.Verdict
-	18, // 26: ui.ServiceLink.latency:type_name -> ui.ServiceLink.Latency
-	27, // 27: ui.ServiceLink.auth_type:type_name -> flow.AuthType
-	13, // 28: ui.ServiceLinkState.service_link:type_name -> ui.ServiceLink
-	2,  // 29: ui.ServiceLinkState.type:type_name -> ui.StateChange
-	12, // 30: ui.ServiceLinkFilter.source:type_name -> ui.ServiceFilter
-	12, // 31: ui.ServiceLinkFilter.destination:type_name -> ui.ServiceFilter
-	26, // 32: ui.ServiceLinkFilter.verdict:type_name -> flow.Verdict
-	19, // 33: ui.GetControlStreamResponse.namespaces:type_name -> ui.GetControlStreamResponse.NamespaceStates
-	23, // 34: ui.GetControlStreamResponse.notification:type_name -> ui.Notification
-	28, // 35: ui.ServiceLink.Latency.min:type_name -> google.protobuf.Duration
-	28, // 36: ui.ServiceLink.Latency.max:type_name -> google.protobuf.Duration
-	28, // 37: ui.ServiceLink.Latency.avg:type_name -> google.protobuf.Duration
-	9,  // 38: ui.GetControlStreamResponse.NamespaceStates.namespaces:type_name -> ui.NamespaceState
-	3,  // 39: ui.UI.GetEvents:input_type -> ui.GetEventsRequest
-	21, // 40: ui.UI.GetStatus:input_type -> ui.GetStatusRequest
-	16, // 41: ui.UI.GetControlStream:input_type -> ui.GetControlStreamRequest
-	4,  // 42: ui.UI.GetEvents:output_type -> ui.GetEventsResponse
-	29, // 43: ui.UI.GetStatus:output_type -> ui.GetStatusResponse
-	17, // 44: ui.UI.GetControlStream:output_type -> ui.GetControlStreamResponse
-	42, // [42:45] is the sub-list for method output_type
-	39, // [39:42] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	9,  // 1: ui.GetEventsRequest.blacklist:type_name -> ui.EventFilter
+	9,  // 2: ui.GetEventsRequest.whitelist:type_name -> ui.EventFilter
+	49, // 3: ui.GetEventsRequest.since:type_name -> google.protobuf.Timestamp
+	50, // 4: ui.GetEventsRequest.status_request:type_name -> ui.GetStatusRequest
+	5,  // 5: ui.GetEventsRequest.scope:type_name -> ui.ServiceMapScope
+	49, // 6: ui.GetEventsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	7,  // 7: ui.GetEventsResponse.events:type_name -> ui.Event
+	51, // 8: ui.Event.flow:type_name -> flow.Flow
+	16, // 9: ui.Event.namespace_state:type_name -> ui.NamespaceState
+	18, // 10: ui.Event.service_state:type_name -> ui.ServiceState
+	30, // 11: ui.Event.service_link_state:type_name -> ui.ServiceLinkState
+	8,  // 12: ui.Event.flows:type_name -> ui.Flows
+	52, // 13: ui.Event.notification:type_name -> ui.Notification
+	29, // 14: ui.Event.namespace_drop_reasons:type_name -> ui.NamespaceDropReasons
+	32, // 15: ui.Event.namespace_node_state:type_name -> ui.NamespaceNodeState
+	34, // 16: ui.Event.namespace_link_state:type_name -> ui.NamespaceLinkState
+	26, // 17: ui.Event.k8s_service_link_state:type_name -> ui.K8sServiceLinkState
+	21, // 18: ui.Event.service_endpoints_state:type_name -> ui.ServiceEndpointsState
+	44, // 19: ui.Event.link_l7_summary_state:type_name -> ui.LinkL7SummaryState
+	51, // 20: ui.Flows.flows:type_name -> flow.Flow
+	53, // 21: ui.EventFilter.flow_filter:type_name -> flow.FlowFilter
+	22, // 22: ui.EventFilter.service_filter:type_name -> ui.ServiceFilter
+	35, // 23: ui.EventFilter.service_link_filter:type_name -> ui.ServiceLinkFilter
+	51, // 24: ui.FlowByUUIDResponse.flow:type_name -> flow.Flow
+	53, // 25: ui.FilterExpressionResponse.whitelist:type_name -> flow.FlowFilter
+	53, // 26: ui.FilterExpressionResponse.blacklist:type_name -> flow.FlowFilter
+	14, // 27: ui.FilterExpressionResponse.error:type_name -> ui.FilterExpressionError
+	49, // 28: ui.NamespaceDescriptor.creation_timestamp:type_name -> google.protobuf.Timestamp
+	15, // 29: ui.NamespaceState.namespace:type_name -> ui.NamespaceDescriptor
+	3,  // 30: ui.NamespaceState.type:type_name -> ui.StateChange
+	49, // 31: ui.Service.creation_timestamp:type_name -> google.protobuf.Timestamp
+	54, // 32: ui.Service.workloads:type_name -> flow.Workload
+	17, // 33: ui.ServiceState.service:type_name -> ui.Service
+	3,  // 34: ui.ServiceState.type:type_name -> ui.StateChange
+	2,  // 35: ui.CiliumEndpointStatus.state:type_name -> ui.EndpointState
+	19, // 36: ui.ServiceEndpoints.endpoints:type_name -> ui.CiliumEndpointStatus
+	20, // 37: ui.ServiceEndpointsState.service_endpoints:type_name -> ui.ServiceEndpoints
+	3,  // 38: ui.ServiceEndpointsState.type:type_name -> ui.StateChange
+	1,  // 39: ui.ServiceLink.ip_protocol:type_name -> ui.IPProtocol
+	55, // 40: ui.ServiceLink.verdict:type_name -> flow.Verdict
+	47, // 41: ui.ServiceLink.latency:type_name -> ui.ServiceLink.Latency
+	56, // 42: ui.ServiceLink.auth_type:type_name -> flow.AuthType
+	27, // 43: ui.ServiceLink.verdict_counts:type_name -> ui.VerdictCount
+	28, // 44: ui.ServiceLink.drop_reasons:type_name -> ui.DropReasonCount
+	24, // 45: ui.ServiceLink.k8s_service:type_name -> ui.K8sServiceRef
+	24, // 46: ui.K8sServiceLink.k8s_service:type_name -> ui.K8sServiceRef
+	27, // 47: ui.K8sServiceLink.verdict_counts:type_name -> ui.VerdictCount
+	25, // 48: ui.K8sServiceLinkState.k8s_service_link:type_name -> ui.K8sServiceLink
+	3,  // 49: ui.K8sServiceLinkState.type:type_name -> ui.StateChange
+	55, // 50: ui.VerdictCount.verdict:type_name -> flow.Verdict
+	57, // 51: ui.DropReasonCount.reason:type_name -> flow.DropReason
+	28, // 52: ui.NamespaceDropReasons.top_reasons:type_name -> ui.DropReasonCount
+	23, // 53: ui.ServiceLinkState.service_link:type_name -> ui.ServiceLink
+	3,  // 54: ui.ServiceLinkState.type:type_name -> ui.StateChange
+	31, // 55: ui.NamespaceNodeState.namespace_node:type_name -> ui.NamespaceNode
+	3,  // 56: ui.NamespaceNodeState.type:type_name -> ui.StateChange
+	27, // 57: ui.NamespaceLink.verdict_counts:type_name -> ui.VerdictCount
+	28, // 58: ui.NamespaceLink.drop_reasons:type_name -> ui.DropReasonCount
+	33, // 59: ui.NamespaceLinkState.namespace_link:type_name -> ui.NamespaceLink
+	3,  // 60: ui.NamespaceLinkState.type:type_name -> ui.StateChange
+	22, // 61: ui.ServiceLinkFilter.source:type_name -> ui.ServiceFilter
+	22, // 62: ui.ServiceLinkFilter.destination:type_name -> ui.ServiceFilter
+	55, // 63: ui.ServiceLinkFilter.verdict:type_name -> flow.Verdict
+	17, // 64: ui.ServiceDetailsResponse.service:type_name -> ui.Service
+	38, // 65: ui.ServiceDetailsResponse.inbound:type_name -> ui.ServicePeer
+	38, // 66: ui.ServiceDetailsResponse.outbound:type_name -> ui.ServicePeer
+	39, // 67: ui.ServiceDetailsResponse.ports:type_name -> ui.ServicePort
+	54, // 68: ui.ServiceDetailsResponse.workloads:type_name -> flow.Workload
+	40, // 69: ui.ServiceDetailsResponse.l7_endpoints:type_name -> ui.L7Endpoint
+	51, // 70: ui.ServiceDetailsResponse.recent_flows:type_name -> flow.Flow
+	41, // 71: ui.ServiceDetailsResponse.http_endpoints:type_name -> ui.HttpEndpointStats
+	17, // 72: ui.ServicePeer.service:type_name -> ui.Service
+	23, // 73: ui.ServicePeer.links:type_name -> ui.ServiceLink
+	1,  // 74: ui.ServicePort.protocol:type_name -> ui.IPProtocol
+	42, // 75: ui.HttpEndpointStats.status_classes:type_name -> ui.HttpStatusClassCount
+	58, // 76: ui.HttpEndpointStats.latency_p50:type_name -> google.protobuf.Duration
+	58, // 77: ui.HttpEndpointStats.latency_p90:type_name -> google.protobuf.Duration
+	58, // 78: ui.HttpEndpointStats.latency_p99:type_name -> google.protobuf.Duration
+	41, // 79: ui.LinkL7Summary.http_endpoints:type_name -> ui.HttpEndpointStats
+	43, // 80: ui.LinkL7SummaryState.link_l7_summary:type_name -> ui.LinkL7Summary
+	3,  // 81: ui.LinkL7SummaryState.type:type_name -> ui.StateChange
+	48, // 82: ui.GetControlStreamResponse.namespaces:type_name -> ui.GetControlStreamResponse.NamespaceStates
+	52, // 83: ui.GetControlStreamResponse.notification:type_name -> ui.Notification
+	58, // 84: ui.ServiceLink.Latency.min:type_name -> google.protobuf.Duration
+	58, // 85: ui.ServiceLink.Latency.max:type_name -> google.protobuf.Duration
+	58, // 86: ui.ServiceLink.Latency.avg:type_name -> google.protobuf.Duration
+	16, // 87: ui.GetControlStreamResponse.NamespaceStates.namespaces:type_name -> ui.NamespaceState
+	4,  // 88: ui.UI.GetEvents:input_type -> ui.GetEventsRequest
+	50, // 89: ui.UI.GetStatus:input_type -> ui.GetStatusRequest
+	45, // 90: ui.UI.GetControlStream:input_type -> ui.GetControlStreamRequest
+	6,  // 91: ui.UI.GetEvents:output_type -> ui.GetEventsResponse
+	59, // 92: ui.UI.GetStatus:output_type -> ui.GetStatusResponse
+	46, // 93: ui.UI.GetControlStream:output_type -> ui.GetControlStreamResponse
+	91, // [91:94] is the sub-list for method output_type
+	88, // [88:91] is the sub-list for method input_type
+	88, // [88:88] is the sub-list for extension type_name
+	88, // [88:88] is the sub-list for extension extendee
+	0,  // [0:88] is the sub-list for field type_name
 }
 
 func init() { file_ui_ui_proto_init() }
@@ -1688,20 +4091,26 @@ func file_ui_ui_proto_init() {
 	}
 	file_ui_notifications_proto_init()
 	file_ui_status_proto_init()
-	file_ui_ui_proto_msgTypes[2].OneofWrappers = []any{
+	file_ui_ui_proto_msgTypes[3].OneofWrappers = []any{
 		(*Event_Flow)(nil),
 		(*Event_NamespaceState)(nil),
 		(*Event_ServiceState)(nil),
 		(*Event_ServiceLinkState)(nil),
 		(*Event_Flows)(nil),
 		(*Event_Notification)(nil),
+		(*Event_NamespaceDropReasons)(nil),
+		(*Event_NamespaceNodeState)(nil),
+		(*Event_NamespaceLinkState)(nil),
+		(*Event_K8SServiceLinkState)(nil),
+		(*Event_ServiceEndpointsState)(nil),
+		(*Event_LinkL7SummaryState)(nil),
 	}
-	file_ui_ui_proto_msgTypes[4].OneofWrappers = []any{
+	file_ui_ui_proto_msgTypes[5].OneofWrappers = []any{
 		(*EventFilter_FlowFilter)(nil),
 		(*EventFilter_ServiceFilter)(nil),
 		(*EventFilter_ServiceLinkFilter)(nil),
 	}
-	file_ui_ui_proto_msgTypes[14].OneofWrappers = []any{
+	file_ui_ui_proto_msgTypes[42].OneofWrappers = []any{
 		(*GetControlStreamResponse_Namespaces)(nil),
 		(*GetControlStreamResponse_Notification)(nil),
 	}
@@ -1710,8 +4119,8 @@ func file_ui_ui_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ui_ui_proto_rawDesc), len(file_ui_ui_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   17,
+			NumEnums:      4,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,6 +30,7 @@ import type { PartialMessage } from "@protobuf-ts/runtime";
 import { reflectionMergePartial } from "@protobuf-ts/runtime";
 import { MessageType } from "@protobuf-ts/runtime";
 import { Duration } from "../google/protobuf/duration_pb";
+import { DropReason } from "../flow/flow_pb";
 import { AuthType } from "../flow/flow_pb";
 import { Verdict } from "../flow/flow_pb";
 import { Workload } from "../flow/flow_pb";
@@ -69,6 +70,53 @@ export interface GetEventsRequest {
      * @generated from protobuf field: ui.GetStatusRequest status_request = 5
      */
     statusRequest?: GetStatusRequest;
+    /**
+     * Filters in hubble CLI style, e.g. `from-pod=default/api and not port=53`,
+     * the expression is compiled into whitelist/blacklist flow filters and
+     * is joined with the ones above using "and"
+     *
+     * @generated from protobuf field: string filter_expression = 6
+     */
+    filterExpression: string;
+    /**
+     * Namespaces and pods the service map is built for, services outside of
+     * the scope are reported as boundary ones
+     *
+     * @generated from protobuf field: ui.ServiceMapScope scope = 7
+     */
+    scope?: ServiceMapScope;
+    /**
+     * ClusterMesh clusters the service map is built for, flows are let
+     * through if either of their sides belongs to one of the clusters
+     *
+     * @generated from protobuf field: repeated string clusters = 8
+     */
+    clusters: string[];
+}
+/**
+ * Namespace selector is resolved via k8s API when the stream starts. If pod
+ * selector is set, only the pods whose labels it matches within the
+ * namespaces are in the scope, otherwise the whole namespaces are.
+ *
+ * @generated from protobuf message ui.ServiceMapScope
+ */
+export interface ServiceMapScope {
+    /**
+     * @generated from protobuf field: repeated string namespaces = 1
+     */
+    namespaces: string[];
+    /**
+     * Label selector of namespaces added to the ones above, e.g. `team=shop`
+     *
+     * @generated from protobuf field: string namespace_selector = 2
+     */
+    namespaceSelector: string;
+    /**
+     * Label selector of pods, e.g. `app in (api, web),tier!=db`
+     *
+     * @generated from protobuf field: string pod_selector = 3
+     */
+    podSelector: string;
 }
 /**
  * @generated from protobuf message ui.GetEventsResponse
@@ -137,6 +185,42 @@ export interface Event {
          * @generated from protobuf field: ui.Notification notification = 8
          */
         notification: Notification;
+    } | {
+        oneofKind: "namespaceDropReasons";
+        /**
+         * @generated from protobuf field: ui.NamespaceDropReasons namespace_drop_reasons = 9
+         */
+        namespaceDropReasons: NamespaceDropReasons;
+    } | {
+        oneofKind: "namespaceNodeState";
+        /**
+         * @generated from protobuf field: ui.NamespaceNodeState namespace_node_state = 10
+         */
+        namespaceNodeState: NamespaceNodeState;
+    } | {
+        oneofKind: "namespaceLinkState";
+        /**
+         * @generated from protobuf field: ui.NamespaceLinkState namespace_link_state = 11
+         */
+        namespaceLinkState: NamespaceLinkState;
+    } | {
+        oneofKind: "k8sServiceLinkState";
+        /**
+         * @generated from protobuf field: ui.K8sServiceLinkState k8s_service_link_state = 12
+         */
+        k8sServiceLinkState: K8sServiceLinkState;
+    } | {
+        oneofKind: "serviceEndpointsState";
+        /**
+         * @generated from protobuf field: ui.ServiceEndpointsState service_endpoints_state = 13
+         */
+        serviceEndpointsState: ServiceEndpointsState;
+    } | {
+        oneofKind: "linkL7SummaryState";
+        /**
+         * @generated from protobuf field: ui.LinkL7SummaryState link_l7_summary_state = 14
+         */
+        linkL7SummaryState: LinkL7SummaryState;
     } | {
         oneofKind: undefined;
     };
@@ -183,6 +267,76 @@ export interface EventFilter {
         oneofKind: undefined;
     };
 }
+/**
+ * @generated from protobuf message ui.FlowByUUIDRequest
+ */
+export interface FlowByUUIDRequest {
+    /**
+     * @generated from protobuf field: string uuid = 1
+     */
+    uuid: string;
+}
+/**
+ * @generated from protobuf message ui.FlowByUUIDResponse
+ */
+export interface FlowByUUIDResponse {
+    /**
+     * @generated from protobuf field: flow.Flow flow = 1
+     */
+    flow?: Flow;
+    /**
+     * The flow is found in the flows recently seen by backend, otherwise it
+     * is fetched from hubble-relay
+     *
+     * @generated from protobuf field: bool is_from_history = 2
+     */
+    isFromHistory: boolean;
+}
+/**
+ * @generated from protobuf message ui.FilterExpressionRequest
+ */
+export interface FilterExpressionRequest {
+    /**
+     * @generated from protobuf field: string expression = 1
+     */
+    expression: string;
+}
+/**
+ * Flow filters the expression is compiled into or the error if it's invalid
+ * or can't be expressed as flow filters, e.g. `from-ip=10.0.0.0/8 and
+ * from-ip=10.1.0.0/16`
+ *
+ * @generated from protobuf message ui.FilterExpressionResponse
+ */
+export interface FilterExpressionResponse {
+    /**
+     * @generated from protobuf field: repeated flow.FlowFilter whitelist = 1
+     */
+    whitelist: FlowFilter[];
+    /**
+     * @generated from protobuf field: repeated flow.FlowFilter blacklist = 2
+     */
+    blacklist: FlowFilter[];
+    /**
+     * @generated from protobuf field: ui.FilterExpressionError error = 3
+     */
+    error?: FilterExpressionError;
+}
+/**
+ * @generated from protobuf message ui.FilterExpressionError
+ */
+export interface FilterExpressionError {
+    /**
+     * @generated from protobuf field: string message = 1
+     */
+    message: string;
+    /**
+     * Zero-based offset of the expression part the error refers to
+     *
+     * @generated from protobuf field: uint32 position = 2
+     */
+    position: number;
+}
 /**
  * @generated from protobuf message ui.NamespaceDescriptor
  */
@@ -250,8 +404,8 @@ export interface Service {
      */
     ingressPolicyEnforced: boolean;
     /**
-     * This is a string instead of boolean because Cilium reports status message. If it's
-     * successfully enabled, this string is set to "OK" I think.
+     * Status of cilium endpoints of the card: "OK" if all of them are ready,
+     * e.g. "1/3 endpoints ready" otherwise, empty if endpoints are unknown.
      *
      * @generated from protobuf field: string visibility_policy_status = 8
      */
@@ -270,6 +424,33 @@ export interface Service {
      * @generated from protobuf field: uint32 identity = 12
      */
     identity: number;
+    /**
+     * Ids this service had before its identity has changed, flows referring
+     * to them belong to this service
+     *
+     * @generated from protobuf field: repeated string id_aliases = 13
+     */
+    idAliases: string[];
+    /**
+     * The service is outside of the requested scope and is shown only
+     * because it talks to services within the scope
+     *
+     * @generated from protobuf field: bool is_boundary = 14
+     */
+    isBoundary: boolean;
+    /**
+     * The service is k8s Service without ready backends, flows to it aren't
+     * translated to any pod
+     *
+     * @generated from protobuf field: bool is_without_endpoints = 15
+     */
+    isWithoutEndpoints: boolean;
+    /**
+     * ClusterMesh cluster the service belongs to, empty if it's unknown
+     *
+     * @generated from protobuf field: string cluster = 16
+     */
+    cluster: string;
 }
 /**
  * @generated from protobuf message ui.ServiceState
@@ -284,6 +465,83 @@ export interface ServiceState {
      */
     type: StateChange;
 }
+/**
+ * @generated from protobuf message ui.CiliumEndpointStatus
+ */
+export interface CiliumEndpointStatus {
+    /**
+     * Name and namespace of the pod the endpoint belongs to
+     *
+     * @generated from protobuf field: string pod_name = 1
+     */
+    podName: string;
+    /**
+     * @generated from protobuf field: string namespace = 2
+     */
+    namespace: string;
+    /**
+     * @generated from protobuf field: ui.EndpointState state = 3
+     */
+    state: EndpointState;
+    /**
+     * State as cilium reports it, e.g. "waiting-to-regenerate"
+     *
+     * @generated from protobuf field: string cilium_state = 4
+     */
+    ciliumState: string;
+    /**
+     * @generated from protobuf field: bool ingress_policy_enforced = 5
+     */
+    ingressPolicyEnforced: boolean;
+    /**
+     * @generated from protobuf field: bool egress_policy_enforced = 6
+     */
+    egressPolicyEnforced: boolean;
+    /**
+     * @generated from protobuf field: uint32 identity = 7
+     */
+    identity: number;
+}
+/**
+ * Cilium endpoints of the pods seen behind the service card
+ *
+ * @generated from protobuf message ui.ServiceEndpoints
+ */
+export interface ServiceEndpoints {
+    /**
+     * @generated from protobuf field: string service_id = 1
+     */
+    serviceId: string;
+    /**
+     * @generated from protobuf field: repeated ui.CiliumEndpointStatus endpoints = 2
+     */
+    endpoints: CiliumEndpointStatus[];
+    /**
+     * @generated from protobuf field: uint32 ready = 3
+     */
+    ready: number;
+    /**
+     * @generated from protobuf field: uint32 regenerating = 4
+     */
+    regenerating: number;
+    /**
+     * @generated from protobuf field: uint32 not_ready = 5
+     */
+    notReady: number;
+}
+/**
+ * @generated from protobuf message ui.ServiceEndpointsState
+ */
+export interface ServiceEndpointsState {
+    /**
+     * @generated from protobuf field: ui.ServiceEndpoints service_endpoints = 1
+     */
+    serviceEndpoints?: ServiceEndpoints;
+    /**
+     * @generated from protobuf field: ui.StateChange type = 2
+     */
+    type: StateChange;
+}
 /**
  * @generated from protobuf message ui.ServiceFilter
  */
@@ -347,6 +605,24 @@ export interface ServiceLink {
      * @generated from protobuf field: bool is_encrypted = 11
      */
     isEncrypted: boolean;
+    /**
+     * Number of flows per verdict seen on this link
+     *
+     * @generated from protobuf field: repeated ui.VerdictCount verdict_counts = 12
+     */
+    verdictCounts: VerdictCount[];
+    /**
+     * Histogram of drop reasons of dropped flows, the most frequent first
+     *
+     * @generated from protobuf field: repeated ui.DropReasonCount drop_reasons = 13
+     */
+    dropReasons: DropReasonCount[];
+    /**
+     * k8s Service the flows of the link were sent to, if any
+     *
+     * @generated from protobuf field: ui.K8sServiceRef k8s_service = 14
+     */
+    k8sService?: K8sServiceRef;
 }
 /**
  * @generated from protobuf message ui.ServiceLink.Latency
@@ -366,209 +642,2757 @@ export interface ServiceLink_Latency {
     avg?: Duration;
 }
 /**
- * @generated from protobuf message ui.ServiceLinkState
+ * @generated from protobuf message ui.K8sServiceRef
  */
-export interface ServiceLinkState {
-    /**
-     * @generated from protobuf field: ui.ServiceLink service_link = 1
-     */
-    serviceLink?: ServiceLink;
+export interface K8sServiceRef {
     /**
-     * @generated from protobuf field: ui.StateChange type = 2
+     * @generated from protobuf field: string name = 1
      */
-    type: StateChange;
-}
-/**
- * @generated from protobuf message ui.ServiceLinkFilter
- */
-export interface ServiceLinkFilter {
+    name: string;
     /**
-     * @generated from protobuf field: repeated ui.ServiceFilter source = 1
+     * @generated from protobuf field: string namespace = 2
      */
-    source: ServiceFilter[];
+    namespace: string;
     /**
-     * @generated from protobuf field: repeated ui.ServiceFilter destination = 2
+     * ClusterIP, NodePort, LoadBalancer or ExternalName, empty if the
+     * Service object is not known
+     *
+     * @generated from protobuf field: string type = 3
      */
-    destination: ServiceFilter[];
+    type: string;
     /**
-     * Use string here to support things like port ranges (e.g. 100-200).
+     * Name of the Service port the flows were sent to
      *
-     * @generated from protobuf field: repeated string destination_port = 3
+     * @generated from protobuf field: string port_name = 4
      */
-    destinationPort: string[];
+    portName: string;
     /**
-     * @generated from protobuf field: repeated flow.Verdict verdict = 4
+     * Numbers of ready and all the backends from EndpointSlices
+     *
+     * @generated from protobuf field: uint32 ready_backends = 5
      */
-    verdict: Verdict[];
-}
-/**
- * @generated from protobuf message ui.GetControlStreamRequest
- */
-export interface GetControlStreamRequest {
-}
-/**
- * @generated from protobuf message ui.GetControlStreamResponse
- */
-export interface GetControlStreamResponse {
+    readyBackends: number;
     /**
-     * @generated from protobuf oneof: event
+     * @generated from protobuf field: uint32 backends = 6
      */
-    event: {
-        oneofKind: "namespaces";
-        /**
-         * @generated from protobuf field: ui.GetControlStreamResponse.NamespaceStates namespaces = 1
-         */
-        namespaces: GetControlStreamResponse_NamespaceStates;
-    } | {
-        oneofKind: "notification";
-        /**
-         * @generated from protobuf field: ui.Notification notification = 2
-         */
-        notification: Notification;
-    } | {
-        oneofKind: undefined;
-    };
+    backends: number;
 }
 /**
- * @generated from protobuf message ui.GetControlStreamResponse.NamespaceStates
+ * Flows from a service to the port of k8s Service, e.g. "frontend ->
+ * svc/api:http", regardless of the backends they were balanced to
+ *
+ * @generated from protobuf message ui.K8sServiceLink
  */
-export interface GetControlStreamResponse_NamespaceStates {
+export interface K8sServiceLink {
     /**
-     * @generated from protobuf field: repeated ui.NamespaceState namespaces = 1
+     * @generated from protobuf field: string id = 1
      */
-    namespaces: NamespaceState[];
-}
-/**
- * @generated from protobuf enum ui.EventType
- */
-export enum EventType {
+    id: string;
     /**
-     * @generated from protobuf enum value: UNKNOWN_EVENT = 0;
+     * source Service id
+     *
+     * @generated from protobuf field: string source_id = 2
      */
-    UNKNOWN_EVENT = 0,
+    sourceId: string;
     /**
-     * @generated from protobuf enum value: FLOW = 1;
+     * @generated from protobuf field: ui.K8sServiceRef k8s_service = 3
      */
-    FLOW = 1,
+    k8sService?: K8sServiceRef;
     /**
-     * @generated from protobuf enum value: K8S_NAMESPACE_STATE = 2;
+     * Ids of the services behind k8s Service the flows ended up at
+     *
+     * @generated from protobuf field: repeated string destination_ids = 4
      */
-    K8S_NAMESPACE_STATE = 2,
+    destinationIds: string[];
     /**
-     * @generated from protobuf enum value: SERVICE_STATE = 3;
+     * @generated from protobuf field: uint64 flow_amount = 5
      */
-    SERVICE_STATE = 3,
+    flowAmount: bigint;
     /**
-     * @generated from protobuf enum value: SERVICE_LINK_STATE = 4;
+     * @generated from protobuf field: repeated ui.VerdictCount verdict_counts = 6
      */
-    SERVICE_LINK_STATE = 4,
+    verdictCounts: VerdictCount[];
+}
+/**
+ * @generated from protobuf message ui.K8sServiceLinkState
+ */
+export interface K8sServiceLinkState {
     /**
-     * @generated from protobuf enum value: FLOWS = 5;
+     * @generated from protobuf field: ui.K8sServiceLink k8s_service_link = 1
      */
-    FLOWS = 5,
+    k8sServiceLink?: K8sServiceLink;
     /**
-     * @generated from protobuf enum value: STATUS = 6;
+     * @generated from protobuf field: ui.StateChange type = 2
      */
-    STATUS = 6
+    type: StateChange;
 }
 /**
- * IP protocols. The values of enums do not correspond to actual IP protocol numbers.
- *
- * @generated from protobuf enum ui.IPProtocol
+ * @generated from protobuf message ui.VerdictCount
  */
-export enum IPProtocol {
-    /**
-     * @generated from protobuf enum value: UNKNOWN_IP_PROTOCOL = 0;
-     */
-    UNKNOWN_IP_PROTOCOL = 0,
+export interface VerdictCount {
     /**
-     * @generated from protobuf enum value: TCP = 1;
+     * @generated from protobuf field: flow.Verdict verdict = 1
      */
-    TCP = 1,
+    verdict: Verdict;
     /**
-     * @generated from protobuf enum value: UDP = 2;
+     * @generated from protobuf field: uint64 count = 2
      */
-    UDP = 2,
+    count: bigint;
+}
+/**
+ * @generated from protobuf message ui.DropReasonCount
+ */
+export interface DropReasonCount {
     /**
-     * @generated from protobuf enum value: ICMP_V4 = 3;
+     * @generated from protobuf field: flow.DropReason reason = 1
      */
-    ICMP_V4 = 3,
+    reason: DropReason;
     /**
-     * @generated from protobuf enum value: ICMP_V6 = 4;
+     * @generated from protobuf field: uint64 count = 2
      */
-    ICMP_V6 = 4
+    count: bigint;
 }
 /**
- * @generated from protobuf enum ui.StateChange
+ * Most frequent drop reasons of flows from/to the namespace
+ *
+ * @generated from protobuf message ui.NamespaceDropReasons
  */
-export enum StateChange {
+export interface NamespaceDropReasons {
     /**
-     * @generated from protobuf enum value: UNKNOWN_STATE_CHANGE = 0;
+     * @generated from protobuf field: string namespace = 1
      */
-    UNKNOWN_STATE_CHANGE = 0,
+    namespace: string;
     /**
-     * @generated from protobuf enum value: ADDED = 1;
+     * @generated from protobuf field: repeated ui.DropReasonCount top_reasons = 2
      */
-    ADDED = 1,
+    topReasons: DropReasonCount[];
+}
+/**
+ * @generated from protobuf message ui.ServiceLinkState
+ */
+export interface ServiceLinkState {
     /**
-     * @generated from protobuf enum value: MODIFIED = 2;
+     * @generated from protobuf field: ui.ServiceLink service_link = 1
      */
-    MODIFIED = 2,
+    serviceLink?: ServiceLink;
     /**
-     * @generated from protobuf enum value: DELETED = 3;
+     * @generated from protobuf field: ui.StateChange type = 2
      */
-    DELETED = 3,
+    type: StateChange;
+}
+/**
+ * Namespace as a node of the cluster map, endpoints that don't belong to any
+ * namespace (world, host, remote nodes) are grouped into reserved nodes
+ *
+ * @generated from protobuf message ui.NamespaceNode
+ */
+export interface NamespaceNode {
     /**
-     * This is needed in case the relay server knows that the service exists,
-     * but it doesn't know when it got created.
+     * Namespace name or reserved label like "reserved:world"
      *
-     * @generated from protobuf enum value: EXISTS = 4;
+     * @generated from protobuf field: string id = 1
      */
-    EXISTS = 4
+    id: string;
+    /**
+     * @generated from protobuf field: string name = 2
+     */
+    name: string;
+    /**
+     * @generated from protobuf field: bool is_reserved = 3
+     */
+    isReserved: boolean;
+    /**
+     * Number of flows sent from or to the namespace
+     *
+     * @generated from protobuf field: uint64 flow_amount = 4
+     */
+    flowAmount: bigint;
+    /**
+     * Number of distinct services seen in the namespace
+     *
+     * @generated from protobuf field: uint32 services_number = 5
+     */
+    servicesNumber: number;
+}
+/**
+ * @generated from protobuf message ui.NamespaceNodeState
+ */
+export interface NamespaceNodeState {
+    /**
+     * @generated from protobuf field: ui.NamespaceNode namespace_node = 1
+     */
+    namespaceNode?: NamespaceNode;
+    /**
+     * @generated from protobuf field: ui.StateChange type = 2
+     */
+    type: StateChange;
+}
+/**
+ * All the flows sent from one namespace to another, flows within the same
+ * namespace make a link with equal source and destination
+ *
+ * @generated from protobuf message ui.NamespaceLink
+ */
+export interface NamespaceLink {
+    /**
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+    /**
+     * source NamespaceNode id
+     *
+     * @generated from protobuf field: string source_id = 2
+     */
+    sourceId: string;
+    /**
+     * destination NamespaceNode id
+     *
+     * @generated from protobuf field: string destination_id = 3
+     */
+    destinationId: string;
+    /**
+     * @generated from protobuf field: uint64 flow_amount = 4
+     */
+    flowAmount: bigint;
+    /**
+     * @generated from protobuf field: repeated ui.VerdictCount verdict_counts = 5
+     */
+    verdictCounts: VerdictCount[];
+    /**
+     * @generated from protobuf field: repeated ui.DropReasonCount drop_reasons = 6
+     */
+    dropReasons: DropReasonCount[];
+    /**
+     * @generated from protobuf field: uint64 bytes_transfered = 7
+     */
+    bytesTransfered: bigint;
+    /**
+     * @generated from protobuf field: uint64 encrypted_flow_amount = 8
+     */
+    encryptedFlowAmount: bigint;
+    /**
+     * Share of encrypted flows, from 0 to 1
+     *
+     * @generated from protobuf field: float encryption_coverage = 9
+     */
+    encryptionCoverage: number;
+    /**
+     * Number of distinct service links aggregated into this link
+     *
+     * @generated from protobuf field: uint32 service_links_number = 10
+     */
+    serviceLinksNumber: number;
+}
+/**
+ * @generated from protobuf message ui.NamespaceLinkState
+ */
+export interface NamespaceLinkState {
+    /**
+     * @generated from protobuf field: ui.NamespaceLink namespace_link = 1
+     */
+    namespaceLink?: NamespaceLink;
+    /**
+     * @generated from protobuf field: ui.StateChange type = 2
+     */
+    type: StateChange;
+}
+/**
+ * @generated from protobuf message ui.ServiceLinkFilter
+ */
+export interface ServiceLinkFilter {
+    /**
+     * @generated from protobuf field: repeated ui.ServiceFilter source = 1
+     */
+    source: ServiceFilter[];
+    /**
+     * @generated from protobuf field: repeated ui.ServiceFilter destination = 2
+     */
+    destination: ServiceFilter[];
+    /**
+     * Use string here to support things like port ranges (e.g. 100-200).
+     *
+     * @generated from protobuf field: repeated string destination_port = 3
+     */
+    destinationPort: string[];
+    /**
+     * @generated from protobuf field: repeated flow.Verdict verdict = 4
+     */
+    verdict: Verdict[];
+}
+/**
+ * @generated from protobuf message ui.ServiceDetailsRequest
+ */
+export interface ServiceDetailsRequest {
+    /**
+     * @generated from protobuf field: string service_id = 1
+     */
+    serviceId: string;
+    /**
+     * The number of the most recent flows to return, 20 if not set
+     *
+     * @generated from protobuf field: uint32 flows_limit = 2
+     */
+    flowsLimit: number;
+}
+/**
+ * @generated from protobuf message ui.ServiceDetailsResponse
+ */
+export interface ServiceDetailsResponse {
+    /**
+     * @generated from protobuf field: ui.Service service = 1
+     */
+    service?: Service;
+    /**
+     * Peers sending traffic to the service and the links they use
+     *
+     * @generated from protobuf field: repeated ui.ServicePeer inbound = 2
+     */
+    inbound: ServicePeer[];
+    /**
+     * Peers receiving traffic from the service and the links they use
+     *
+     * @generated from protobuf field: repeated ui.ServicePeer outbound = 3
+     */
+    outbound: ServicePeer[];
+    /**
+     * @generated from protobuf field: repeated ui.ServicePort ports = 4
+     */
+    ports: ServicePort[];
+    /**
+     * @generated from protobuf field: repeated flow.Workload workloads = 5
+     */
+    workloads: Workload[];
+    /**
+     * Pods in "<namespace>/<pod>" form
+     *
+     * @generated from protobuf field: repeated string pods = 6
+     */
+    pods: string[];
+    /**
+     * DNS and Kafka endpoints served by the service, HTTP ones are in
+     * http_endpoints
+     *
+     * @generated from protobuf field: repeated ui.L7Endpoint l7_endpoints = 7
+     */
+    l7Endpoints: L7Endpoint[];
+    /**
+     * The most recent flows from/to the service, the newest comes first
+     *
+     * @generated from protobuf field: repeated flow.Flow recent_flows = 8
+     */
+    recentFlows: Flow[];
+    /**
+     * The number of flows the details are computed from
+     *
+     * @generated from protobuf field: uint32 flows_number = 9
+     */
+    flowsNumber: number;
+    /**
+     * HTTP endpoints served by the service, they are computed the same way
+     * as L7 summaries of links
+     *
+     * @generated from protobuf field: repeated ui.HttpEndpointStats http_endpoints = 10
+     */
+    httpEndpoints: HttpEndpointStats[];
+}
+/**
+ * @generated from protobuf message ui.ServicePeer
+ */
+export interface ServicePeer {
+    /**
+     * @generated from protobuf field: ui.Service service = 1
+     */
+    service?: Service;
+    /**
+     * @generated from protobuf field: repeated ui.ServiceLink links = 2
+     */
+    links: ServiceLink[];
+}
+/**
+ * Port the service is observed listening on
+ *
+ * @generated from protobuf message ui.ServicePort
+ */
+export interface ServicePort {
+    /**
+     * @generated from protobuf field: uint32 port = 1
+     */
+    port: number;
+    /**
+     * @generated from protobuf field: ui.IPProtocol protocol = 2
+     */
+    protocol: IPProtocol;
+    /**
+     * @generated from protobuf field: uint64 flow_amount = 3
+     */
+    flowAmount: bigint;
+}
+/**
+ * L7 endpoint served by the service, e.g. HTTP method and path
+ *
+ * @generated from protobuf message ui.L7Endpoint
+ */
+export interface L7Endpoint {
+    /**
+     * Either "dns" or "kafka"
+     *
+     * @generated from protobuf field: string protocol = 1
+     */
+    protocol: string;
+    /**
+     * @generated from protobuf field: string method = 2
+     */
+    method: string;
+    /**
+     * Query for DNS and topic for Kafka
+     *
+     * @generated from protobuf field: string path = 3
+     */
+    path: string;
+    /**
+     * @generated from protobuf field: uint64 requests = 4
+     */
+    requests: bigint;
+    /**
+     * Responses with DNS rcode != 0 or Kafka error code != 0
+     *
+     * @generated from protobuf field: uint64 errors = 5
+     */
+    errors: bigint;
+}
+/**
+ * HTTP endpoint of the link, numeric ids and UUIDs of the path are
+ * collapsed, e.g. "/users/{id}"
+ *
+ * @generated from protobuf message ui.HttpEndpointStats
+ */
+export interface HttpEndpointStats {
+    /**
+     * @generated from protobuf field: string method = 1
+     */
+    method: string;
+    /**
+     * @generated from protobuf field: string path = 2
+     */
+    path: string;
+    /**
+     * @generated from protobuf field: uint64 requests = 3
+     */
+    requests: bigint;
+    /**
+     * @generated from protobuf field: repeated ui.HttpStatusClassCount status_classes = 4
+     */
+    statusClasses: HttpStatusClassCount[];
+    /**
+     * Latency percentiles of the recent responses
+     *
+     * @generated from protobuf field: google.protobuf.Duration latency_p50 = 5
+     */
+    latencyP50?: Duration;
+    /**
+     * @generated from protobuf field: google.protobuf.Duration latency_p90 = 6
+     */
+    latencyP90?: Duration;
+    /**
+     * @generated from protobuf field: google.protobuf.Duration latency_p99 = 7
+     */
+    latencyP99?: Duration;
+}
+/**
+ * @generated from protobuf message ui.HttpStatusClassCount
+ */
+export interface HttpStatusClassCount {
+    /**
+     * e.g. "2xx" or "5xx"
+     *
+     * @generated from protobuf field: string status_class = 1
+     */
+    statusClass: string;
+    /**
+     * @generated from protobuf field: uint64 count = 2
+     */
+    count: bigint;
+}
+/**
+ * @generated from protobuf message ui.LinkL7Summary
+ */
+export interface LinkL7Summary {
+    /**
+     * Id of the service link the requests were sent over
+     *
+     * @generated from protobuf field: string link_id = 1
+     */
+    linkId: string;
+    /**
+     * @generated from protobuf field: string source_id = 2
+     */
+    sourceId: string;
+    /**
+     * @generated from protobuf field: string destination_id = 3
+     */
+    destinationId: string;
+    /**
+     * @generated from protobuf field: repeated ui.HttpEndpointStats http_endpoints = 4
+     */
+    httpEndpoints: HttpEndpointStats[];
+}
+/**
+ * @generated from protobuf message ui.LinkL7SummaryState
+ */
+export interface LinkL7SummaryState {
+    /**
+     * @generated from protobuf field: ui.LinkL7Summary link_l7_summary = 1
+     */
+    linkL7Summary?: LinkL7Summary;
+    /**
+     * @generated from protobuf field: ui.StateChange type = 2
+     */
+    type: StateChange;
+}
+/**
+ * @generated from protobuf message ui.GetControlStreamRequest
+ */
+export interface GetControlStreamRequest {
+}
+/**
+ * @generated from protobuf message ui.GetControlStreamResponse
+ */
+export interface GetControlStreamResponse {
+    /**
+     * @generated from protobuf oneof: event
+     */
+    event: {
+        oneofKind: "namespaces";
+        /**
+         * @generated from protobuf field: ui.GetControlStreamResponse.NamespaceStates namespaces = 1
+         */
+        namespaces: GetControlStreamResponse_NamespaceStates;
+    } | {
+        oneofKind: "notification";
+        /**
+         * @generated from protobuf field: ui.Notification notification = 2
+         */
+        notification: Notification;
+    } | {
+        oneofKind: undefined;
+    };
+}
+/**
+ * @generated from protobuf message ui.GetControlStreamResponse.NamespaceStates
+ */
+export interface GetControlStreamResponse_NamespaceStates {
+    /**
+     * @generated from protobuf field: repeated ui.NamespaceState namespaces = 1
+     */
+    namespaces: NamespaceState[];
+}
+/**
+ * @generated from protobuf enum ui.EventType
+ */
+export enum EventType {
+    /**
+     * @generated from protobuf enum value: UNKNOWN_EVENT = 0;
+     */
+    UNKNOWN_EVENT = 0,
+    /**
+     * @generated from protobuf enum value: FLOW = 1;
+     */
+    FLOW = 1,
+    /**
+     * @generated from protobuf enum value: K8S_NAMESPACE_STATE = 2;
+     */
+    K8S_NAMESPACE_STATE = 2,
+    /**
+     * @generated from protobuf enum value: SERVICE_STATE = 3;
+     */
+    SERVICE_STATE = 3,
+    /**
+     * @generated from protobuf enum value: SERVICE_LINK_STATE = 4;
+     */
+    SERVICE_LINK_STATE = 4,
+    /**
+     * @generated from protobuf enum value: FLOWS = 5;
+     */
+    FLOWS = 5,
+    /**
+     * @generated from protobuf enum value: STATUS = 6;
+     */
+    STATUS = 6,
+    /**
+     * @generated from protobuf enum value: DROP_REASONS = 7;
+     */
+    DROP_REASONS = 7,
+    /**
+     * @generated from protobuf enum value: NAMESPACE_MAP = 8;
+     */
+    NAMESPACE_MAP = 8,
+    /**
+     * @generated from protobuf enum value: K8S_SERVICE_LINK_STATE = 9;
+     */
+    K8S_SERVICE_LINK_STATE = 9,
+    /**
+     * @generated from protobuf enum value: SERVICE_ENDPOINTS = 10;
+     */
+    SERVICE_ENDPOINTS = 10,
+    /**
+     * @generated from protobuf enum value: L7_SUMMARY = 11;
+     */
+    L7_SUMMARY = 11
+}
+/**
+ * IP protocols. The values of enums do not correspond to actual IP protocol numbers.
+ *
+ * @generated from protobuf enum ui.IPProtocol
+ */
+export enum IPProtocol {
+    /**
+     * @generated from protobuf enum value: UNKNOWN_IP_PROTOCOL = 0;
+     */
+    UNKNOWN_IP_PROTOCOL = 0,
+    /**
+     * @generated from protobuf enum value: TCP = 1;
+     */
+    TCP = 1,
+    /**
+     * @generated from protobuf enum value: UDP = 2;
+     */
+    UDP = 2,
+    /**
+     * @generated from protobuf enum value: ICMP_V4 = 3;
+     */
+    ICMP_V4 = 3,
+    /**
+     * @generated from protobuf enum value: ICMP_V6 = 4;
+     */
+    ICMP_V6 = 4
+}
+/**
+ * States of cilium endpoints collapsed to the ones that matter on the map
+ *
+ * @generated from protobuf enum ui.EndpointState
+ */
+export enum EndpointState {
+    /**
+     * @generated from protobuf enum value: UNKNOWN_ENDPOINT_STATE = 0;
+     */
+    UNKNOWN_ENDPOINT_STATE = 0,
+    /**
+     * @generated from protobuf enum value: ENDPOINT_READY = 1;
+     */
+    ENDPOINT_READY = 1,
+    /**
+     * Includes waiting for regeneration and restoring
+     *
+     * @generated from protobuf enum value: ENDPOINT_REGENERATING = 2;
+     */
+    ENDPOINT_REGENERATING = 2,
+    /**
+     * @generated from protobuf enum value: ENDPOINT_NOT_READY = 3;
+     */
+    ENDPOINT_NOT_READY = 3
+}
+/**
+ * @generated from protobuf enum ui.StateChange
+ */
+export enum StateChange {
+    /**
+     * @generated from protobuf enum value: UNKNOWN_STATE_CHANGE = 0;
+     */
+    UNKNOWN_STATE_CHANGE = 0,
+    /**
+     * @generated from protobuf enum value: ADDED = 1;
+     */
+    ADDED = 1,
+    /**
+     * @generated from protobuf enum value: MODIFIED = 2;
+     */
+    MODIFIED = 2,
+    /**
+     * @generated from protobuf enum value: DELETED = 3;
+     */
+    DELETED = 3,
+    /**
+     * This is needed in case the relay server knows that the service exists,
+     * but it doesn't know when it got created.
+     *
+     * @generated from protobuf enum value: EXISTS = 4;
+     */
+    EXISTS = 4
+}
+// @generated message type with reflection information, may provide speed optimized methods
+class GetEventsRequest$Type extends MessageType<GetEventsRequest> {
+    constructor() {
+        super("ui.GetEventsRequest", [
+            { no: 1, name: "event_types", kind: "enum", repeat: 1 /*RepeatType.PACKED*/, T: () => ["ui.EventType", EventType] },
+            { no: 2, name: "blacklist", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => EventFilter },
+            { no: 3, name: "whitelist", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => EventFilter },
+            { no: 4, name: "since", kind: "message", T: () => Timestamp },
+            { no: 5, name: "status_request", kind: "message", T: () => GetStatusRequest },
+            { no: 6, name: "filter_expression", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "scope", kind: "message", T: () => ServiceMapScope },
+            { no: 8, name: "clusters", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetEventsRequest>): GetEventsRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.eventTypes = [];
+        message.blacklist = [];
+        message.whitelist = [];
+        message.filterExpression = "";
+        message.clusters = [];
+        if (value !== undefined)
+            reflectionMergePartial<GetEventsRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetEventsRequest): GetEventsRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated ui.EventType event_types */ 1:
+                    if (wireType === WireType.LengthDelimited)
+                        for (let e = reader.int32() + reader.pos; reader.pos < e;)
+                            message.eventTypes.push(reader.int32());
+                    else
+                        message.eventTypes.push(reader.int32());
+                    break;
+                case /* repeated ui.EventFilter blacklist */ 2:
+                    message.blacklist.push(EventFilter.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated ui.EventFilter whitelist */ 3:
+                    message.whitelist.push(EventFilter.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* google.protobuf.Timestamp since */ 4:
+                    message.since = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.since);
+                    break;
+                case /* ui.GetStatusRequest status_request */ 5:
+                    message.statusRequest = GetStatusRequest.internalBinaryRead(reader, reader.uint32(), options, message.statusRequest);
+                    break;
+                case /* string filter_expression */ 6:
+                    message.filterExpression = reader.string();
+                    break;
+                case /* ui.ServiceMapScope scope */ 7:
+                    message.scope = ServiceMapScope.internalBinaryRead(reader, reader.uint32(), options, message.scope);
+                    break;
+                case /* repeated string clusters */ 8:
+                    message.clusters.push(reader.string());
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetEventsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated ui.EventType event_types = 1; */
+        if (message.eventTypes.length) {
+            writer.tag(1, WireType.LengthDelimited).fork();
+            for (let i = 0; i < message.eventTypes.length; i++)
+                writer.int32(message.eventTypes[i]);
+            writer.join();
+        }
+        /* repeated ui.EventFilter blacklist = 2; */
+        for (let i = 0; i < message.blacklist.length; i++)
+            EventFilter.internalBinaryWrite(message.blacklist[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.EventFilter whitelist = 3; */
+        for (let i = 0; i < message.whitelist.length; i++)
+            EventFilter.internalBinaryWrite(message.whitelist[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp since = 4; */
+        if (message.since)
+            Timestamp.internalBinaryWrite(message.since, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* ui.GetStatusRequest status_request = 5; */
+        if (message.statusRequest)
+            GetStatusRequest.internalBinaryWrite(message.statusRequest, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* string filter_expression = 6; */
+        if (message.filterExpression !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.filterExpression);
+        /* ui.ServiceMapScope scope = 7; */
+        if (message.scope)
+            ServiceMapScope.internalBinaryWrite(message.scope, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string clusters = 8; */
+        for (let i = 0; i < message.clusters.length; i++)
+            writer.tag(8, WireType.LengthDelimited).string(message.clusters[i]);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.GetEventsRequest
+ */
+export const GetEventsRequest = new GetEventsRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ServiceMapScope$Type extends MessageType<ServiceMapScope> {
+    constructor() {
+        super("ui.ServiceMapScope", [
+            { no: 1, name: "namespaces", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "namespace_selector", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "pod_selector", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ServiceMapScope>): ServiceMapScope {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.namespaces = [];
+        message.namespaceSelector = "";
+        message.podSelector = "";
+        if (value !== undefined)
+            reflectionMergePartial<ServiceMapScope>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceMapScope): ServiceMapScope {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated string namespaces */ 1:
+                    message.namespaces.push(reader.string());
+                    break;
+                case /* string namespace_selector */ 2:
+                    message.namespaceSelector = reader.string();
+                    break;
+                case /* string pod_selector */ 3:
+                    message.podSelector = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServiceMapScope, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated string namespaces = 1; */
+        for (let i = 0; i < message.namespaces.length; i++)
+            writer.tag(1, WireType.LengthDelimited).string(message.namespaces[i]);
+        /* string namespace_selector = 2; */
+        if (message.namespaceSelector !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.namespaceSelector);
+        /* string pod_selector = 3; */
+        if (message.podSelector !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.podSelector);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ServiceMapScope
+ */
+export const ServiceMapScope = new ServiceMapScope$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetEventsResponse$Type extends MessageType<GetEventsResponse> {
+    constructor() {
+        super("ui.GetEventsResponse", [
+            { no: 1, name: "node", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "timestamp", kind: "message", T: () => Timestamp },
+            { no: 3, name: "events", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Event }
+        ]);
+    }
+    create(value?: PartialMessage<GetEventsResponse>): GetEventsResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.node = "";
+        message.events = [];
+        if (value !== undefined)
+            reflectionMergePartial<GetEventsResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetEventsResponse): GetEventsResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string node */ 1:
+                    message.node = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp timestamp */ 2:
+                    message.timestamp = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.timestamp);
+                    break;
+                case /* repeated ui.Event events */ 3:
+                    message.events.push(Event.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetEventsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string node = 1; */
+        if (message.node !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.node);
+        /* google.protobuf.Timestamp timestamp = 2; */
+        if (message.timestamp)
+            Timestamp.internalBinaryWrite(message.timestamp, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.Event events = 3; */
+        for (let i = 0; i < message.events.length; i++)
+            Event.internalBinaryWrite(message.events[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.GetEventsResponse
+ */
+export const GetEventsResponse = new GetEventsResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class Event$Type extends MessageType<Event> {
+    constructor() {
+        super("ui.Event", [
+            { no: 3, name: "flow", kind: "message", oneof: "event", T: () => Flow },
+            { no: 4, name: "namespace_state", kind: "message", oneof: "event", T: () => NamespaceState },
+            { no: 5, name: "service_state", kind: "message", oneof: "event", T: () => ServiceState },
+            { no: 6, name: "service_link_state", kind: "message", oneof: "event", T: () => ServiceLinkState },
+            { no: 7, name: "flows", kind: "message", oneof: "event", T: () => Flows },
+            { no: 8, name: "notification", kind: "message", oneof: "event", T: () => Notification },
+            { no: 9, name: "namespace_drop_reasons", kind: "message", oneof: "event", T: () => NamespaceDropReasons },
+            { no: 10, name: "namespace_node_state", kind: "message", oneof: "event", T: () => NamespaceNodeState },
+            { no: 11, name: "namespace_link_state", kind: "message", oneof: "event", T: () => NamespaceLinkState },
+            { no: 12, name: "k8s_service_link_state", kind: "message", oneof: "event", T: () => K8sServiceLinkState },
+            { no: 13, name: "service_endpoints_state", kind: "message", oneof: "event", T: () => ServiceEndpointsState },
+            { no: 14, name: "link_l7_summary_state", kind: "message", oneof: "event", T: () => LinkL7SummaryState }
+        ]);
+    }
+    create(value?: PartialMessage<Event>): Event {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.event = { oneofKind: undefined };
+        if (value !== undefined)
+            reflectionMergePartial<Event>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: Event): Event {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* flow.Flow flow */ 3:
+                    message.event = {
+                        oneofKind: "flow",
+                        flow: Flow.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).flow)
+                    };
+                    break;
+                case /* ui.NamespaceState namespace_state */ 4:
+                    message.event = {
+                        oneofKind: "namespaceState",
+                        namespaceState: NamespaceState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).namespaceState)
+                    };
+                    break;
+                case /* ui.ServiceState service_state */ 5:
+                    message.event = {
+                        oneofKind: "serviceState",
+                        serviceState: ServiceState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).serviceState)
+                    };
+                    break;
+                case /* ui.ServiceLinkState service_link_state */ 6:
+                    message.event = {
+                        oneofKind: "serviceLinkState",
+                        serviceLinkState: ServiceLinkState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).serviceLinkState)
+                    };
+                    break;
+                case /* ui.Flows flows */ 7:
+                    message.event = {
+                        oneofKind: "flows",
+                        flows: Flows.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).flows)
+                    };
+                    break;
+                case /* ui.Notification notification */ 8:
+                    message.event = {
+                        oneofKind: "notification",
+                        notification: Notification.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).notification)
+                    };
+                    break;
+                case /* ui.NamespaceDropReasons namespace_drop_reasons */ 9:
+                    message.event = {
+                        oneofKind: "namespaceDropReasons",
+                        namespaceDropReasons: NamespaceDropReasons.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).namespaceDropReasons)
+                    };
+                    break;
+                case /* ui.NamespaceNodeState namespace_node_state */ 10:
+                    message.event = {
+                        oneofKind: "namespaceNodeState",
+                        namespaceNodeState: NamespaceNodeState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).namespaceNodeState)
+                    };
+                    break;
+                case /* ui.NamespaceLinkState namespace_link_state */ 11:
+                    message.event = {
+                        oneofKind: "namespaceLinkState",
+                        namespaceLinkState: NamespaceLinkState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).namespaceLinkState)
+                    };
+                    break;
+                case /* ui.K8sServiceLinkState k8s_service_link_state */ 12:
+                    message.event = {
+                        oneofKind: "k8sServiceLinkState",
+                        k8sServiceLinkState: K8sServiceLinkState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).k8sServiceLinkState)
+                    };
+                    break;
+                case /* ui.ServiceEndpointsState service_endpoints_state */ 13:
+                    message.event = {
+                        oneofKind: "serviceEndpointsState",
+                        serviceEndpointsState: ServiceEndpointsState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).serviceEndpointsState)
+                    };
+                    break;
+                case /* ui.LinkL7SummaryState link_l7_summary_state */ 14:
+                    message.event = {
+                        oneofKind: "linkL7SummaryState",
+                        linkL7SummaryState: LinkL7SummaryState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).linkL7SummaryState)
+                    };
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: Event, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* flow.Flow flow = 3; */
+        if (message.event.oneofKind === "flow")
+            Flow.internalBinaryWrite(message.event.flow, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* ui.NamespaceState namespace_state = 4; */
+        if (message.event.oneofKind === "namespaceState")
+            NamespaceState.internalBinaryWrite(message.event.namespaceState, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* ui.ServiceState service_state = 5; */
+        if (message.event.oneofKind === "serviceState")
+            ServiceState.internalBinaryWrite(message.event.serviceState, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* ui.ServiceLinkState service_link_state = 6; */
+        if (message.event.oneofKind === "serviceLinkState")
+            ServiceLinkState.internalBinaryWrite(message.event.serviceLinkState, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* ui.Flows flows = 7; */
+        if (message.event.oneofKind === "flows")
+            Flows.internalBinaryWrite(message.event.flows, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* ui.Notification notification = 8; */
+        if (message.event.oneofKind === "notification")
+            Notification.internalBinaryWrite(message.event.notification, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* ui.NamespaceDropReasons namespace_drop_reasons = 9; */
+        if (message.event.oneofKind === "namespaceDropReasons")
+            NamespaceDropReasons.internalBinaryWrite(message.event.namespaceDropReasons, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
+        /* ui.NamespaceNodeState namespace_node_state = 10; */
+        if (message.event.oneofKind === "namespaceNodeState")
+            NamespaceNodeState.internalBinaryWrite(message.event.namespaceNodeState, writer.tag(10, WireType.LengthDelimited).fork(), options).join();
+        /* ui.NamespaceLinkState namespace_link_state = 11; */
+        if (message.event.oneofKind === "namespaceLinkState")
+            NamespaceLinkState.internalBinaryWrite(message.event.namespaceLinkState, writer.tag(11, WireType.LengthDelimited).fork(), options).join();
+        /* ui.K8sServiceLinkState k8s_service_link_state = 12; */
+        if (message.event.oneofKind === "k8sServiceLinkState")
+            K8sServiceLinkState.internalBinaryWrite(message.event.k8sServiceLinkState, writer.tag(12, WireType.LengthDelimited).fork(), options).join();
+        /* ui.ServiceEndpointsState service_endpoints_state = 13; */
+        if (message.event.oneofKind === "serviceEndpointsState")
+            ServiceEndpointsState.internalBinaryWrite(message.event.serviceEndpointsState, writer.tag(13, WireType.LengthDelimited).fork(), options).join();
+        /* ui.LinkL7SummaryState link_l7_summary_state = 14; */
+        if (message.event.oneofKind === "linkL7SummaryState")
+            LinkL7SummaryState.internalBinaryWrite(message.event.linkL7SummaryState, writer.tag(14, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.Event
+ */
+export const Event = new Event$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class Flows$Type extends MessageType<Flows> {
+    constructor() {
+        super("ui.Flows", [
+            { no: 1, name: "flows", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Flow }
+        ]);
+    }
+    create(value?: PartialMessage<Flows>): Flows {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.flows = [];
+        if (value !== undefined)
+            reflectionMergePartial<Flows>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: Flows): Flows {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated flow.Flow flows */ 1:
+                    message.flows.push(Flow.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: Flows, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated flow.Flow flows = 1; */
+        for (let i = 0; i < message.flows.length; i++)
+            Flow.internalBinaryWrite(message.flows[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.Flows
+ */
+export const Flows = new Flows$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class EventFilter$Type extends MessageType<EventFilter> {
+    constructor() {
+        super("ui.EventFilter", [
+            { no: 2, name: "flow_filter", kind: "message", oneof: "filter", T: () => FlowFilter },
+            { no: 3, name: "service_filter", kind: "message", oneof: "filter", T: () => ServiceFilter },
+            { no: 4, name: "service_link_filter", kind: "message", oneof: "filter", T: () => ServiceLinkFilter }
+        ]);
+    }
+    create(value?: PartialMessage<EventFilter>): EventFilter {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.filter = { oneofKind: undefined };
+        if (value !== undefined)
+            reflectionMergePartial<EventFilter>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: EventFilter): EventFilter {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* flow.FlowFilter flow_filter */ 2:
+                    message.filter = {
+                        oneofKind: "flowFilter",
+                        flowFilter: FlowFilter.internalBinaryRead(reader, reader.uint32(), options, (message.filter as any).flowFilter)
+                    };
+                    break;
+                case /* ui.ServiceFilter service_filter */ 3:
+                    message.filter = {
+                        oneofKind: "serviceFilter",
+                        serviceFilter: ServiceFilter.internalBinaryRead(reader, reader.uint32(), options, (message.filter as any).serviceFilter)
+                    };
+                    break;
+                case /* ui.ServiceLinkFilter service_link_filter */ 4:
+                    message.filter = {
+                        oneofKind: "serviceLinkFilter",
+                        serviceLinkFilter: ServiceLinkFilter.internalBinaryRead(reader, reader.uint32(), options, (message.filter as any).serviceLinkFilter)
+                    };
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: EventFilter, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* flow.FlowFilter flow_filter = 2; */
+        if (message.filter.oneofKind === "flowFilter")
+            FlowFilter.internalBinaryWrite(message.filter.flowFilter, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* ui.ServiceFilter service_filter = 3; */
+        if (message.filter.oneofKind === "serviceFilter")
+            ServiceFilter.internalBinaryWrite(message.filter.serviceFilter, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* ui.ServiceLinkFilter service_link_filter = 4; */
+        if (message.filter.oneofKind === "serviceLinkFilter")
+            ServiceLinkFilter.internalBinaryWrite(message.filter.serviceLinkFilter, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.EventFilter
+ */
+export const EventFilter = new EventFilter$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class FlowByUUIDRequest$Type extends MessageType<FlowByUUIDRequest> {
+    constructor() {
+        super("ui.FlowByUUIDRequest", [
+            { no: 1, name: "uuid", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<FlowByUUIDRequest>): FlowByUUIDRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.uuid = "";
+        if (value !== undefined)
+            reflectionMergePartial<FlowByUUIDRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: FlowByUUIDRequest): FlowByUUIDRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string uuid */ 1:
+                    message.uuid = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: FlowByUUIDRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string uuid = 1; */
+        if (message.uuid !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.uuid);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.FlowByUUIDRequest
+ */
+export const FlowByUUIDRequest = new FlowByUUIDRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class FlowByUUIDResponse$Type extends MessageType<FlowByUUIDResponse> {
+    constructor() {
+        super("ui.FlowByUUIDResponse", [
+            { no: 1, name: "flow", kind: "message", T: () => Flow },
+            { no: 2, name: "is_from_history", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        ]);
+    }
+    create(value?: PartialMessage<FlowByUUIDResponse>): FlowByUUIDResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.isFromHistory = false;
+        if (value !== undefined)
+            reflectionMergePartial<FlowByUUIDResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: FlowByUUIDResponse): FlowByUUIDResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* flow.Flow flow */ 1:
+                    message.flow = Flow.internalBinaryRead(reader, reader.uint32(), options, message.flow);
+                    break;
+                case /* bool is_from_history */ 2:
+                    message.isFromHistory = reader.bool();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: FlowByUUIDResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* flow.Flow flow = 1; */
+        if (message.flow)
+            Flow.internalBinaryWrite(message.flow, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* bool is_from_history = 2; */
+        if (message.isFromHistory !== false)
+            writer.tag(2, WireType.Varint).bool(message.isFromHistory);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.FlowByUUIDResponse
+ */
+export const FlowByUUIDResponse = new FlowByUUIDResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class FilterExpressionRequest$Type extends MessageType<FilterExpressionRequest> {
+    constructor() {
+        super("ui.FilterExpressionRequest", [
+            { no: 1, name: "expression", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<FilterExpressionRequest>): FilterExpressionRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.expression = "";
+        if (value !== undefined)
+            reflectionMergePartial<FilterExpressionRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: FilterExpressionRequest): FilterExpressionRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string expression */ 1:
+                    message.expression = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: FilterExpressionRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string expression = 1; */
+        if (message.expression !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.expression);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.FilterExpressionRequest
+ */
+export const FilterExpressionRequest = new FilterExpressionRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class FilterExpressionResponse$Type extends MessageType<FilterExpressionResponse> {
+    constructor() {
+        super("ui.FilterExpressionResponse", [
+            { no: 1, name: "whitelist", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => FlowFilter },
+            { no: 2, name: "blacklist", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => FlowFilter },
+            { no: 3, name: "error", kind: "message", T: () => FilterExpressionError }
+        ]);
+    }
+    create(value?: PartialMessage<FilterExpressionResponse>): FilterExpressionResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.whitelist = [];
+        message.blacklist = [];
+        if (value !== undefined)
+            reflectionMergePartial<FilterExpressionResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: FilterExpressionResponse): FilterExpressionResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated flow.FlowFilter whitelist */ 1:
+                    message.whitelist.push(FlowFilter.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated flow.FlowFilter blacklist */ 2:
+                    message.blacklist.push(FlowFilter.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* ui.FilterExpressionError error */ 3:
+                    message.error = FilterExpressionError.internalBinaryRead(reader, reader.uint32(), options, message.error);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: FilterExpressionResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated flow.FlowFilter whitelist = 1; */
+        for (let i = 0; i < message.whitelist.length; i++)
+            FlowFilter.internalBinaryWrite(message.whitelist[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated flow.FlowFilter blacklist = 2; */
+        for (let i = 0; i < message.blacklist.length; i++)
+            FlowFilter.internalBinaryWrite(message.blacklist[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* ui.FilterExpressionError error = 3; */
+        if (message.error)
+            FilterExpressionError.internalBinaryWrite(message.error, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.FilterExpressionResponse
+ */
+export const FilterExpressionResponse = new FilterExpressionResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class FilterExpressionError$Type extends MessageType<FilterExpressionError> {
+    constructor() {
+        super("ui.FilterExpressionError", [
+            { no: 1, name: "message", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "position", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+    create(value?: PartialMessage<FilterExpressionError>): FilterExpressionError {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.message = "";
+        message.position = 0;
+        if (value !== undefined)
+            reflectionMergePartial<FilterExpressionError>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: FilterExpressionError): FilterExpressionError {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string message */ 1:
+                    message.message = reader.string();
+                    break;
+                case /* uint32 position */ 2:
+                    message.position = reader.uint32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: FilterExpressionError, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string message = 1; */
+        if (message.message !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.message);
+        /* uint32 position = 2; */
+        if (message.position !== 0)
+            writer.tag(2, WireType.Varint).uint32(message.position);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.FilterExpressionError
+ */
+export const FilterExpressionError = new FilterExpressionError$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class NamespaceDescriptor$Type extends MessageType<NamespaceDescriptor> {
+    constructor() {
+        super("ui.NamespaceDescriptor", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "creation_timestamp", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<NamespaceDescriptor>): NamespaceDescriptor {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.name = "";
+        if (value !== undefined)
+            reflectionMergePartial<NamespaceDescriptor>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceDescriptor): NamespaceDescriptor {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string name */ 2:
+                    message.name = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp creation_timestamp */ 3:
+                    message.creationTimestamp = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.creationTimestamp);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: NamespaceDescriptor, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string name = 2; */
+        if (message.name !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.name);
+        /* google.protobuf.Timestamp creation_timestamp = 3; */
+        if (message.creationTimestamp)
+            Timestamp.internalBinaryWrite(message.creationTimestamp, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.NamespaceDescriptor
+ */
+export const NamespaceDescriptor = new NamespaceDescriptor$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class NamespaceState$Type extends MessageType<NamespaceState> {
+    constructor() {
+        super("ui.NamespaceState", [
+            { no: 1, name: "namespace", kind: "message", T: () => NamespaceDescriptor },
+            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
+        ]);
+    }
+    create(value?: PartialMessage<NamespaceState>): NamespaceState {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.type = 0;
+        if (value !== undefined)
+            reflectionMergePartial<NamespaceState>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceState): NamespaceState {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.NamespaceDescriptor namespace */ 1:
+                    message.namespace = NamespaceDescriptor.internalBinaryRead(reader, reader.uint32(), options, message.namespace);
+                    break;
+                case /* ui.StateChange type */ 2:
+                    message.type = reader.int32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: NamespaceState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.NamespaceDescriptor namespace = 1; */
+        if (message.namespace)
+            NamespaceDescriptor.internalBinaryWrite(message.namespace, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* ui.StateChange type = 2; */
+        if (message.type !== 0)
+            writer.tag(2, WireType.Varint).int32(message.type);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.NamespaceState
+ */
+export const NamespaceState = new NamespaceState$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class Service$Type extends MessageType<Service> {
+    constructor() {
+        super("ui.Service", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "labels", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "dns_names", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "egress_policy_enforced", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 7, name: "ingress_policy_enforced", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 8, name: "visibility_policy_status", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 9, name: "creation_timestamp", kind: "message", T: () => Timestamp },
+            { no: 10, name: "workloads", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Workload },
+            { no: 12, name: "identity", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 13, name: "id_aliases", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 14, name: "is_boundary", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 15, name: "is_without_endpoints", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 16, name: "cluster", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<Service>): Service {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.name = "";
+        message.namespace = "";
+        message.labels = [];
+        message.dnsNames = [];
+        message.egressPolicyEnforced = false;
+        message.ingressPolicyEnforced = false;
+        message.visibilityPolicyStatus = "";
+        message.workloads = [];
+        message.identity = 0;
+        message.idAliases = [];
+        message.isBoundary = false;
+        message.isWithoutEndpoints = false;
+        message.cluster = "";
+        if (value !== undefined)
+            reflectionMergePartial<Service>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: Service): Service {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string name */ 2:
+                    message.name = reader.string();
+                    break;
+                case /* string namespace */ 3:
+                    message.namespace = reader.string();
+                    break;
+                case /* repeated string labels */ 4:
+                    message.labels.push(reader.string());
+                    break;
+                case /* repeated string dns_names */ 5:
+                    message.dnsNames.push(reader.string());
+                    break;
+                case /* bool egress_policy_enforced */ 6:
+                    message.egressPolicyEnforced = reader.bool();
+                    break;
+                case /* bool ingress_policy_enforced */ 7:
+                    message.ingressPolicyEnforced = reader.bool();
+                    break;
+                case /* string visibility_policy_status */ 8:
+                    message.visibilityPolicyStatus = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp creation_timestamp */ 9:
+                    message.creationTimestamp = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.creationTimestamp);
+                    break;
+                case /* repeated flow.Workload workloads */ 10:
+                    message.workloads.push(Workload.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* uint32 identity */ 12:
+                    message.identity = reader.uint32();
+                    break;
+                case /* repeated string id_aliases */ 13:
+                    message.idAliases.push(reader.string());
+                    break;
+                case /* bool is_boundary */ 14:
+                    message.isBoundary = reader.bool();
+                    break;
+                case /* bool is_without_endpoints */ 15:
+                    message.isWithoutEndpoints = reader.bool();
+                    break;
+                case /* string cluster */ 16:
+                    message.cluster = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: Service, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string name = 2; */
+        if (message.name !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.name);
+        /* string namespace = 3; */
+        if (message.namespace !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.namespace);
+        /* repeated string labels = 4; */
+        for (let i = 0; i < message.labels.length; i++)
+            writer.tag(4, WireType.LengthDelimited).string(message.labels[i]);
+        /* repeated string dns_names = 5; */
+        for (let i = 0; i < message.dnsNames.length; i++)
+            writer.tag(5, WireType.LengthDelimited).string(message.dnsNames[i]);
+        /* bool egress_policy_enforced = 6; */
+        if (message.egressPolicyEnforced !== false)
+            writer.tag(6, WireType.Varint).bool(message.egressPolicyEnforced);
+        /* bool ingress_policy_enforced = 7; */
+        if (message.ingressPolicyEnforced !== false)
+            writer.tag(7, WireType.Varint).bool(message.ingressPolicyEnforced);
+        /* string visibility_policy_status = 8; */
+        if (message.visibilityPolicyStatus !== "")
+            writer.tag(8, WireType.LengthDelimited).string(message.visibilityPolicyStatus);
+        /* google.protobuf.Timestamp creation_timestamp = 9; */
+        if (message.creationTimestamp)
+            Timestamp.internalBinaryWrite(message.creationTimestamp, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
+        /* repeated flow.Workload workloads = 10; */
+        for (let i = 0; i < message.workloads.length; i++)
+            Workload.internalBinaryWrite(message.workloads[i], writer.tag(10, WireType.LengthDelimited).fork(), options).join();
+        /* uint32 identity = 12; */
+        if (message.identity !== 0)
+            writer.tag(12, WireType.Varint).uint32(message.identity);
+        /* repeated string id_aliases = 13; */
+        for (let i = 0; i < message.idAliases.length; i++)
+            writer.tag(13, WireType.LengthDelimited).string(message.idAliases[i]);
+        /* bool is_boundary = 14; */
+        if (message.isBoundary !== false)
+            writer.tag(14, WireType.Varint).bool(message.isBoundary);
+        /* bool is_without_endpoints = 15; */
+        if (message.isWithoutEndpoints !== false)
+            writer.tag(15, WireType.Varint).bool(message.isWithoutEndpoints);
+        /* string cluster = 16; */
+        if (message.cluster !== "")
+            writer.tag(16, WireType.LengthDelimited).string(message.cluster);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.Service
+ */
+export const Service = new Service$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ServiceState$Type extends MessageType<ServiceState> {
+    constructor() {
+        super("ui.ServiceState", [
+            { no: 1, name: "service", kind: "message", T: () => Service },
+            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
+        ]);
+    }
+    create(value?: PartialMessage<ServiceState>): ServiceState {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.type = 0;
+        if (value !== undefined)
+            reflectionMergePartial<ServiceState>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceState): ServiceState {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.Service service */ 1:
+                    message.service = Service.internalBinaryRead(reader, reader.uint32(), options, message.service);
+                    break;
+                case /* ui.StateChange type */ 2:
+                    message.type = reader.int32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServiceState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.Service service = 1; */
+        if (message.service)
+            Service.internalBinaryWrite(message.service, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* ui.StateChange type = 2; */
+        if (message.type !== 0)
+            writer.tag(2, WireType.Varint).int32(message.type);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ServiceState
+ */
+export const ServiceState = new ServiceState$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CiliumEndpointStatus$Type extends MessageType<CiliumEndpointStatus> {
+    constructor() {
+        super("ui.CiliumEndpointStatus", [
+            { no: 1, name: "pod_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "state", kind: "enum", T: () => ["ui.EndpointState", EndpointState] },
+            { no: 4, name: "cilium_state", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "ingress_policy_enforced", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "egress_policy_enforced", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 7, name: "identity", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+    create(value?: PartialMessage<CiliumEndpointStatus>): CiliumEndpointStatus {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.podName = "";
+        message.namespace = "";
+        message.state = 0;
+        message.ciliumState = "";
+        message.ingressPolicyEnforced = false;
+        message.egressPolicyEnforced = false;
+        message.identity = 0;
+        if (value !== undefined)
+            reflectionMergePartial<CiliumEndpointStatus>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CiliumEndpointStatus): CiliumEndpointStatus {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string pod_name */ 1:
+                    message.podName = reader.string();
+                    break;
+                case /* string namespace */ 2:
+                    message.namespace = reader.string();
+                    break;
+                case /* ui.EndpointState state */ 3:
+                    message.state = reader.int32();
+                    break;
+                case /* string cilium_state */ 4:
+                    message.ciliumState = reader.string();
+                    break;
+                case /* bool ingress_policy_enforced */ 5:
+                    message.ingressPolicyEnforced = reader.bool();
+                    break;
+                case /* bool egress_policy_enforced */ 6:
+                    message.egressPolicyEnforced = reader.bool();
+                    break;
+                case /* uint32 identity */ 7:
+                    message.identity = reader.uint32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CiliumEndpointStatus, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string pod_name = 1; */
+        if (message.podName !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.podName);
+        /* string namespace = 2; */
+        if (message.namespace !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.namespace);
+        /* ui.EndpointState state = 3; */
+        if (message.state !== 0)
+            writer.tag(3, WireType.Varint).int32(message.state);
+        /* string cilium_state = 4; */
+        if (message.ciliumState !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.ciliumState);
+        /* bool ingress_policy_enforced = 5; */
+        if (message.ingressPolicyEnforced !== false)
+            writer.tag(5, WireType.Varint).bool(message.ingressPolicyEnforced);
+        /* bool egress_policy_enforced = 6; */
+        if (message.egressPolicyEnforced !== false)
+            writer.tag(6, WireType.Varint).bool(message.egressPolicyEnforced);
+        /* uint32 identity = 7; */
+        if (message.identity !== 0)
+            writer.tag(7, WireType.Varint).uint32(message.identity);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.CiliumEndpointStatus
+ */
+export const CiliumEndpointStatus = new CiliumEndpointStatus$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ServiceEndpoints$Type extends MessageType<ServiceEndpoints> {
+    constructor() {
+        super("ui.ServiceEndpoints", [
+            { no: 1, name: "service_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "endpoints", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => CiliumEndpointStatus },
+            { no: 3, name: "ready", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 4, name: "regenerating", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 5, name: "not_ready", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ServiceEndpoints>): ServiceEndpoints {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.serviceId = "";
+        message.endpoints = [];
+        message.ready = 0;
+        message.regenerating = 0;
+        message.notReady = 0;
+        if (value !== undefined)
+            reflectionMergePartial<ServiceEndpoints>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceEndpoints): ServiceEndpoints {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string service_id */ 1:
+                    message.serviceId = reader.string();
+                    break;
+                case /* repeated ui.CiliumEndpointStatus endpoints */ 2:
+                    message.endpoints.push(CiliumEndpointStatus.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* uint32 ready */ 3:
+                    message.ready = reader.uint32();
+                    break;
+                case /* uint32 regenerating */ 4:
+                    message.regenerating = reader.uint32();
+                    break;
+                case /* uint32 not_ready */ 5:
+                    message.notReady = reader.uint32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServiceEndpoints, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string service_id = 1; */
+        if (message.serviceId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.serviceId);
+        /* repeated ui.CiliumEndpointStatus endpoints = 2; */
+        for (let i = 0; i < message.endpoints.length; i++)
+            CiliumEndpointStatus.internalBinaryWrite(message.endpoints[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* uint32 ready = 3; */
+        if (message.ready !== 0)
+            writer.tag(3, WireType.Varint).uint32(message.ready);
+        /* uint32 regenerating = 4; */
+        if (message.regenerating !== 0)
+            writer.tag(4, WireType.Varint).uint32(message.regenerating);
+        /* uint32 not_ready = 5; */
+        if (message.notReady !== 0)
+            writer.tag(5, WireType.Varint).uint32(message.notReady);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ServiceEndpoints
+ */
+export const ServiceEndpoints = new ServiceEndpoints$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ServiceEndpointsState$Type extends MessageType<ServiceEndpointsState> {
+    constructor() {
+        super("ui.ServiceEndpointsState", [
+            { no: 1, name: "service_endpoints", kind: "message", T: () => ServiceEndpoints },
+            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
+        ]);
+    }
+    create(value?: PartialMessage<ServiceEndpointsState>): ServiceEndpointsState {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.type = 0;
+        if (value !== undefined)
+            reflectionMergePartial<ServiceEndpointsState>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceEndpointsState): ServiceEndpointsState {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.ServiceEndpoints service_endpoints */ 1:
+                    message.serviceEndpoints = ServiceEndpoints.internalBinaryRead(reader, reader.uint32(), options, message.serviceEndpoints);
+                    break;
+                case /* ui.StateChange type */ 2:
+                    message.type = reader.int32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServiceEndpointsState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.ServiceEndpoints service_endpoints = 1; */
+        if (message.serviceEndpoints)
+            ServiceEndpoints.internalBinaryWrite(message.serviceEndpoints, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* ui.StateChange type = 2; */
+        if (message.type !== 0)
+            writer.tag(2, WireType.Varint).int32(message.type);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ServiceEndpointsState
+ */
+export const ServiceEndpointsState = new ServiceEndpointsState$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ServiceFilter$Type extends MessageType<ServiceFilter> {
+    constructor() {
+        super("ui.ServiceFilter", [
+            { no: 1, name: "namespace", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ServiceFilter>): ServiceFilter {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.namespace = [];
+        if (value !== undefined)
+            reflectionMergePartial<ServiceFilter>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceFilter): ServiceFilter {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated string namespace */ 1:
+                    message.namespace.push(reader.string());
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServiceFilter, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated string namespace = 1; */
+        for (let i = 0; i < message.namespace.length; i++)
+            writer.tag(1, WireType.LengthDelimited).string(message.namespace[i]);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ServiceFilter
+ */
+export const ServiceFilter = new ServiceFilter$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ServiceLink$Type extends MessageType<ServiceLink> {
+    constructor() {
+        super("ui.ServiceLink", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "source_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "destination_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "destination_port", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 5, name: "ip_protocol", kind: "enum", T: () => ["ui.IPProtocol", IPProtocol] },
+            { no: 6, name: "verdict", kind: "enum", T: () => ["flow.Verdict", Verdict] },
+            { no: 7, name: "flow_amount", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 8, name: "latency", kind: "message", T: () => ServiceLink_Latency },
+            { no: 9, name: "bytes_transfered", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 10, name: "auth_type", kind: "enum", T: () => ["flow.AuthType", AuthType] },
+            { no: 11, name: "is_encrypted", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 12, name: "verdict_counts", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => VerdictCount },
+            { no: 13, name: "drop_reasons", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DropReasonCount },
+            { no: 14, name: "k8s_service", kind: "message", T: () => K8sServiceRef }
+        ]);
+    }
+    create(value?: PartialMessage<ServiceLink>): ServiceLink {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.sourceId = "";
+        message.destinationId = "";
+        message.destinationPort = 0;
+        message.ipProtocol = 0;
+        message.verdict = 0;
+        message.flowAmount = 0n;
+        message.bytesTransfered = 0n;
+        message.authType = 0;
+        message.isEncrypted = false;
+        message.verdictCounts = [];
+        message.dropReasons = [];
+        if (value !== undefined)
+            reflectionMergePartial<ServiceLink>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceLink): ServiceLink {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string source_id */ 2:
+                    message.sourceId = reader.string();
+                    break;
+                case /* string destination_id */ 3:
+                    message.destinationId = reader.string();
+                    break;
+                case /* uint32 destination_port */ 4:
+                    message.destinationPort = reader.uint32();
+                    break;
+                case /* ui.IPProtocol ip_protocol */ 5:
+                    message.ipProtocol = reader.int32();
+                    break;
+                case /* flow.Verdict verdict */ 6:
+                    message.verdict = reader.int32();
+                    break;
+                case /* uint64 flow_amount */ 7:
+                    message.flowAmount = reader.uint64().toBigInt();
+                    break;
+                case /* ui.ServiceLink.Latency latency */ 8:
+                    message.latency = ServiceLink_Latency.internalBinaryRead(reader, reader.uint32(), options, message.latency);
+                    break;
+                case /* uint64 bytes_transfered */ 9:
+                    message.bytesTransfered = reader.uint64().toBigInt();
+                    break;
+                case /* flow.AuthType auth_type */ 10:
+                    message.authType = reader.int32();
+                    break;
+                case /* bool is_encrypted */ 11:
+                    message.isEncrypted = reader.bool();
+                    break;
+                case /* repeated ui.VerdictCount verdict_counts */ 12:
+                    message.verdictCounts.push(VerdictCount.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated ui.DropReasonCount drop_reasons */ 13:
+                    message.dropReasons.push(DropReasonCount.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* ui.K8sServiceRef k8s_service */ 14:
+                    message.k8sService = K8sServiceRef.internalBinaryRead(reader, reader.uint32(), options, message.k8sService);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServiceLink, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string source_id = 2; */
+        if (message.sourceId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.sourceId);
+        /* string destination_id = 3; */
+        if (message.destinationId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.destinationId);
+        /* uint32 destination_port = 4; */
+        if (message.destinationPort !== 0)
+            writer.tag(4, WireType.Varint).uint32(message.destinationPort);
+        /* ui.IPProtocol ip_protocol = 5; */
+        if (message.ipProtocol !== 0)
+            writer.tag(5, WireType.Varint).int32(message.ipProtocol);
+        /* flow.Verdict verdict = 6; */
+        if (message.verdict !== 0)
+            writer.tag(6, WireType.Varint).int32(message.verdict);
+        /* uint64 flow_amount = 7; */
+        if (message.flowAmount !== 0n)
+            writer.tag(7, WireType.Varint).uint64(message.flowAmount);
+        /* ui.ServiceLink.Latency latency = 8; */
+        if (message.latency)
+            ServiceLink_Latency.internalBinaryWrite(message.latency, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* uint64 bytes_transfered = 9; */
+        if (message.bytesTransfered !== 0n)
+            writer.tag(9, WireType.Varint).uint64(message.bytesTransfered);
+        /* flow.AuthType auth_type = 10; */
+        if (message.authType !== 0)
+            writer.tag(10, WireType.Varint).int32(message.authType);
+        /* bool is_encrypted = 11; */
+        if (message.isEncrypted !== false)
+            writer.tag(11, WireType.Varint).bool(message.isEncrypted);
+        /* repeated ui.VerdictCount verdict_counts = 12; */
+        for (let i = 0; i < message.verdictCounts.length; i++)
+            VerdictCount.internalBinaryWrite(message.verdictCounts[i], writer.tag(12, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.DropReasonCount drop_reasons = 13; */
+        for (let i = 0; i < message.dropReasons.length; i++)
+            DropReasonCount.internalBinaryWrite(message.dropReasons[i], writer.tag(13, WireType.LengthDelimited).fork(), options).join();
+        /* ui.K8sServiceRef k8s_service = 14; */
+        if (message.k8sService)
+            K8sServiceRef.internalBinaryWrite(message.k8sService, writer.tag(14, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ServiceLink
+ */
+export const ServiceLink = new ServiceLink$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ServiceLink_Latency$Type extends MessageType<ServiceLink_Latency> {
+    constructor() {
+        super("ui.ServiceLink.Latency", [
+            { no: 1, name: "min", kind: "message", T: () => Duration },
+            { no: 2, name: "max", kind: "message", T: () => Duration },
+            { no: 3, name: "avg", kind: "message", T: () => Duration }
+        ]);
+    }
+    create(value?: PartialMessage<ServiceLink_Latency>): ServiceLink_Latency {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<ServiceLink_Latency>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceLink_Latency): ServiceLink_Latency {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* google.protobuf.Duration min */ 1:
+                    message.min = Duration.internalBinaryRead(reader, reader.uint32(), options, message.min);
+                    break;
+                case /* google.protobuf.Duration max */ 2:
+                    message.max = Duration.internalBinaryRead(reader, reader.uint32(), options, message.max);
+                    break;
+                case /* google.protobuf.Duration avg */ 3:
+                    message.avg = Duration.internalBinaryRead(reader, reader.uint32(), options, message.avg);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServiceLink_Latency, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* google.protobuf.Duration min = 1; */
+        if (message.min)
+            Duration.internalBinaryWrite(message.min, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Duration max = 2; */
+        if (message.max)
+            Duration.internalBinaryWrite(message.max, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Duration avg = 3; */
+        if (message.avg)
+            Duration.internalBinaryWrite(message.avg, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ServiceLink.Latency
+ */
+export const ServiceLink_Latency = new ServiceLink_Latency$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class K8sServiceRef$Type extends MessageType<K8sServiceRef> {
+    constructor() {
+        super("ui.K8sServiceRef", [
+            { no: 1, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "type", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "port_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "ready_backends", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 6, name: "backends", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        ]);
+    }
+    create(value?: PartialMessage<K8sServiceRef>): K8sServiceRef {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.name = "";
+        message.namespace = "";
+        message.type = "";
+        message.portName = "";
+        message.readyBackends = 0;
+        message.backends = 0;
+        if (value !== undefined)
+            reflectionMergePartial<K8sServiceRef>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: K8sServiceRef): K8sServiceRef {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string name */ 1:
+                    message.name = reader.string();
+                    break;
+                case /* string namespace */ 2:
+                    message.namespace = reader.string();
+                    break;
+                case /* string type */ 3:
+                    message.type = reader.string();
+                    break;
+                case /* string port_name */ 4:
+                    message.portName = reader.string();
+                    break;
+                case /* uint32 ready_backends */ 5:
+                    message.readyBackends = reader.uint32();
+                    break;
+                case /* uint32 backends */ 6:
+                    message.backends = reader.uint32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: K8sServiceRef, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string name = 1; */
+        if (message.name !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.name);
+        /* string namespace = 2; */
+        if (message.namespace !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.namespace);
+        /* string type = 3; */
+        if (message.type !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.type);
+        /* string port_name = 4; */
+        if (message.portName !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.portName);
+        /* uint32 ready_backends = 5; */
+        if (message.readyBackends !== 0)
+            writer.tag(5, WireType.Varint).uint32(message.readyBackends);
+        /* uint32 backends = 6; */
+        if (message.backends !== 0)
+            writer.tag(6, WireType.Varint).uint32(message.backends);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.K8sServiceRef
+ */
+export const K8sServiceRef = new K8sServiceRef$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class K8sServiceLink$Type extends MessageType<K8sServiceLink> {
+    constructor() {
+        super("ui.K8sServiceLink", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "source_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "k8s_service", kind: "message", T: () => K8sServiceRef },
+            { no: 4, name: "destination_ids", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "flow_amount", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 6, name: "verdict_counts", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => VerdictCount }
+        ]);
+    }
+    create(value?: PartialMessage<K8sServiceLink>): K8sServiceLink {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.sourceId = "";
+        message.destinationIds = [];
+        message.flowAmount = 0n;
+        message.verdictCounts = [];
+        if (value !== undefined)
+            reflectionMergePartial<K8sServiceLink>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: K8sServiceLink): K8sServiceLink {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string source_id */ 2:
+                    message.sourceId = reader.string();
+                    break;
+                case /* ui.K8sServiceRef k8s_service */ 3:
+                    message.k8sService = K8sServiceRef.internalBinaryRead(reader, reader.uint32(), options, message.k8sService);
+                    break;
+                case /* repeated string destination_ids */ 4:
+                    message.destinationIds.push(reader.string());
+                    break;
+                case /* uint64 flow_amount */ 5:
+                    message.flowAmount = reader.uint64().toBigInt();
+                    break;
+                case /* repeated ui.VerdictCount verdict_counts */ 6:
+                    message.verdictCounts.push(VerdictCount.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: K8sServiceLink, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string source_id = 2; */
+        if (message.sourceId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.sourceId);
+        /* ui.K8sServiceRef k8s_service = 3; */
+        if (message.k8sService)
+            K8sServiceRef.internalBinaryWrite(message.k8sService, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string destination_ids = 4; */
+        for (let i = 0; i < message.destinationIds.length; i++)
+            writer.tag(4, WireType.LengthDelimited).string(message.destinationIds[i]);
+        /* uint64 flow_amount = 5; */
+        if (message.flowAmount !== 0n)
+            writer.tag(5, WireType.Varint).uint64(message.flowAmount);
+        /* repeated ui.VerdictCount verdict_counts = 6; */
+        for (let i = 0; i < message.verdictCounts.length; i++)
+            VerdictCount.internalBinaryWrite(message.verdictCounts[i], writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.K8sServiceLink
+ */
+export const K8sServiceLink = new K8sServiceLink$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class K8sServiceLinkState$Type extends MessageType<K8sServiceLinkState> {
+    constructor() {
+        super("ui.K8sServiceLinkState", [
+            { no: 1, name: "k8s_service_link", kind: "message", T: () => K8sServiceLink },
+            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
+        ]);
+    }
+    create(value?: PartialMessage<K8sServiceLinkState>): K8sServiceLinkState {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.type = 0;
+        if (value !== undefined)
+            reflectionMergePartial<K8sServiceLinkState>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: K8sServiceLinkState): K8sServiceLinkState {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.K8sServiceLink k8s_service_link */ 1:
+                    message.k8sServiceLink = K8sServiceLink.internalBinaryRead(reader, reader.uint32(), options, message.k8sServiceLink);
+                    break;
+                case /* ui.StateChange type */ 2:
+                    message.type = reader.int32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: K8sServiceLinkState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.K8sServiceLink k8s_service_link = 1; */
+        if (message.k8sServiceLink)
+            K8sServiceLink.internalBinaryWrite(message.k8sServiceLink, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* ui.StateChange type = 2; */
+        if (message.type !== 0)
+            writer.tag(2, WireType.Varint).int32(message.type);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.K8sServiceLinkState
+ */
+export const K8sServiceLinkState = new K8sServiceLinkState$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class VerdictCount$Type extends MessageType<VerdictCount> {
+    constructor() {
+        super("ui.VerdictCount", [
+            { no: 1, name: "verdict", kind: "enum", T: () => ["flow.Verdict", Verdict] },
+            { no: 2, name: "count", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ }
+        ]);
+    }
+    create(value?: PartialMessage<VerdictCount>): VerdictCount {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.verdict = 0;
+        message.count = 0n;
+        if (value !== undefined)
+            reflectionMergePartial<VerdictCount>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: VerdictCount): VerdictCount {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* flow.Verdict verdict */ 1:
+                    message.verdict = reader.int32();
+                    break;
+                case /* uint64 count */ 2:
+                    message.count = reader.uint64().toBigInt();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: VerdictCount, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* flow.Verdict verdict = 1; */
+        if (message.verdict !== 0)
+            writer.tag(1, WireType.Varint).int32(message.verdict);
+        /* uint64 count = 2; */
+        if (message.count !== 0n)
+            writer.tag(2, WireType.Varint).uint64(message.count);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.VerdictCount
+ */
+export const VerdictCount = new VerdictCount$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DropReasonCount$Type extends MessageType<DropReasonCount> {
+    constructor() {
+        super("ui.DropReasonCount", [
+            { no: 1, name: "reason", kind: "enum", T: () => ["flow.DropReason", DropReason] },
+            { no: 2, name: "count", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ }
+        ]);
+    }
+    create(value?: PartialMessage<DropReasonCount>): DropReasonCount {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.reason = 0;
+        message.count = 0n;
+        if (value !== undefined)
+            reflectionMergePartial<DropReasonCount>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DropReasonCount): DropReasonCount {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* flow.DropReason reason */ 1:
+                    message.reason = reader.int32();
+                    break;
+                case /* uint64 count */ 2:
+                    message.count = reader.uint64().toBigInt();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: DropReasonCount, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* flow.DropReason reason = 1; */
+        if (message.reason !== 0)
+            writer.tag(1, WireType.Varint).int32(message.reason);
+        /* uint64 count = 2; */
+        if (message.count !== 0n)
+            writer.tag(2, WireType.Varint).uint64(message.count);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.DropReasonCount
+ */
+export const DropReasonCount = new DropReasonCount$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class NamespaceDropReasons$Type extends MessageType<NamespaceDropReasons> {
+    constructor() {
+        super("ui.NamespaceDropReasons", [
+            { no: 1, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "top_reasons", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DropReasonCount }
+        ]);
+    }
+    create(value?: PartialMessage<NamespaceDropReasons>): NamespaceDropReasons {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.namespace = "";
+        message.topReasons = [];
+        if (value !== undefined)
+            reflectionMergePartial<NamespaceDropReasons>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceDropReasons): NamespaceDropReasons {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string namespace */ 1:
+                    message.namespace = reader.string();
+                    break;
+                case /* repeated ui.DropReasonCount top_reasons */ 2:
+                    message.topReasons.push(DropReasonCount.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: NamespaceDropReasons, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string namespace = 1; */
+        if (message.namespace !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.namespace);
+        /* repeated ui.DropReasonCount top_reasons = 2; */
+        for (let i = 0; i < message.topReasons.length; i++)
+            DropReasonCount.internalBinaryWrite(message.topReasons[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.NamespaceDropReasons
+ */
+export const NamespaceDropReasons = new NamespaceDropReasons$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ServiceLinkState$Type extends MessageType<ServiceLinkState> {
+    constructor() {
+        super("ui.ServiceLinkState", [
+            { no: 1, name: "service_link", kind: "message", T: () => ServiceLink },
+            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
+        ]);
+    }
+    create(value?: PartialMessage<ServiceLinkState>): ServiceLinkState {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.type = 0;
+        if (value !== undefined)
+            reflectionMergePartial<ServiceLinkState>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceLinkState): ServiceLinkState {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.ServiceLink service_link */ 1:
+                    message.serviceLink = ServiceLink.internalBinaryRead(reader, reader.uint32(), options, message.serviceLink);
+                    break;
+                case /* ui.StateChange type */ 2:
+                    message.type = reader.int32();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServiceLinkState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.ServiceLink service_link = 1; */
+        if (message.serviceLink)
+            ServiceLink.internalBinaryWrite(message.serviceLink, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* ui.StateChange type = 2; */
+        if (message.type !== 0)
+            writer.tag(2, WireType.Varint).int32(message.type);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
 }
+/**
+ * @generated MessageType for protobuf message ui.ServiceLinkState
+ */
+export const ServiceLinkState = new ServiceLinkState$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetEventsRequest$Type extends MessageType<GetEventsRequest> {
+class NamespaceNode$Type extends MessageType<NamespaceNode> {
     constructor() {
-        super("ui.GetEventsRequest", [
-            { no: 1, name: "event_types", kind: "enum", repeat: 1 /*RepeatType.PACKED*/, T: () => ["ui.EventType", EventType] },
-            { no: 2, name: "blacklist", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => EventFilter },
-            { no: 3, name: "whitelist", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => EventFilter },
-            { no: 4, name: "since", kind: "message", T: () => Timestamp },
-            { no: 5, name: "status_request", kind: "message", T: () => GetStatusRequest }
+        super("ui.NamespaceNode", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "is_reserved", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 4, name: "flow_amount", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 5, name: "services_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
         ]);
     }
-    create(value?: PartialMessage<GetEventsRequest>): GetEventsRequest {
+    create(value?: PartialMessage<NamespaceNode>): NamespaceNode {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.eventTypes = [];
-        message.blacklist = [];
-        message.whitelist = [];
+        message.id = "";
+        message.name = "";
+        message.isReserved = false;
+        message.flowAmount = 0n;
+        message.servicesNumber = 0;
         if (value !== undefined)
-            reflectionMergePartial<GetEventsRequest>(this, message, value);
+            reflectionMergePartial<NamespaceNode>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetEventsRequest): GetEventsRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceNode): NamespaceNode {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated ui.EventType event_types */ 1:
-                    if (wireType === WireType.LengthDelimited)
-                        for (let e = reader.int32() + reader.pos; reader.pos < e;)
-                            message.eventTypes.push(reader.int32());
-                    else
-                        message.eventTypes.push(reader.int32());
+                case /* string id */ 1:
+                    message.id = reader.string();
                     break;
-                case /* repeated ui.EventFilter blacklist */ 2:
-                    message.blacklist.push(EventFilter.internalBinaryRead(reader, reader.uint32(), options));
+                case /* string name */ 2:
+                    message.name = reader.string();
                     break;
-                case /* repeated ui.EventFilter whitelist */ 3:
-                    message.whitelist.push(EventFilter.internalBinaryRead(reader, reader.uint32(), options));
+                case /* bool is_reserved */ 3:
+                    message.isReserved = reader.bool();
                     break;
-                case /* google.protobuf.Timestamp since */ 4:
-                    message.since = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.since);
+                case /* uint64 flow_amount */ 4:
+                    message.flowAmount = reader.uint64().toBigInt();
                     break;
-                case /* ui.GetStatusRequest status_request */ 5:
-                    message.statusRequest = GetStatusRequest.internalBinaryRead(reader, reader.uint32(), options, message.statusRequest);
+                case /* uint32 services_number */ 5:
+                    message.servicesNumber = reader.uint32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -581,26 +3405,22 @@ class GetEventsRequest$Type extends MessageType<GetEventsRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: GetEventsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated ui.EventType event_types = 1; */
-        if (message.eventTypes.length) {
-            writer.tag(1, WireType.LengthDelimited).fork();
-            for (let i = 0; i < message.eventTypes.length; i++)
-                writer.int32(message.eventTypes[i]);
-            writer.join();
-        }
-        /* repeated ui.EventFilter blacklist = 2; */
-        for (let i = 0; i < message.blacklist.length; i++)
-            EventFilter.internalBinaryWrite(message.blacklist[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
-        /* repeated ui.EventFilter whitelist = 3; */
-        for (let i = 0; i < message.whitelist.length; i++)
-            EventFilter.internalBinaryWrite(message.whitelist[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp since = 4; */
-        if (message.since)
-            Timestamp.internalBinaryWrite(message.since, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* ui.GetStatusRequest status_request = 5; */
-        if (message.statusRequest)
-            GetStatusRequest.internalBinaryWrite(message.statusRequest, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: NamespaceNode, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string name = 2; */
+        if (message.name !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.name);
+        /* bool is_reserved = 3; */
+        if (message.isReserved !== false)
+            writer.tag(3, WireType.Varint).bool(message.isReserved);
+        /* uint64 flow_amount = 4; */
+        if (message.flowAmount !== 0n)
+            writer.tag(4, WireType.Varint).uint64(message.flowAmount);
+        /* uint32 services_number = 5; */
+        if (message.servicesNumber !== 0)
+            writer.tag(5, WireType.Varint).uint32(message.servicesNumber);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -608,39 +3428,34 @@ class GetEventsRequest$Type extends MessageType<GetEventsRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.GetEventsRequest
+ * @generated MessageType for protobuf message ui.NamespaceNode
  */
-export const GetEventsRequest = new GetEventsRequest$Type();
+export const NamespaceNode = new NamespaceNode$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetEventsResponse$Type extends MessageType<GetEventsResponse> {
+class NamespaceNodeState$Type extends MessageType<NamespaceNodeState> {
     constructor() {
-        super("ui.GetEventsResponse", [
-            { no: 1, name: "node", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "timestamp", kind: "message", T: () => Timestamp },
-            { no: 3, name: "events", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Event }
+        super("ui.NamespaceNodeState", [
+            { no: 1, name: "namespace_node", kind: "message", T: () => NamespaceNode },
+            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
         ]);
     }
-    create(value?: PartialMessage<GetEventsResponse>): GetEventsResponse {
+    create(value?: PartialMessage<NamespaceNodeState>): NamespaceNodeState {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.node = "";
-        message.events = [];
+        message.type = 0;
         if (value !== undefined)
-            reflectionMergePartial<GetEventsResponse>(this, message, value);
+            reflectionMergePartial<NamespaceNodeState>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetEventsResponse): GetEventsResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceNodeState): NamespaceNodeState {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string node */ 1:
-                    message.node = reader.string();
-                    break;
-                case /* google.protobuf.Timestamp timestamp */ 2:
-                    message.timestamp = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.timestamp);
+                case /* ui.NamespaceNode namespace_node */ 1:
+                    message.namespaceNode = NamespaceNode.internalBinaryRead(reader, reader.uint32(), options, message.namespaceNode);
                     break;
-                case /* repeated ui.Event events */ 3:
-                    message.events.push(Event.internalBinaryRead(reader, reader.uint32(), options));
+                case /* ui.StateChange type */ 2:
+                    message.type = reader.int32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -653,16 +3468,13 @@ class GetEventsResponse$Type extends MessageType<GetEventsResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: GetEventsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string node = 1; */
-        if (message.node !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.node);
-        /* google.protobuf.Timestamp timestamp = 2; */
-        if (message.timestamp)
-            Timestamp.internalBinaryWrite(message.timestamp, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
-        /* repeated ui.Event events = 3; */
-        for (let i = 0; i < message.events.length; i++)
-            Event.internalBinaryWrite(message.events[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: NamespaceNodeState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.NamespaceNode namespace_node = 1; */
+        if (message.namespaceNode)
+            NamespaceNode.internalBinaryWrite(message.namespaceNode, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* ui.StateChange type = 2; */
+        if (message.type !== 0)
+            writer.tag(2, WireType.Varint).int32(message.type);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -670,68 +3482,75 @@ class GetEventsResponse$Type extends MessageType<GetEventsResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.GetEventsResponse
+ * @generated MessageType for protobuf message ui.NamespaceNodeState
  */
-export const GetEventsResponse = new GetEventsResponse$Type();
+export const NamespaceNodeState = new NamespaceNodeState$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class Event$Type extends MessageType<Event> {
+class NamespaceLink$Type extends MessageType<NamespaceLink> {
     constructor() {
-        super("ui.Event", [
-            { no: 3, name: "flow", kind: "message", oneof: "event", T: () => Flow },
-            { no: 4, name: "namespace_state", kind: "message", oneof: "event", T: () => NamespaceState },
-            { no: 5, name: "service_state", kind: "message", oneof: "event", T: () => ServiceState },
-            { no: 6, name: "service_link_state", kind: "message", oneof: "event", T: () => ServiceLinkState },
-            { no: 7, name: "flows", kind: "message", oneof: "event", T: () => Flows },
-            { no: 8, name: "notification", kind: "message", oneof: "event", T: () => Notification }
+        super("ui.NamespaceLink", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "source_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "destination_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "flow_amount", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 5, name: "verdict_counts", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => VerdictCount },
+            { no: 6, name: "drop_reasons", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DropReasonCount },
+            { no: 7, name: "bytes_transfered", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 8, name: "encrypted_flow_amount", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 9, name: "encryption_coverage", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ },
+            { no: 10, name: "service_links_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
         ]);
     }
-    create(value?: PartialMessage<Event>): Event {
+    create(value?: PartialMessage<NamespaceLink>): NamespaceLink {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.event = { oneofKind: undefined };
+        message.id = "";
+        message.sourceId = "";
+        message.destinationId = "";
+        message.flowAmount = 0n;
+        message.verdictCounts = [];
+        message.dropReasons = [];
+        message.bytesTransfered = 0n;
+        message.encryptedFlowAmount = 0n;
+        message.encryptionCoverage = 0;
+        message.serviceLinksNumber = 0;
         if (value !== undefined)
-            reflectionMergePartial<Event>(this, message, value);
+            reflectionMergePartial<NamespaceLink>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: Event): Event {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceLink): NamespaceLink {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* flow.Flow flow */ 3:
-                    message.event = {
-                        oneofKind: "flow",
-                        flow: Flow.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).flow)
-                    };
+                case /* string id */ 1:
+                    message.id = reader.string();
                     break;
-                case /* ui.NamespaceState namespace_state */ 4:
-                    message.event = {
-                        oneofKind: "namespaceState",
-                        namespaceState: NamespaceState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).namespaceState)
-                    };
+                case /* string source_id */ 2:
+                    message.sourceId = reader.string();
                     break;
-                case /* ui.ServiceState service_state */ 5:
-                    message.event = {
-                        oneofKind: "serviceState",
-                        serviceState: ServiceState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).serviceState)
-                    };
+                case /* string destination_id */ 3:
+                    message.destinationId = reader.string();
                     break;
-                case /* ui.ServiceLinkState service_link_state */ 6:
-                    message.event = {
-                        oneofKind: "serviceLinkState",
-                        serviceLinkState: ServiceLinkState.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).serviceLinkState)
-                    };
+                case /* uint64 flow_amount */ 4:
+                    message.flowAmount = reader.uint64().toBigInt();
                     break;
-                case /* ui.Flows flows */ 7:
-                    message.event = {
-                        oneofKind: "flows",
-                        flows: Flows.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).flows)
-                    };
+                case /* repeated ui.VerdictCount verdict_counts */ 5:
+                    message.verdictCounts.push(VerdictCount.internalBinaryRead(reader, reader.uint32(), options));
                     break;
-                case /* ui.Notification notification */ 8:
-                    message.event = {
-                        oneofKind: "notification",
-                        notification: Notification.internalBinaryRead(reader, reader.uint32(), options, (message.event as any).notification)
-                    };
+                case /* repeated ui.DropReasonCount drop_reasons */ 6:
+                    message.dropReasons.push(DropReasonCount.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* uint64 bytes_transfered */ 7:
+                    message.bytesTransfered = reader.uint64().toBigInt();
+                    break;
+                case /* uint64 encrypted_flow_amount */ 8:
+                    message.encryptedFlowAmount = reader.uint64().toBigInt();
+                    break;
+                case /* float encryption_coverage */ 9:
+                    message.encryptionCoverage = reader.float();
+                    break;
+                case /* uint32 service_links_number */ 10:
+                    message.serviceLinksNumber = reader.uint32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -744,25 +3563,37 @@ class Event$Type extends MessageType<Event> {
         }
         return message;
     }
-    internalBinaryWrite(message: Event, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* flow.Flow flow = 3; */
-        if (message.event.oneofKind === "flow")
-            Flow.internalBinaryWrite(message.event.flow, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
-        /* ui.NamespaceState namespace_state = 4; */
-        if (message.event.oneofKind === "namespaceState")
-            NamespaceState.internalBinaryWrite(message.event.namespaceState, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* ui.ServiceState service_state = 5; */
-        if (message.event.oneofKind === "serviceState")
-            ServiceState.internalBinaryWrite(message.event.serviceState, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
-        /* ui.ServiceLinkState service_link_state = 6; */
-        if (message.event.oneofKind === "serviceLinkState")
-            ServiceLinkState.internalBinaryWrite(message.event.serviceLinkState, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
-        /* ui.Flows flows = 7; */
-        if (message.event.oneofKind === "flows")
-            Flows.internalBinaryWrite(message.event.flows, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
-        /* ui.Notification notification = 8; */
-        if (message.event.oneofKind === "notification")
-            Notification.internalBinaryWrite(message.event.notification, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: NamespaceLink, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string source_id = 2; */
+        if (message.sourceId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.sourceId);
+        /* string destination_id = 3; */
+        if (message.destinationId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.destinationId);
+        /* uint64 flow_amount = 4; */
+        if (message.flowAmount !== 0n)
+            writer.tag(4, WireType.Varint).uint64(message.flowAmount);
+        /* repeated ui.VerdictCount verdict_counts = 5; */
+        for (let i = 0; i < message.verdictCounts.length; i++)
+            VerdictCount.internalBinaryWrite(message.verdictCounts[i], writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.DropReasonCount drop_reasons = 6; */
+        for (let i = 0; i < message.dropReasons.length; i++)
+            DropReasonCount.internalBinaryWrite(message.dropReasons[i], writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* uint64 bytes_transfered = 7; */
+        if (message.bytesTransfered !== 0n)
+            writer.tag(7, WireType.Varint).uint64(message.bytesTransfered);
+        /* uint64 encrypted_flow_amount = 8; */
+        if (message.encryptedFlowAmount !== 0n)
+            writer.tag(8, WireType.Varint).uint64(message.encryptedFlowAmount);
+        /* float encryption_coverage = 9; */
+        if (message.encryptionCoverage !== 0)
+            writer.tag(9, WireType.Bit32).float(message.encryptionCoverage);
+        /* uint32 service_links_number = 10; */
+        if (message.serviceLinksNumber !== 0)
+            writer.tag(10, WireType.Varint).uint32(message.serviceLinksNumber);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -770,30 +3601,34 @@ class Event$Type extends MessageType<Event> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.Event
+ * @generated MessageType for protobuf message ui.NamespaceLink
  */
-export const Event = new Event$Type();
+export const NamespaceLink = new NamespaceLink$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class Flows$Type extends MessageType<Flows> {
+class NamespaceLinkState$Type extends MessageType<NamespaceLinkState> {
     constructor() {
-        super("ui.Flows", [
-            { no: 1, name: "flows", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Flow }
+        super("ui.NamespaceLinkState", [
+            { no: 1, name: "namespace_link", kind: "message", T: () => NamespaceLink },
+            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
         ]);
     }
-    create(value?: PartialMessage<Flows>): Flows {
+    create(value?: PartialMessage<NamespaceLinkState>): NamespaceLinkState {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.flows = [];
+        message.type = 0;
         if (value !== undefined)
-            reflectionMergePartial<Flows>(this, message, value);
+            reflectionMergePartial<NamespaceLinkState>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: Flows): Flows {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceLinkState): NamespaceLinkState {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated flow.Flow flows */ 1:
-                    message.flows.push(Flow.internalBinaryRead(reader, reader.uint32(), options));
+                case /* ui.NamespaceLink namespace_link */ 1:
+                    message.namespaceLink = NamespaceLink.internalBinaryRead(reader, reader.uint32(), options, message.namespaceLink);
+                    break;
+                case /* ui.StateChange type */ 2:
+                    message.type = reader.int32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -806,10 +3641,13 @@ class Flows$Type extends MessageType<Flows> {
         }
         return message;
     }
-    internalBinaryWrite(message: Flows, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated flow.Flow flows = 1; */
-        for (let i = 0; i < message.flows.length; i++)
-            Flow.internalBinaryWrite(message.flows[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: NamespaceLinkState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.NamespaceLink namespace_link = 1; */
+        if (message.namespaceLink)
+            NamespaceLink.internalBinaryWrite(message.namespaceLink, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* ui.StateChange type = 2; */
+        if (message.type !== 0)
+            writer.tag(2, WireType.Varint).int32(message.type);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -817,47 +3655,49 @@ class Flows$Type extends MessageType<Flows> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.Flows
+ * @generated MessageType for protobuf message ui.NamespaceLinkState
  */
-export const Flows = new Flows$Type();
+export const NamespaceLinkState = new NamespaceLinkState$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class EventFilter$Type extends MessageType<EventFilter> {
+class ServiceLinkFilter$Type extends MessageType<ServiceLinkFilter> {
     constructor() {
-        super("ui.EventFilter", [
-            { no: 2, name: "flow_filter", kind: "message", oneof: "filter", T: () => FlowFilter },
-            { no: 3, name: "service_filter", kind: "message", oneof: "filter", T: () => ServiceFilter },
-            { no: 4, name: "service_link_filter", kind: "message", oneof: "filter", T: () => ServiceLinkFilter }
+        super("ui.ServiceLinkFilter", [
+            { no: 1, name: "source", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ServiceFilter },
+            { no: 2, name: "destination", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ServiceFilter },
+            { no: 3, name: "destination_port", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "verdict", kind: "enum", repeat: 1 /*RepeatType.PACKED*/, T: () => ["flow.Verdict", Verdict] }
         ]);
     }
-    create(value?: PartialMessage<EventFilter>): EventFilter {
+    create(value?: PartialMessage<ServiceLinkFilter>): ServiceLinkFilter {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.filter = { oneofKind: undefined };
+        message.source = [];
+        message.destination = [];
+        message.destinationPort = [];
+        message.verdict = [];
         if (value !== undefined)
-            reflectionMergePartial<EventFilter>(this, message, value);
+            reflectionMergePartial<ServiceLinkFilter>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: EventFilter): EventFilter {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceLinkFilter): ServiceLinkFilter {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* flow.FlowFilter flow_filter */ 2:
-                    message.filter = {
-                        oneofKind: "flowFilter",
-                        flowFilter: FlowFilter.internalBinaryRead(reader, reader.uint32(), options, (message.filter as any).flowFilter)
-                    };
+                case /* repeated ui.ServiceFilter source */ 1:
+                    message.source.push(ServiceFilter.internalBinaryRead(reader, reader.uint32(), options));
                     break;
-                case /* ui.ServiceFilter service_filter */ 3:
-                    message.filter = {
-                        oneofKind: "serviceFilter",
-                        serviceFilter: ServiceFilter.internalBinaryRead(reader, reader.uint32(), options, (message.filter as any).serviceFilter)
-                    };
+                case /* repeated ui.ServiceFilter destination */ 2:
+                    message.destination.push(ServiceFilter.internalBinaryRead(reader, reader.uint32(), options));
                     break;
-                case /* ui.ServiceLinkFilter service_link_filter */ 4:
-                    message.filter = {
-                        oneofKind: "serviceLinkFilter",
-                        serviceLinkFilter: ServiceLinkFilter.internalBinaryRead(reader, reader.uint32(), options, (message.filter as any).serviceLinkFilter)
-                    };
+                case /* repeated string destination_port */ 3:
+                    message.destinationPort.push(reader.string());
+                    break;
+                case /* repeated flow.Verdict verdict */ 4:
+                    if (wireType === WireType.LengthDelimited)
+                        for (let e = reader.int32() + reader.pos; reader.pos < e;)
+                            message.verdict.push(reader.int32());
+                    else
+                        message.verdict.push(reader.int32());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -870,16 +3710,23 @@ class EventFilter$Type extends MessageType<EventFilter> {
         }
         return message;
     }
-    internalBinaryWrite(message: EventFilter, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* flow.FlowFilter flow_filter = 2; */
-        if (message.filter.oneofKind === "flowFilter")
-            FlowFilter.internalBinaryWrite(message.filter.flowFilter, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
-        /* ui.ServiceFilter service_filter = 3; */
-        if (message.filter.oneofKind === "serviceFilter")
-            ServiceFilter.internalBinaryWrite(message.filter.serviceFilter, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
-        /* ui.ServiceLinkFilter service_link_filter = 4; */
-        if (message.filter.oneofKind === "serviceLinkFilter")
-            ServiceLinkFilter.internalBinaryWrite(message.filter.serviceLinkFilter, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ServiceLinkFilter, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated ui.ServiceFilter source = 1; */
+        for (let i = 0; i < message.source.length; i++)
+            ServiceFilter.internalBinaryWrite(message.source[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.ServiceFilter destination = 2; */
+        for (let i = 0; i < message.destination.length; i++)
+            ServiceFilter.internalBinaryWrite(message.destination[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string destination_port = 3; */
+        for (let i = 0; i < message.destinationPort.length; i++)
+            writer.tag(3, WireType.LengthDelimited).string(message.destinationPort[i]);
+        /* repeated flow.Verdict verdict = 4; */
+        if (message.verdict.length) {
+            writer.tag(4, WireType.LengthDelimited).fork();
+            for (let i = 0; i < message.verdict.length; i++)
+                writer.int32(message.verdict[i]);
+            writer.join();
+        }
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -887,39 +3734,35 @@ class EventFilter$Type extends MessageType<EventFilter> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.EventFilter
+ * @generated MessageType for protobuf message ui.ServiceLinkFilter
  */
-export const EventFilter = new EventFilter$Type();
+export const ServiceLinkFilter = new ServiceLinkFilter$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class NamespaceDescriptor$Type extends MessageType<NamespaceDescriptor> {
+class ServiceDetailsRequest$Type extends MessageType<ServiceDetailsRequest> {
     constructor() {
-        super("ui.NamespaceDescriptor", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "creation_timestamp", kind: "message", T: () => Timestamp }
+        super("ui.ServiceDetailsRequest", [
+            { no: 1, name: "service_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "flows_limit", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
         ]);
     }
-    create(value?: PartialMessage<NamespaceDescriptor>): NamespaceDescriptor {
+    create(value?: PartialMessage<ServiceDetailsRequest>): ServiceDetailsRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.name = "";
+        message.serviceId = "";
+        message.flowsLimit = 0;
         if (value !== undefined)
-            reflectionMergePartial<NamespaceDescriptor>(this, message, value);
+            reflectionMergePartial<ServiceDetailsRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceDescriptor): NamespaceDescriptor {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceDetailsRequest): ServiceDetailsRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string name */ 2:
-                    message.name = reader.string();
+                case /* string service_id */ 1:
+                    message.serviceId = reader.string();
                     break;
-                case /* google.protobuf.Timestamp creation_timestamp */ 3:
-                    message.creationTimestamp = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.creationTimestamp);
+                case /* uint32 flows_limit */ 2:
+                    message.flowsLimit = reader.uint32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -932,16 +3775,13 @@ class NamespaceDescriptor$Type extends MessageType<NamespaceDescriptor> {
         }
         return message;
     }
-    internalBinaryWrite(message: NamespaceDescriptor, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string name = 2; */
-        if (message.name !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.name);
-        /* google.protobuf.Timestamp creation_timestamp = 3; */
-        if (message.creationTimestamp)
-            Timestamp.internalBinaryWrite(message.creationTimestamp, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ServiceDetailsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string service_id = 1; */
+        if (message.serviceId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.serviceId);
+        /* uint32 flows_limit = 2; */
+        if (message.flowsLimit !== 0)
+            writer.tag(2, WireType.Varint).uint32(message.flowsLimit);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -949,34 +3789,74 @@ class NamespaceDescriptor$Type extends MessageType<NamespaceDescriptor> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.NamespaceDescriptor
+ * @generated MessageType for protobuf message ui.ServiceDetailsRequest
  */
-export const NamespaceDescriptor = new NamespaceDescriptor$Type();
+export const ServiceDetailsRequest = new ServiceDetailsRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class NamespaceState$Type extends MessageType<NamespaceState> {
+class ServiceDetailsResponse$Type extends MessageType<ServiceDetailsResponse> {
     constructor() {
-        super("ui.NamespaceState", [
-            { no: 1, name: "namespace", kind: "message", T: () => NamespaceDescriptor },
-            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
+        super("ui.ServiceDetailsResponse", [
+            { no: 1, name: "service", kind: "message", T: () => Service },
+            { no: 2, name: "inbound", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ServicePeer },
+            { no: 3, name: "outbound", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ServicePeer },
+            { no: 4, name: "ports", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ServicePort },
+            { no: 5, name: "workloads", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Workload },
+            { no: 6, name: "pods", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "l7_endpoints", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => L7Endpoint },
+            { no: 8, name: "recent_flows", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Flow },
+            { no: 9, name: "flows_number", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 10, name: "http_endpoints", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => HttpEndpointStats }
         ]);
     }
-    create(value?: PartialMessage<NamespaceState>): NamespaceState {
+    create(value?: PartialMessage<ServiceDetailsResponse>): ServiceDetailsResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.type = 0;
+        message.inbound = [];
+        message.outbound = [];
+        message.ports = [];
+        message.workloads = [];
+        message.pods = [];
+        message.l7Endpoints = [];
+        message.recentFlows = [];
+        message.flowsNumber = 0;
+        message.httpEndpoints = [];
         if (value !== undefined)
-            reflectionMergePartial<NamespaceState>(this, message, value);
+            reflectionMergePartial<ServiceDetailsResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: NamespaceState): NamespaceState {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceDetailsResponse): ServiceDetailsResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* ui.NamespaceDescriptor namespace */ 1:
-                    message.namespace = NamespaceDescriptor.internalBinaryRead(reader, reader.uint32(), options, message.namespace);
+                case /* ui.Service service */ 1:
+                    message.service = Service.internalBinaryRead(reader, reader.uint32(), options, message.service);
                     break;
-                case /* ui.StateChange type */ 2:
-                    message.type = reader.int32();
+                case /* repeated ui.ServicePeer inbound */ 2:
+                    message.inbound.push(ServicePeer.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated ui.ServicePeer outbound */ 3:
+                    message.outbound.push(ServicePeer.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated ui.ServicePort ports */ 4:
+                    message.ports.push(ServicePort.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated flow.Workload workloads */ 5:
+                    message.workloads.push(Workload.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated string pods */ 6:
+                    message.pods.push(reader.string());
+                    break;
+                case /* repeated ui.L7Endpoint l7_endpoints */ 7:
+                    message.l7Endpoints.push(L7Endpoint.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated flow.Flow recent_flows */ 8:
+                    message.recentFlows.push(Flow.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* uint32 flows_number */ 9:
+                    message.flowsNumber = reader.uint32();
+                    break;
+                case /* repeated ui.HttpEndpointStats http_endpoints */ 10:
+                    message.httpEndpoints.push(HttpEndpointStats.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -989,13 +3869,37 @@ class NamespaceState$Type extends MessageType<NamespaceState> {
         }
         return message;
     }
-    internalBinaryWrite(message: NamespaceState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* ui.NamespaceDescriptor namespace = 1; */
-        if (message.namespace)
-            NamespaceDescriptor.internalBinaryWrite(message.namespace, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* ui.StateChange type = 2; */
-        if (message.type !== 0)
-            writer.tag(2, WireType.Varint).int32(message.type);
+    internalBinaryWrite(message: ServiceDetailsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.Service service = 1; */
+        if (message.service)
+            Service.internalBinaryWrite(message.service, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.ServicePeer inbound = 2; */
+        for (let i = 0; i < message.inbound.length; i++)
+            ServicePeer.internalBinaryWrite(message.inbound[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.ServicePeer outbound = 3; */
+        for (let i = 0; i < message.outbound.length; i++)
+            ServicePeer.internalBinaryWrite(message.outbound[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.ServicePort ports = 4; */
+        for (let i = 0; i < message.ports.length; i++)
+            ServicePort.internalBinaryWrite(message.ports[i], writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* repeated flow.Workload workloads = 5; */
+        for (let i = 0; i < message.workloads.length; i++)
+            Workload.internalBinaryWrite(message.workloads[i], writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string pods = 6; */
+        for (let i = 0; i < message.pods.length; i++)
+            writer.tag(6, WireType.LengthDelimited).string(message.pods[i]);
+        /* repeated ui.L7Endpoint l7_endpoints = 7; */
+        for (let i = 0; i < message.l7Endpoints.length; i++)
+            L7Endpoint.internalBinaryWrite(message.l7Endpoints[i], writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* repeated flow.Flow recent_flows = 8; */
+        for (let i = 0; i < message.recentFlows.length; i++)
+            Flow.internalBinaryWrite(message.recentFlows[i], writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* uint32 flows_number = 9; */
+        if (message.flowsNumber !== 0)
+            writer.tag(9, WireType.Varint).uint32(message.flowsNumber);
+        /* repeated ui.HttpEndpointStats http_endpoints = 10; */
+        for (let i = 0; i < message.httpEndpoints.length; i++)
+            HttpEndpointStats.internalBinaryWrite(message.httpEndpoints[i], writer.tag(10, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1003,79 +3907,34 @@ class NamespaceState$Type extends MessageType<NamespaceState> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.NamespaceState
+ * @generated MessageType for protobuf message ui.ServiceDetailsResponse
  */
-export const NamespaceState = new NamespaceState$Type();
+export const ServiceDetailsResponse = new ServiceDetailsResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class Service$Type extends MessageType<Service> {
+class ServicePeer$Type extends MessageType<ServicePeer> {
     constructor() {
-        super("ui.Service", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "labels", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 5, name: "dns_names", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 6, name: "egress_policy_enforced", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 7, name: "ingress_policy_enforced", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 8, name: "visibility_policy_status", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 9, name: "creation_timestamp", kind: "message", T: () => Timestamp },
-            { no: 10, name: "workloads", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Workload },
-            { no: 12, name: "identity", kind: "scalar", T: 13 /*ScalarType.UINT32*/ }
+        super("ui.ServicePeer", [
+            { no: 1, name: "service", kind: "message", T: () => Service },
+            { no: 2, name: "links", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ServiceLink }
         ]);
     }
-    create(value?: PartialMessage<Service>): Service {
+    create(value?: PartialMessage<ServicePeer>): ServicePeer {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.name = "";
-        message.namespace = "";
-        message.labels = [];
-        message.dnsNames = [];
-        message.egressPolicyEnforced = false;
-        message.ingressPolicyEnforced = false;
-        message.visibilityPolicyStatus = "";
-        message.workloads = [];
-        message.identity = 0;
+        message.links = [];
         if (value !== undefined)
-            reflectionMergePartial<Service>(this, message, value);
+            reflectionMergePartial<ServicePeer>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: Service): Service {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServicePeer): ServicePeer {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string name */ 2:
-                    message.name = reader.string();
-                    break;
-                case /* string namespace */ 3:
-                    message.namespace = reader.string();
-                    break;
-                case /* repeated string labels */ 4:
-                    message.labels.push(reader.string());
-                    break;
-                case /* repeated string dns_names */ 5:
-                    message.dnsNames.push(reader.string());
-                    break;
-                case /* bool egress_policy_enforced */ 6:
-                    message.egressPolicyEnforced = reader.bool();
-                    break;
-                case /* bool ingress_policy_enforced */ 7:
-                    message.ingressPolicyEnforced = reader.bool();
-                    break;
-                case /* string visibility_policy_status */ 8:
-                    message.visibilityPolicyStatus = reader.string();
-                    break;
-                case /* google.protobuf.Timestamp creation_timestamp */ 9:
-                    message.creationTimestamp = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.creationTimestamp);
-                    break;
-                case /* repeated flow.Workload workloads */ 10:
-                    message.workloads.push(Workload.internalBinaryRead(reader, reader.uint32(), options));
+                case /* ui.Service service */ 1:
+                    message.service = Service.internalBinaryRead(reader, reader.uint32(), options, message.service);
                     break;
-                case /* uint32 identity */ 12:
-                    message.identity = reader.uint32();
+                case /* repeated ui.ServiceLink links */ 2:
+                    message.links.push(ServiceLink.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1085,43 +3944,16 @@ class Service$Type extends MessageType<Service> {
                     if (u !== false)
                         (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
             }
-        }
-        return message;
-    }
-    internalBinaryWrite(message: Service, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string name = 2; */
-        if (message.name !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.name);
-        /* string namespace = 3; */
-        if (message.namespace !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.namespace);
-        /* repeated string labels = 4; */
-        for (let i = 0; i < message.labels.length; i++)
-            writer.tag(4, WireType.LengthDelimited).string(message.labels[i]);
-        /* repeated string dns_names = 5; */
-        for (let i = 0; i < message.dnsNames.length; i++)
-            writer.tag(5, WireType.LengthDelimited).string(message.dnsNames[i]);
-        /* bool egress_policy_enforced = 6; */
-        if (message.egressPolicyEnforced !== false)
-            writer.tag(6, WireType.Varint).bool(message.egressPolicyEnforced);
-        /* bool ingress_policy_enforced = 7; */
-        if (message.ingressPolicyEnforced !== false)
-            writer.tag(7, WireType.Varint).bool(message.ingressPolicyEnforced);
-        /* string visibility_policy_status = 8; */
-        if (message.visibilityPolicyStatus !== "")
-            writer.tag(8, WireType.LengthDelimited).string(message.visibilityPolicyStatus);
-        /* google.protobuf.Timestamp creation_timestamp = 9; */
-        if (message.creationTimestamp)
-            Timestamp.internalBinaryWrite(message.creationTimestamp, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
-        /* repeated flow.Workload workloads = 10; */
-        for (let i = 0; i < message.workloads.length; i++)
-            Workload.internalBinaryWrite(message.workloads[i], writer.tag(10, WireType.LengthDelimited).fork(), options).join();
-        /* uint32 identity = 12; */
-        if (message.identity !== 0)
-            writer.tag(12, WireType.Varint).uint32(message.identity);
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ServicePeer, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.Service service = 1; */
+        if (message.service)
+            Service.internalBinaryWrite(message.service, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated ui.ServiceLink links = 2; */
+        for (let i = 0; i < message.links.length; i++)
+            ServiceLink.internalBinaryWrite(message.links[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1129,34 +3961,40 @@ class Service$Type extends MessageType<Service> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.Service
+ * @generated MessageType for protobuf message ui.ServicePeer
  */
-export const Service = new Service$Type();
+export const ServicePeer = new ServicePeer$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ServiceState$Type extends MessageType<ServiceState> {
+class ServicePort$Type extends MessageType<ServicePort> {
     constructor() {
-        super("ui.ServiceState", [
-            { no: 1, name: "service", kind: "message", T: () => Service },
-            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
+        super("ui.ServicePort", [
+            { no: 1, name: "port", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
+            { no: 2, name: "protocol", kind: "enum", T: () => ["ui.IPProtocol", IPProtocol] },
+            { no: 3, name: "flow_amount", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ }
         ]);
     }
-    create(value?: PartialMessage<ServiceState>): ServiceState {
+    create(value?: PartialMessage<ServicePort>): ServicePort {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.type = 0;
+        message.port = 0;
+        message.protocol = 0;
+        message.flowAmount = 0n;
         if (value !== undefined)
-            reflectionMergePartial<ServiceState>(this, message, value);
+            reflectionMergePartial<ServicePort>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceState): ServiceState {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServicePort): ServicePort {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* ui.Service service */ 1:
-                    message.service = Service.internalBinaryRead(reader, reader.uint32(), options, message.service);
+                case /* uint32 port */ 1:
+                    message.port = reader.uint32();
                     break;
-                case /* ui.StateChange type */ 2:
-                    message.type = reader.int32();
+                case /* ui.IPProtocol protocol */ 2:
+                    message.protocol = reader.int32();
+                    break;
+                case /* uint64 flow_amount */ 3:
+                    message.flowAmount = reader.uint64().toBigInt();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1169,13 +4007,16 @@ class ServiceState$Type extends MessageType<ServiceState> {
         }
         return message;
     }
-    internalBinaryWrite(message: ServiceState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* ui.Service service = 1; */
-        if (message.service)
-            Service.internalBinaryWrite(message.service, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* ui.StateChange type = 2; */
-        if (message.type !== 0)
-            writer.tag(2, WireType.Varint).int32(message.type);
+    internalBinaryWrite(message: ServicePort, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* uint32 port = 1; */
+        if (message.port !== 0)
+            writer.tag(1, WireType.Varint).uint32(message.port);
+        /* ui.IPProtocol protocol = 2; */
+        if (message.protocol !== 0)
+            writer.tag(2, WireType.Varint).int32(message.protocol);
+        /* uint64 flow_amount = 3; */
+        if (message.flowAmount !== 0n)
+            writer.tag(3, WireType.Varint).uint64(message.flowAmount);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1183,30 +4024,50 @@ class ServiceState$Type extends MessageType<ServiceState> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.ServiceState
+ * @generated MessageType for protobuf message ui.ServicePort
  */
-export const ServiceState = new ServiceState$Type();
+export const ServicePort = new ServicePort$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ServiceFilter$Type extends MessageType<ServiceFilter> {
+class L7Endpoint$Type extends MessageType<L7Endpoint> {
     constructor() {
-        super("ui.ServiceFilter", [
-            { no: 1, name: "namespace", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("ui.L7Endpoint", [
+            { no: 1, name: "protocol", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "method", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "path", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "requests", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 5, name: "errors", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ }
         ]);
     }
-    create(value?: PartialMessage<ServiceFilter>): ServiceFilter {
+    create(value?: PartialMessage<L7Endpoint>): L7Endpoint {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.namespace = [];
+        message.protocol = "";
+        message.method = "";
+        message.path = "";
+        message.requests = 0n;
+        message.errors = 0n;
         if (value !== undefined)
-            reflectionMergePartial<ServiceFilter>(this, message, value);
+            reflectionMergePartial<L7Endpoint>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceFilter): ServiceFilter {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: L7Endpoint): L7Endpoint {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated string namespace */ 1:
-                    message.namespace.push(reader.string());
+                case /* string protocol */ 1:
+                    message.protocol = reader.string();
+                    break;
+                case /* string method */ 2:
+                    message.method = reader.string();
+                    break;
+                case /* string path */ 3:
+                    message.path = reader.string();
+                    break;
+                case /* uint64 requests */ 4:
+                    message.requests = reader.uint64().toBigInt();
+                    break;
+                case /* uint64 errors */ 5:
+                    message.errors = reader.uint64().toBigInt();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1219,10 +4080,22 @@ class ServiceFilter$Type extends MessageType<ServiceFilter> {
         }
         return message;
     }
-    internalBinaryWrite(message: ServiceFilter, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated string namespace = 1; */
-        for (let i = 0; i < message.namespace.length; i++)
-            writer.tag(1, WireType.LengthDelimited).string(message.namespace[i]);
+    internalBinaryWrite(message: L7Endpoint, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string protocol = 1; */
+        if (message.protocol !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.protocol);
+        /* string method = 2; */
+        if (message.method !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.method);
+        /* string path = 3; */
+        if (message.path !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.path);
+        /* uint64 requests = 4; */
+        if (message.requests !== 0n)
+            writer.tag(4, WireType.Varint).uint64(message.requests);
+        /* uint64 errors = 5; */
+        if (message.errors !== 0n)
+            writer.tag(5, WireType.Varint).uint64(message.errors);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1230,79 +4103,57 @@ class ServiceFilter$Type extends MessageType<ServiceFilter> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.ServiceFilter
+ * @generated MessageType for protobuf message ui.L7Endpoint
  */
-export const ServiceFilter = new ServiceFilter$Type();
+export const L7Endpoint = new L7Endpoint$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ServiceLink$Type extends MessageType<ServiceLink> {
+class HttpEndpointStats$Type extends MessageType<HttpEndpointStats> {
     constructor() {
-        super("ui.ServiceLink", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "source_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "destination_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "destination_port", kind: "scalar", T: 13 /*ScalarType.UINT32*/ },
-            { no: 5, name: "ip_protocol", kind: "enum", T: () => ["ui.IPProtocol", IPProtocol] },
-            { no: 6, name: "verdict", kind: "enum", T: () => ["flow.Verdict", Verdict] },
-            { no: 7, name: "flow_amount", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
-            { no: 8, name: "latency", kind: "message", T: () => ServiceLink_Latency },
-            { no: 9, name: "bytes_transfered", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
-            { no: 10, name: "auth_type", kind: "enum", T: () => ["flow.AuthType", AuthType] },
-            { no: 11, name: "is_encrypted", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        super("ui.HttpEndpointStats", [
+            { no: 1, name: "method", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "path", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "requests", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 4, name: "status_classes", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => HttpStatusClassCount },
+            { no: 5, name: "latency_p50", kind: "message", T: () => Duration },
+            { no: 6, name: "latency_p90", kind: "message", T: () => Duration },
+            { no: 7, name: "latency_p99", kind: "message", T: () => Duration }
         ]);
     }
-    create(value?: PartialMessage<ServiceLink>): ServiceLink {
+    create(value?: PartialMessage<HttpEndpointStats>): HttpEndpointStats {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.sourceId = "";
-        message.destinationId = "";
-        message.destinationPort = 0;
-        message.ipProtocol = 0;
-        message.verdict = 0;
-        message.flowAmount = 0n;
-        message.bytesTransfered = 0n;
-        message.authType = 0;
-        message.isEncrypted = false;
+        message.method = "";
+        message.path = "";
+        message.requests = 0n;
+        message.statusClasses = [];
         if (value !== undefined)
-            reflectionMergePartial<ServiceLink>(this, message, value);
+            reflectionMergePartial<HttpEndpointStats>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceLink): ServiceLink {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: HttpEndpointStats): HttpEndpointStats {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string source_id */ 2:
-                    message.sourceId = reader.string();
-                    break;
-                case /* string destination_id */ 3:
-                    message.destinationId = reader.string();
-                    break;
-                case /* uint32 destination_port */ 4:
-                    message.destinationPort = reader.uint32();
+                case /* string method */ 1:
+                    message.method = reader.string();
                     break;
-                case /* ui.IPProtocol ip_protocol */ 5:
-                    message.ipProtocol = reader.int32();
-                    break;
-                case /* flow.Verdict verdict */ 6:
-                    message.verdict = reader.int32();
+                case /* string path */ 2:
+                    message.path = reader.string();
                     break;
-                case /* uint64 flow_amount */ 7:
-                    message.flowAmount = reader.uint64().toBigInt();
+                case /* uint64 requests */ 3:
+                    message.requests = reader.uint64().toBigInt();
                     break;
-                case /* ui.ServiceLink.Latency latency */ 8:
-                    message.latency = ServiceLink_Latency.internalBinaryRead(reader, reader.uint32(), options, message.latency);
+                case /* repeated ui.HttpStatusClassCount status_classes */ 4:
+                    message.statusClasses.push(HttpStatusClassCount.internalBinaryRead(reader, reader.uint32(), options));
                     break;
-                case /* uint64 bytes_transfered */ 9:
-                    message.bytesTransfered = reader.uint64().toBigInt();
+                case /* google.protobuf.Duration latency_p50 */ 5:
+                    message.latencyP50 = Duration.internalBinaryRead(reader, reader.uint32(), options, message.latencyP50);
                     break;
-                case /* flow.AuthType auth_type */ 10:
-                    message.authType = reader.int32();
+                case /* google.protobuf.Duration latency_p90 */ 6:
+                    message.latencyP90 = Duration.internalBinaryRead(reader, reader.uint32(), options, message.latencyP90);
                     break;
-                case /* bool is_encrypted */ 11:
-                    message.isEncrypted = reader.bool();
+                case /* google.protobuf.Duration latency_p99 */ 7:
+                    message.latencyP99 = Duration.internalBinaryRead(reader, reader.uint32(), options, message.latencyP99);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1315,40 +4166,28 @@ class ServiceLink$Type extends MessageType<ServiceLink> {
         }
         return message;
     }
-    internalBinaryWrite(message: ServiceLink, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string source_id = 2; */
-        if (message.sourceId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.sourceId);
-        /* string destination_id = 3; */
-        if (message.destinationId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.destinationId);
-        /* uint32 destination_port = 4; */
-        if (message.destinationPort !== 0)
-            writer.tag(4, WireType.Varint).uint32(message.destinationPort);
-        /* ui.IPProtocol ip_protocol = 5; */
-        if (message.ipProtocol !== 0)
-            writer.tag(5, WireType.Varint).int32(message.ipProtocol);
-        /* flow.Verdict verdict = 6; */
-        if (message.verdict !== 0)
-            writer.tag(6, WireType.Varint).int32(message.verdict);
-        /* uint64 flow_amount = 7; */
-        if (message.flowAmount !== 0n)
-            writer.tag(7, WireType.Varint).uint64(message.flowAmount);
-        /* ui.ServiceLink.Latency latency = 8; */
-        if (message.latency)
-            ServiceLink_Latency.internalBinaryWrite(message.latency, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
-        /* uint64 bytes_transfered = 9; */
-        if (message.bytesTransfered !== 0n)
-            writer.tag(9, WireType.Varint).uint64(message.bytesTransfered);
-        /* flow.AuthType auth_type = 10; */
-        if (message.authType !== 0)
-            writer.tag(10, WireType.Varint).int32(message.authType);
-        /* bool is_encrypted = 11; */
-        if (message.isEncrypted !== false)
-            writer.tag(11, WireType.Varint).bool(message.isEncrypted);
+    internalBinaryWrite(message: HttpEndpointStats, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string method = 1; */
+        if (message.method !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.method);
+        /* string path = 2; */
+        if (message.path !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.path);
+        /* uint64 requests = 3; */
+        if (message.requests !== 0n)
+            writer.tag(3, WireType.Varint).uint64(message.requests);
+        /* repeated ui.HttpStatusClassCount status_classes = 4; */
+        for (let i = 0; i < message.statusClasses.length; i++)
+            HttpStatusClassCount.internalBinaryWrite(message.statusClasses[i], writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Duration latency_p50 = 5; */
+        if (message.latencyP50)
+            Duration.internalBinaryWrite(message.latencyP50, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Duration latency_p90 = 6; */
+        if (message.latencyP90)
+            Duration.internalBinaryWrite(message.latencyP90, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Duration latency_p99 = 7; */
+        if (message.latencyP99)
+            Duration.internalBinaryWrite(message.latencyP99, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1356,37 +4195,35 @@ class ServiceLink$Type extends MessageType<ServiceLink> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.ServiceLink
+ * @generated MessageType for protobuf message ui.HttpEndpointStats
  */
-export const ServiceLink = new ServiceLink$Type();
+export const HttpEndpointStats = new HttpEndpointStats$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ServiceLink_Latency$Type extends MessageType<ServiceLink_Latency> {
+class HttpStatusClassCount$Type extends MessageType<HttpStatusClassCount> {
     constructor() {
-        super("ui.ServiceLink.Latency", [
-            { no: 1, name: "min", kind: "message", T: () => Duration },
-            { no: 2, name: "max", kind: "message", T: () => Duration },
-            { no: 3, name: "avg", kind: "message", T: () => Duration }
+        super("ui.HttpStatusClassCount", [
+            { no: 1, name: "status_class", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "count", kind: "scalar", T: 4 /*ScalarType.UINT64*/, L: 0 /*LongType.BIGINT*/ }
         ]);
     }
-    create(value?: PartialMessage<ServiceLink_Latency>): ServiceLink_Latency {
+    create(value?: PartialMessage<HttpStatusClassCount>): HttpStatusClassCount {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.statusClass = "";
+        message.count = 0n;
         if (value !== undefined)
-            reflectionMergePartial<ServiceLink_Latency>(this, message, value);
+            reflectionMergePartial<HttpStatusClassCount>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceLink_Latency): ServiceLink_Latency {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: HttpStatusClassCount): HttpStatusClassCount {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* google.protobuf.Duration min */ 1:
-                    message.min = Duration.internalBinaryRead(reader, reader.uint32(), options, message.min);
-                    break;
-                case /* google.protobuf.Duration max */ 2:
-                    message.max = Duration.internalBinaryRead(reader, reader.uint32(), options, message.max);
+                case /* string status_class */ 1:
+                    message.statusClass = reader.string();
                     break;
-                case /* google.protobuf.Duration avg */ 3:
-                    message.avg = Duration.internalBinaryRead(reader, reader.uint32(), options, message.avg);
+                case /* uint64 count */ 2:
+                    message.count = reader.uint64().toBigInt();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1399,16 +4236,13 @@ class ServiceLink_Latency$Type extends MessageType<ServiceLink_Latency> {
         }
         return message;
     }
-    internalBinaryWrite(message: ServiceLink_Latency, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* google.protobuf.Duration min = 1; */
-        if (message.min)
-            Duration.internalBinaryWrite(message.min, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Duration max = 2; */
-        if (message.max)
-            Duration.internalBinaryWrite(message.max, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Duration avg = 3; */
-        if (message.avg)
-            Duration.internalBinaryWrite(message.avg, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: HttpStatusClassCount, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string status_class = 1; */
+        if (message.statusClass !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.statusClass);
+        /* uint64 count = 2; */
+        if (message.count !== 0n)
+            writer.tag(2, WireType.Varint).uint64(message.count);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1416,34 +4250,45 @@ class ServiceLink_Latency$Type extends MessageType<ServiceLink_Latency> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.ServiceLink.Latency
+ * @generated MessageType for protobuf message ui.HttpStatusClassCount
  */
-export const ServiceLink_Latency = new ServiceLink_Latency$Type();
+export const HttpStatusClassCount = new HttpStatusClassCount$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ServiceLinkState$Type extends MessageType<ServiceLinkState> {
+class LinkL7Summary$Type extends MessageType<LinkL7Summary> {
     constructor() {
-        super("ui.ServiceLinkState", [
-            { no: 1, name: "service_link", kind: "message", T: () => ServiceLink },
-            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
+        super("ui.LinkL7Summary", [
+            { no: 1, name: "link_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "source_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "destination_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "http_endpoints", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => HttpEndpointStats }
         ]);
     }
-    create(value?: PartialMessage<ServiceLinkState>): ServiceLinkState {
+    create(value?: PartialMessage<LinkL7Summary>): LinkL7Summary {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.type = 0;
+        message.linkId = "";
+        message.sourceId = "";
+        message.destinationId = "";
+        message.httpEndpoints = [];
         if (value !== undefined)
-            reflectionMergePartial<ServiceLinkState>(this, message, value);
+            reflectionMergePartial<LinkL7Summary>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceLinkState): ServiceLinkState {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: LinkL7Summary): LinkL7Summary {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* ui.ServiceLink service_link */ 1:
-                    message.serviceLink = ServiceLink.internalBinaryRead(reader, reader.uint32(), options, message.serviceLink);
+                case /* string link_id */ 1:
+                    message.linkId = reader.string();
                     break;
-                case /* ui.StateChange type */ 2:
-                    message.type = reader.int32();
+                case /* string source_id */ 2:
+                    message.sourceId = reader.string();
+                    break;
+                case /* string destination_id */ 3:
+                    message.destinationId = reader.string();
+                    break;
+                case /* repeated ui.HttpEndpointStats http_endpoints */ 4:
+                    message.httpEndpoints.push(HttpEndpointStats.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1456,13 +4301,19 @@ class ServiceLinkState$Type extends MessageType<ServiceLinkState> {
         }
         return message;
     }
-    internalBinaryWrite(message: ServiceLinkState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* ui.ServiceLink service_link = 1; */
-        if (message.serviceLink)
-            ServiceLink.internalBinaryWrite(message.serviceLink, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* ui.StateChange type = 2; */
-        if (message.type !== 0)
-            writer.tag(2, WireType.Varint).int32(message.type);
+    internalBinaryWrite(message: LinkL7Summary, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string link_id = 1; */
+        if (message.linkId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.linkId);
+        /* string source_id = 2; */
+        if (message.sourceId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.sourceId);
+        /* string destination_id = 3; */
+        if (message.destinationId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.destinationId);
+        /* repeated ui.HttpEndpointStats http_endpoints = 4; */
+        for (let i = 0; i < message.httpEndpoints.length; i++)
+            HttpEndpointStats.internalBinaryWrite(message.httpEndpoints[i], writer.tag(4, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1470,49 +4321,34 @@ class ServiceLinkState$Type extends MessageType<ServiceLinkState> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.ServiceLinkState
+ * @generated MessageType for protobuf message ui.LinkL7Summary
  */
-export const ServiceLinkState = new ServiceLinkState$Type();
+export const LinkL7Summary = new LinkL7Summary$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ServiceLinkFilter$Type extends MessageType<ServiceLinkFilter> {
+class LinkL7SummaryState$Type extends MessageType<LinkL7SummaryState> {
     constructor() {
-        super("ui.ServiceLinkFilter", [
-            { no: 1, name: "source", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ServiceFilter },
-            { no: 2, name: "destination", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ServiceFilter },
-            { no: 3, name: "destination_port", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "verdict", kind: "enum", repeat: 1 /*RepeatType.PACKED*/, T: () => ["flow.Verdict", Verdict] }
+        super("ui.LinkL7SummaryState", [
+            { no: 1, name: "link_l7_summary", kind: "message", T: () => LinkL7Summary },
+            { no: 2, name: "type", kind: "enum", T: () => ["ui.StateChange", StateChange] }
         ]);
     }
-    create(value?: PartialMessage<ServiceLinkFilter>): ServiceLinkFilter {
+    create(value?: PartialMessage<LinkL7SummaryState>): LinkL7SummaryState {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.source = [];
-        message.destination = [];
-        message.destinationPort = [];
-        message.verdict = [];
+        message.type = 0;
         if (value !== undefined)
-            reflectionMergePartial<ServiceLinkFilter>(this, message, value);
+            reflectionMergePartial<LinkL7SummaryState>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ServiceLinkFilter): ServiceLinkFilter {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: LinkL7SummaryState): LinkL7SummaryState {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated ui.ServiceFilter source */ 1:
-                    message.source.push(ServiceFilter.internalBinaryRead(reader, reader.uint32(), options));
-                    break;
-                case /* repeated ui.ServiceFilter destination */ 2:
-                    message.destination.push(ServiceFilter.internalBinaryRead(reader, reader.uint32(), options));
-                    break;
-                case /* repeated string destination_port */ 3:
-                    message.destinationPort.push(reader.string());
+                case /* ui.LinkL7Summary link_l7_summary */ 1:
+                    message.linkL7Summary = LinkL7Summary.internalBinaryRead(reader, reader.uint32(), options, message.linkL7Summary);
                     break;
-                case /* repeated flow.Verdict verdict */ 4:
-                    if (wireType === WireType.LengthDelimited)
-                        for (let e = reader.int32() + reader.pos; reader.pos < e;)
-                            message.verdict.push(reader.int32());
-                    else
-                        message.verdict.push(reader.int32());
+                case /* ui.StateChange type */ 2:
+                    message.type = reader.int32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1525,23 +4361,13 @@ class ServiceLinkFilter$Type extends MessageType<ServiceLinkFilter> {
         }
         return message;
     }
-    internalBinaryWrite(message: ServiceLinkFilter, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated ui.ServiceFilter source = 1; */
-        for (let i = 0; i < message.source.length; i++)
-            ServiceFilter.internalBinaryWrite(message.source[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* repeated ui.ServiceFilter destination = 2; */
-        for (let i = 0; i < message.destination.length; i++)
-            ServiceFilter.internalBinaryWrite(message.destination[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
-        /* repeated string destination_port = 3; */
-        for (let i = 0; i < message.destinationPort.length; i++)
-            writer.tag(3, WireType.LengthDelimited).string(message.destinationPort[i]);
-        /* repeated flow.Verdict verdict = 4; */
-        if (message.verdict.length) {
-            writer.tag(4, WireType.LengthDelimited).fork();
-            for (let i = 0; i < message.verdict.length; i++)
-                writer.int32(message.verdict[i]);
-            writer.join();
-        }
+    internalBinaryWrite(message: LinkL7SummaryState, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.LinkL7Summary link_l7_summary = 1; */
+        if (message.linkL7Summary)
+            LinkL7Summary.internalBinaryWrite(message.linkL7Summary, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* ui.StateChange type = 2; */
+        if (message.type !== 0)
+            writer.tag(2, WireType.Varint).int32(message.type);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1549,9 +4375,9 @@ class ServiceLinkFilter$Type extends MessageType<ServiceLinkFilter> {
     }
 }
 /**
- * @generated MessageType for protobuf message ui.ServiceLinkFilter
+ * @generated MessageType for protobuf message ui.LinkL7SummaryState
  */
-export const ServiceLinkFilter = new ServiceLinkFilter$Type();
+export const LinkL7SummaryState = new LinkL7SummaryState$Type();
 // @generated message type with reflection information, may provide speed optimized methods
 class GetControlStreamRequest$Type extends MessageType<GetControlStreamRequest> {
     constructor() {
@@ -0,0 +1,664 @@
+/* eslint-disable */
+// @generated by protobuf-ts 2.11.1 with parameter add_pb_suffix,eslint_disable,ts_nocheck,generate_dependencies,long_type_bigint
+// @generated from protobuf file "ui/views.proto" (package "ui", syntax proto3)
+// tslint:disable
+// @ts-nocheck
+import type { BinaryWriteOptions } from "@protobuf-ts/runtime";
+import type { IBinaryWriter } from "@protobuf-ts/runtime";
+import { WireType } from "@protobuf-ts/runtime";
+import type { BinaryReadOptions } from "@protobuf-ts/runtime";
+import type { IBinaryReader } from "@protobuf-ts/runtime";
+import { UnknownFieldHandler } from "@protobuf-ts/runtime";
+import type { PartialMessage } from "@protobuf-ts/runtime";
+import { reflectionMergePartial } from "@protobuf-ts/runtime";
+import { MessageType } from "@protobuf-ts/runtime";
+import { Timestamp } from "../google/protobuf/timestamp_pb";
+import { Duration } from "../google/protobuf/duration_pb";
+import { EventType } from "./ui_pb";
+/**
+ * @generated from protobuf message ui.SavedView
+ */
+export interface SavedView {
+    /**
+     * Short id that is used in share links
+     *
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+    /**
+     * @generated from protobuf field: string name = 2
+     */
+    name: string;
+    /**
+     * @generated from protobuf field: string namespace = 3
+     */
+    namespace: string;
+    /**
+     * @generated from protobuf field: string filter_expression = 4
+     */
+    filterExpression: string;
+    /**
+     * @generated from protobuf field: repeated ui.EventType event_types = 5
+     */
+    eventTypes: EventType[];
+    /**
+     * Either absolute time window or the one relative to the moment when view
+     * is opened is used
+     *
+     * @generated from protobuf field: google.protobuf.Timestamp since = 6
+     */
+    since?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp until = 7
+     */
+    until?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Duration lookback = 8
+     */
+    lookback?: Duration;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 9
+     */
+    createdAt?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp updated_at = 10
+     */
+    updatedAt?: Timestamp;
+}
+/**
+ * @generated from protobuf message ui.SavedViews
+ */
+export interface SavedViews {
+    /**
+     * @generated from protobuf field: repeated ui.SavedView views = 1
+     */
+    views: SavedView[];
+}
+/**
+ * @generated from protobuf message ui.CreateSavedViewRequest
+ */
+export interface CreateSavedViewRequest {
+    /**
+     * @generated from protobuf field: ui.SavedView view = 1
+     */
+    view?: SavedView;
+}
+/**
+ * @generated from protobuf message ui.GetSavedViewRequest
+ */
+export interface GetSavedViewRequest {
+    /**
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+}
+/**
+ * @generated from protobuf message ui.ListSavedViewsRequest
+ */
+export interface ListSavedViewsRequest {
+}
+/**
+ * @generated from protobuf message ui.UpdateSavedViewRequest
+ */
+export interface UpdateSavedViewRequest {
+    /**
+     * @generated from protobuf field: ui.SavedView view = 1
+     */
+    view?: SavedView;
+}
+/**
+ * @generated from protobuf message ui.DeleteSavedViewRequest
+ */
+export interface DeleteSavedViewRequest {
+    /**
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+}
+/**
+ * @generated from protobuf message ui.SavedViewResponse
+ */
+export interface SavedViewResponse {
+    /**
+     * @generated from protobuf field: ui.SavedView view = 1
+     */
+    view?: SavedView;
+}
+/**
+ * @generated from protobuf message ui.ListSavedViewsResponse
+ */
+export interface ListSavedViewsResponse {
+    /**
+     * @generated from protobuf field: repeated ui.SavedView views = 1
+     */
+    views: SavedView[];
+}
+/**
+ * @generated from protobuf message ui.DeleteSavedViewResponse
+ */
+export interface DeleteSavedViewResponse {
+}
+// @generated message type with reflection information, may provide speed optimized methods
+class SavedView$Type extends MessageType<SavedView> {
+    constructor() {
+        super("ui.SavedView", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "namespace", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "filter_expression", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "event_types", kind: "enum", repeat: 1 /*RepeatType.PACKED*/, T: () => ["ui.EventType", EventType] },
+            { no: 6, name: "since", kind: "message", T: () => Timestamp },
+            { no: 7, name: "until", kind: "message", T: () => Timestamp },
+            { no: 8, name: "lookback", kind: "message", T: () => Duration },
+            { no: 9, name: "created_at", kind: "message", T: () => Timestamp },
+            { no: 10, name: "updated_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<SavedView>): SavedView {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.name = "";
+        message.namespace = "";
+        message.filterExpression = "";
+        message.eventTypes = [];
+        if (value !== undefined)
+            reflectionMergePartial<SavedView>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SavedView): SavedView {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string name */ 2:
+                    message.name = reader.string();
+                    break;
+                case /* string namespace */ 3:
+                    message.namespace = reader.string();
+                    break;
+                case /* string filter_expression */ 4:
+                    message.filterExpression = reader.string();
+                    break;
+                case /* repeated ui.EventType event_types */ 5:
+                    if (wireType === WireType.LengthDelimited)
+                        for (let e = reader.int32() + reader.pos; reader.pos < e;)
+                            message.eventTypes.push(reader.int32());
+                    else
+                        message.eventTypes.push(reader.int32());
+                    break;
+                case /* google.protobuf.Timestamp since */ 6:
+                    message.since = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.since);
+                    break;
+                case /* google.protobuf.Timestamp until */ 7:
+                    message.until = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.until);
+                    break;
+                case /* google.protobuf.Duration lookback */ 8:
+                    message.lookback = Duration.internalBinaryRead(reader, reader.uint32(), options, message.lookback);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 9:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                case /* google.protobuf.Timestamp updated_at */ 10:
+                    message.updatedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.updatedAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: SavedView, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string name = 2; */
+        if (message.name !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.name);
+        /* string namespace = 3; */
+        if (message.namespace !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.namespace);
+        /* string filter_expression = 4; */
+        if (message.filterExpression !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.filterExpression);
+        /* repeated ui.EventType event_types = 5; */
+        if (message.eventTypes.length) {
+            writer.tag(5, WireType.LengthDelimited).fork();
+            for (let i = 0; i < message.eventTypes.length; i++)
+                writer.int32(message.eventTypes[i]);
+            writer.join();
+        }
+        /* google.protobuf.Timestamp since = 6; */
+        if (message.since)
+            Timestamp.internalBinaryWrite(message.since, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp until = 7; */
+        if (message.until)
+            Timestamp.internalBinaryWrite(message.until, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Duration lookback = 8; */
+        if (message.lookback)
+            Duration.internalBinaryWrite(message.lookback, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 9; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp updated_at = 10; */
+        if (message.updatedAt)
+            Timestamp.internalBinaryWrite(message.updatedAt, writer.tag(10, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.SavedView
+ */
+export const SavedView = new SavedView$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class SavedViews$Type extends MessageType<SavedViews> {
+    constructor() {
+        super("ui.SavedViews", [
+            { no: 1, name: "views", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => SavedView }
+        ]);
+    }
+    create(value?: PartialMessage<SavedViews>): SavedViews {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.views = [];
+        if (value !== undefined)
+            reflectionMergePartial<SavedViews>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SavedViews): SavedViews {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated ui.SavedView views */ 1:
+                    message.views.push(SavedView.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: SavedViews, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated ui.SavedView views = 1; */
+        for (let i = 0; i < message.views.length; i++)
+            SavedView.internalBinaryWrite(message.views[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.SavedViews
+ */
+export const SavedViews = new SavedViews$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CreateSavedViewRequest$Type extends MessageType<CreateSavedViewRequest> {
+    constructor() {
+        super("ui.CreateSavedViewRequest", [
+            { no: 1, name: "view", kind: "message", T: () => SavedView }
+        ]);
+    }
+    create(value?: PartialMessage<CreateSavedViewRequest>): CreateSavedViewRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<CreateSavedViewRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateSavedViewRequest): CreateSavedViewRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.SavedView view */ 1:
+                    message.view = SavedView.internalBinaryRead(reader, reader.uint32(), options, message.view);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CreateSavedViewRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.SavedView view = 1; */
+        if (message.view)
+            SavedView.internalBinaryWrite(message.view, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.CreateSavedViewRequest
+ */
+export const CreateSavedViewRequest = new CreateSavedViewRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetSavedViewRequest$Type extends MessageType<GetSavedViewRequest> {
+    constructor() {
+        super("ui.GetSavedViewRequest", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetSavedViewRequest>): GetSavedViewRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetSavedViewRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetSavedViewRequest): GetSavedViewRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetSavedViewRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.GetSavedViewRequest
+ */
+export const GetSavedViewRequest = new GetSavedViewRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ListSavedViewsRequest$Type extends MessageType<ListSavedViewsRequest> {
+    constructor() {
+        super("ui.ListSavedViewsRequest", []);
+    }
+    create(value?: PartialMessage<ListSavedViewsRequest>): ListSavedViewsRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<ListSavedViewsRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListSavedViewsRequest): ListSavedViewsRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ListSavedViewsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ListSavedViewsRequest
+ */
+export const ListSavedViewsRequest = new ListSavedViewsRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class UpdateSavedViewRequest$Type extends MessageType<UpdateSavedViewRequest> {
+    constructor() {
+        super("ui.UpdateSavedViewRequest", [
+            { no: 1, name: "view", kind: "message", T: () => SavedView }
+        ]);
+    }
+    create(value?: PartialMessage<UpdateSavedViewRequest>): UpdateSavedViewRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<UpdateSavedViewRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: UpdateSavedViewRequest): UpdateSavedViewRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.SavedView view */ 1:
+                    message.view = SavedView.internalBinaryRead(reader, reader.uint32(), options, message.view);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: UpdateSavedViewRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.SavedView view = 1; */
+        if (message.view)
+            SavedView.internalBinaryWrite(message.view, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.UpdateSavedViewRequest
+ */
+export const UpdateSavedViewRequest = new UpdateSavedViewRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DeleteSavedViewRequest$Type extends MessageType<DeleteSavedViewRequest> {
+    constructor() {
+        super("ui.DeleteSavedViewRequest", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<DeleteSavedViewRequest>): DeleteSavedViewRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        if (value !== undefined)
+            reflectionMergePartial<DeleteSavedViewRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeleteSavedViewRequest): DeleteSavedViewRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: DeleteSavedViewRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.DeleteSavedViewRequest
+ */
+export const DeleteSavedViewRequest = new DeleteSavedViewRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class SavedViewResponse$Type extends MessageType<SavedViewResponse> {
+    constructor() {
+        super("ui.SavedViewResponse", [
+            { no: 1, name: "view", kind: "message", T: () => SavedView }
+        ]);
+    }
+    create(value?: PartialMessage<SavedViewResponse>): SavedViewResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<SavedViewResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SavedViewResponse): SavedViewResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* ui.SavedView view */ 1:
+                    message.view = SavedView.internalBinaryRead(reader, reader.uint32(), options, message.view);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: SavedViewResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* ui.SavedView view = 1; */
+        if (message.view)
+            SavedView.internalBinaryWrite(message.view, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.SavedViewResponse
+ */
+export const SavedViewResponse = new SavedViewResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ListSavedViewsResponse$Type extends MessageType<ListSavedViewsResponse> {
+    constructor() {
+        super("ui.ListSavedViewsResponse", [
+            { no: 1, name: "views", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => SavedView }
+        ]);
+    }
+    create(value?: PartialMessage<ListSavedViewsResponse>): ListSavedViewsResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.views = [];
+        if (value !== undefined)
+            reflectionMergePartial<ListSavedViewsResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListSavedViewsResponse): ListSavedViewsResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated ui.SavedView views */ 1:
+                    message.views.push(SavedView.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ListSavedViewsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated ui.SavedView views = 1; */
+        for (let i = 0; i < message.views.length; i++)
+            SavedView.internalBinaryWrite(message.views[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.ListSavedViewsResponse
+ */
+export const ListSavedViewsResponse = new ListSavedViewsResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DeleteSavedViewResponse$Type extends MessageType<DeleteSavedViewResponse> {
+    constructor() {
+        super("ui.DeleteSavedViewResponse", []);
+    }
+    create(value?: PartialMessage<DeleteSavedViewResponse>): DeleteSavedViewResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<DeleteSavedViewResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeleteSavedViewResponse): DeleteSavedViewResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: DeleteSavedViewResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message ui.DeleteSavedViewResponse
+ */
+export const DeleteSavedViewResponse = new DeleteSavedViewResponse$Type();